@@ -0,0 +1,879 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+)
+
+// Values for AffectedEventKind.
+const (
+	// Introduced is the version that introduced the vulnerability. Versions
+	// greater than or equal to it are affected.
+	Introduced AffectedEventKind = iota
+
+	// Fixed is the version that fixed the vulnerability. Versions greater than
+	// or equal to it are not affected.
+	Fixed
+
+	// LastAffected is the last affected version. Versions greater than it are
+	// not affected.
+	LastAffected
+
+	// Limit is an upper limit for the range. Versions greater than or equal to
+	// it are never affected by the range. A Limit event with a nil Version,
+	// written as "*" in the OSV format, sets no limit.
+	Limit
+)
+
+// Range types in the Open Source Vulnerability format.
+const (
+	osvTypeSemver    = "SEMVER"
+	osvTypeEcosystem = "ECOSYSTEM"
+	osvTypeGit       = "GIT"
+)
+
+// Errors returned when parsing affected ranges.
+var (
+	// ErrInvalidAffectedRange is returned when an affected range cannot be
+	// parsed.
+	ErrInvalidAffectedRange = errors.New("invalid affected range")
+
+	// ErrUnsupportedRangeType is returned when an affected range has a type
+	// whose versions cannot be evaluated as semantic versions.
+	ErrUnsupportedRangeType = errors.New("unsupported range type")
+)
+
+// AffectedRanges is a list of the version ranges affected by a security
+// advisory. A version is affected if it is in any of the ranges. It is modeled
+// after the "ranges" field of the affected package objects in the [OSV format].
+//
+// [OSV format]: https://ossf.github.io/osv-schema/
+type AffectedRanges []AffectedRange
+
+// An AffectedRange is a single range of versions affected by a security
+// advisory. The range is described by a list of events that introduce or end
+// the affected state, as in the range objects of the [OSV format].
+//
+// [OSV format]: https://ossf.github.io/osv-schema/
+type AffectedRange struct {
+	// Type is the type of the range in the OSV format. It is either "SEMVER"
+	// or "ECOSYSTEM".
+	Type string
+
+	// Events are the events of the range.
+	Events []AffectedEvent
+}
+
+// An AffectedEvent is an event in an AffectedRange.
+type AffectedEvent struct {
+	// Version is the version at which the event occurs. It is nil for
+	// an Introduced event that is written as "0" in the OSV format, meaning
+	// that every version is affected from the start, and for a Limit event
+	// that is written as "*".
+	Version *Version
+
+	// Kind is the kind of the event.
+	Kind AffectedEventKind
+}
+
+// An AffectedEventKind is the kind of an AffectedEvent.
+type AffectedEventKind int
+
+// An ImportError is returned when a version range from a security advisory
+// cannot be imported. It describes the part of the range that could not be
+// imported.
+type ImportError struct {
+	// Input is the whole range string that was imported.
+	Input string
+
+	// Clause is the alternative in the range that could not be imported.
+	Clause string
+
+	// Token is the token in the clause that could not be imported. It is
+	// empty if the error is not caused by a single token.
+	Token string
+
+	// Err is the underlying error.
+	Err error
+}
+
+// An advisoryPhrase is a phrase that is commonly used in security advisories
+// in place of an operator.
+type advisoryPhrase struct {
+	words []string
+	op    string
+}
+
+// osvRange is the JSON representation of a range in the OSV format.
+type osvRange struct {
+	Type   string     `json:"type"`
+	Events []osvEvent `json:"events"`
+}
+
+// osvEvent is the JSON representation of an event in the OSV format.
+type osvEvent struct {
+	Introduced   string `json:"introduced,omitempty"`
+	Fixed        string `json:"fixed,omitempty"`
+	LastAffected string `json:"last_affected,omitempty"`
+	Limit        string `json:"limit,omitempty"`
+}
+
+// ParseAffectedRanges parses the JSON array of OSV range objects in data into
+// AffectedRanges. The ranges of type "GIT" are skipped as they describe commits
+// instead of versions.
+func ParseAffectedRanges(data []byte) (AffectedRanges, error) {
+	var r AffectedRanges
+
+	if err := json.Unmarshal(data, &r); err != nil {
+		return nil, err //nolint:wrapcheck // the error is already wrapped
+	}
+
+	return r, nil
+}
+
+// Contains reports whether v is in any of the ranges.
+func (r AffectedRanges) Contains(v *Version) bool {
+	for _, ar := range r {
+		if ar.Contains(v) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// UnmarshalJSON implements [json.Unmarshaler]. It decodes a JSON array of OSV
+// range objects, skipping the ranges of type "GIT".
+func (r *AffectedRanges) UnmarshalJSON(data []byte) error {
+	var raw []json.RawMessage
+
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidAffectedRange, err)
+	}
+
+	ranges := make(AffectedRanges, 0, len(raw))
+
+	for _, b := range raw {
+		var ar AffectedRange
+
+		err := json.Unmarshal(b, &ar)
+		if errors.Is(err, ErrUnsupportedRangeType) {
+			continue
+		}
+
+		if err != nil {
+			return err //nolint:wrapcheck // the error is already wrapped
+		}
+
+		ranges = append(ranges, ar)
+	}
+
+	*r = ranges
+
+	return nil
+}
+
+// Contains reports whether v is in the range. The events are evaluated in
+// the order of their versions: v becomes affected at an Introduced event that
+// is less than or equal to it and unaffected at a Fixed event that is less
+// than or equal to it or at a LastAffected event that is less than it. Limit
+// events exclude every version greater than or equal to them.
+//
+// The build metadata of v is not taken into account.
+func (r AffectedRange) Contains(v *Version) bool {
+	events := slices.Clone(r.Events)
+	slices.SortStableFunc(events, func(a, b AffectedEvent) int {
+		switch {
+		case a.Version == nil && b.Version == nil:
+			return 0
+		case a.Version == nil:
+			return -1
+		case b.Version == nil:
+			return 1
+		default:
+			return a.Version.Compare(b.Version)
+		}
+	})
+
+	affected := false
+
+	for _, e := range events {
+		switch e.Kind {
+		case Introduced:
+			if e.Version == nil || v.Compare(e.Version) >= 0 {
+				affected = true
+			}
+		case Fixed:
+			if v.Compare(e.Version) >= 0 {
+				affected = false
+			}
+		case LastAffected:
+			if v.Compare(e.Version) > 0 {
+				affected = false
+			}
+		case Limit:
+			if e.Version != nil && v.Compare(e.Version) >= 0 {
+				return false
+			}
+		default:
+			panic(fmt.Sprintf("invalid affected event kind: %d", e.Kind))
+		}
+	}
+
+	return affected
+}
+
+// MarshalJSON implements [json.Marshaler]. The range is encoded as an OSV range
+// object.
+func (r AffectedRange) MarshalJSON() ([]byte, error) {
+	raw := osvRange{Type: r.Type, Events: make([]osvEvent, len(r.Events))}
+
+	for i, e := range r.Events {
+		var s string
+
+		switch {
+		case e.Version != nil:
+			s = e.Version.String()
+		case e.Kind == Limit:
+			s = "*"
+		default:
+			s = "0"
+		}
+
+		switch e.Kind {
+		case Introduced:
+			raw.Events[i].Introduced = s
+		case Fixed:
+			raw.Events[i].Fixed = s
+		case LastAffected:
+			raw.Events[i].LastAffected = s
+		case Limit:
+			raw.Events[i].Limit = s
+		default:
+			return nil, fmt.Errorf("%w: invalid event kind %d", ErrInvalidAffectedRange, e.Kind)
+		}
+	}
+
+	b, err := json.Marshal(raw)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal affected range: %w", err)
+	}
+
+	return b, nil
+}
+
+// UnmarshalJSON implements [json.Unmarshaler]. It decodes an OSV range object.
+// If the type of the range is not "SEMVER" or "ECOSYSTEM", it returns
+// an error that wraps ErrUnsupportedRangeType. The versions of "ECOSYSTEM"
+// ranges must be valid semantic versions.
+func (r *AffectedRange) UnmarshalJSON(data []byte) error {
+	var raw osvRange
+
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidAffectedRange, err)
+	}
+
+	switch raw.Type {
+	case osvTypeSemver, osvTypeEcosystem:
+	case osvTypeGit:
+		return fmt.Errorf("%w: %q", ErrUnsupportedRangeType, raw.Type)
+	default:
+		return fmt.Errorf("%w: unknown range type %q", ErrUnsupportedRangeType, raw.Type)
+	}
+
+	if len(raw.Events) == 0 {
+		return fmt.Errorf("%w: no events", ErrInvalidAffectedRange)
+	}
+
+	events := make([]AffectedEvent, 0, len(raw.Events))
+
+	for _, re := range raw.Events {
+		e, err := parseOSVEvent(re)
+		if err != nil {
+			return err
+		}
+
+		events = append(events, e)
+	}
+
+	r.Type = raw.Type
+	r.Events = events
+
+	return nil
+}
+
+func parseOSVEvent(raw osvEvent) (AffectedEvent, error) {
+	var (
+		kind AffectedEventKind
+		s    string
+		n    int
+	)
+
+	for k, v := range []string{raw.Introduced, raw.Fixed, raw.LastAffected, raw.Limit} {
+		if v != "" {
+			kind = AffectedEventKind(k)
+			s = v
+			n++
+		}
+	}
+
+	if n != 1 {
+		return AffectedEvent{}, fmt.Errorf(
+			"%w: an event must have exactly one field, found %d",
+			ErrInvalidAffectedRange,
+			n,
+		)
+	}
+
+	if (kind == Introduced && s == "0") || (kind == Limit && s == "*") {
+		return AffectedEvent{Version: nil, Kind: kind}, nil
+	}
+
+	v, err := Parse(s)
+	if err != nil {
+		return AffectedEvent{}, fmt.Errorf("%w: %w", ErrInvalidAffectedRange, err)
+	}
+
+	return AffectedEvent{Version: v, Kind: kind}, nil
+}
+
+// ImportConstraint parses a version range written in one of the notations
+// commonly used in security advisories into a Constraint. In addition to
+// the constraint syntax of [ParseConstraint], it understands, for example,
+// the following notations:
+//
+//   - Comparators separated by commas, like ">= 1.2.0, < 1.4.5".
+//   - Phrases in place of operators, like "fixed in 2.3.1", "before 2.3.1",
+//     "prior to 2.3.1", "1.2.3 and earlier", "1.2.3 and later", and
+//     "up to (including) 1.2.3".
+//   - Interval notation, like "[1.0.0,2.0.0)" or "(,1.2.3]".
+//   - Alternatives separated by "||", ";", or "or".
+//
+// The function tolerates common formatting quirks: the letters are not case
+// sensitive, the "≥" and "≤" characters are accepted as operators, words
+// like "versions" and "all" are ignored, and trailing punctuation is removed.
+// If the range cannot be imported, the returned error is an [*ImportError]
+// that wraps ErrInvalidConstraint.
+//
+// Note that the returned Constraint uses the pre-release rule of Constraint.
+// Use [ImportAffectedRanges] for ranges that include the pre-release versions
+// within them.
+func ImportConstraint(s string) (*Constraint, error) {
+	expr, err := normalizeAdvisoryRange(s)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := ParseConstraint(expr)
+	if err != nil {
+		return nil, &ImportError{Input: s, Clause: failingAlternative(expr), Token: "", Err: err}
+	}
+
+	return c, nil
+}
+
+// ImportAffectedRanges parses a version range written in one of the notations
+// commonly used in security advisories into AffectedRanges. It accepts the same
+// notations as [ImportConstraint]. Each continuous interval of versions in
+// the range becomes one AffectedRange of type "SEMVER".
+func ImportAffectedRanges(s string) (AffectedRanges, error) {
+	c, err := ImportConstraint(s)
+	if err != nil {
+		return nil, err
+	}
+
+	var ranges AffectedRanges
+
+	alternatives := strings.Split(c.String(), "||")
+
+	for k, set := range c.sets {
+		for _, i := range rangeIntervals(set) {
+			r, err := intervalToAffectedRange(i)
+			if err != nil {
+				clause := strings.TrimSpace(alternatives[k])
+
+				return nil, &ImportError{Input: s, Clause: clause, Token: "", Err: err}
+			}
+
+			ranges = append(ranges, r)
+		}
+	}
+
+	return ranges, nil
+}
+
+// failingAlternative returns the first alternative of the normalized range
+// expr that cannot be parsed, or expr if the alternatives can be parsed on
+// their own.
+func failingAlternative(expr string) string {
+	for _, alt := range strings.Split(expr, "||") {
+		alt = strings.TrimSpace(alt)
+		if _, err := parseRange(alt); err != nil {
+			return alt
+		}
+	}
+
+	return expr
+}
+
+// Error returns the error message.
+func (e *ImportError) Error() string {
+	var sb strings.Builder
+
+	sb.WriteString("cannot import version range ")
+	sb.WriteString(fmt.Sprintf("%q", e.Input))
+
+	if e.Clause != "" && e.Clause != e.Input {
+		sb.WriteString(fmt.Sprintf(" in clause %q", e.Clause))
+	}
+
+	if e.Token != "" {
+		sb.WriteString(fmt.Sprintf(" at %q", e.Token))
+	}
+
+	sb.WriteString(": ")
+	sb.WriteString(e.Err.Error())
+
+	return sb.String()
+}
+
+// Unwrap returns the underlying error.
+func (e *ImportError) Unwrap() error {
+	return e.Err
+}
+
+// advisoryPhrases returns the phrases that are translated into operators when
+// importing advisory ranges. The phrases that are written before the version
+// are in the first list, and the phrases written after it in the second.
+func advisoryPhrases() ([]advisoryPhrase, []advisoryPhrase) {
+	before := []advisoryPhrase{
+		{[]string{"up", "to", "and", "including"}, "<="},
+		{[]string{"up", "to", "(including)"}, "<="},
+		{[]string{"up", "to", "(inclusive)"}, "<="},
+		{[]string{"up", "to", "(excluding)"}, "<"},
+		{[]string{"up", "to", "(exclusive)"}, "<"},
+		{[]string{"greater", "than", "or", "equal", "to"}, ">="},
+		{[]string{"less", "than", "or", "equal", "to"}, "<="},
+		{[]string{"starting", "with"}, ">="},
+		{[]string{"starting", "from"}, ">="},
+		{[]string{"starting", "in"}, ">="},
+		{[]string{"introduced", "in"}, ">="},
+		{[]string{"fixed", "in"}, "<"},
+		{[]string{"patched", "in"}, "<"},
+		{[]string{"resolved", "in"}, "<"},
+		{[]string{"prior", "to"}, "<"},
+		{[]string{"earlier", "than"}, "<"},
+		{[]string{"older", "than"}, "<"},
+		{[]string{"less", "than"}, "<"},
+		{[]string{"lower", "than"}, "<"},
+		{[]string{"greater", "than"}, ">"},
+		{[]string{"later", "than"}, ">"},
+		{[]string{"newer", "than"}, ">"},
+		{[]string{"at", "least"}, ">="},
+		{[]string{"at", "most"}, "<="},
+		{[]string{"before"}, "<"},
+		{[]string{"below"}, "<"},
+		{[]string{"after"}, ">"},
+		{[]string{"since"}, ">="},
+		{[]string{"from"}, ">="},
+		{[]string{"through"}, "<="},
+		{[]string{"fixed"}, "<"},
+	}
+
+	after := []advisoryPhrase{
+		{[]string{"and", "earlier"}, "<="},
+		{[]string{"or", "earlier"}, "<="},
+		{[]string{"and", "below"}, "<="},
+		{[]string{"or", "below"}, "<="},
+		{[]string{"and", "prior"}, "<="},
+		{[]string{"and", "older"}, "<="},
+		{[]string{"or", "older"}, "<="},
+		{[]string{"or", "lower"}, "<="},
+		{[]string{"and", "lower"}, "<="},
+		{[]string{"and", "later"}, ">="},
+		{[]string{"or", "later"}, ">="},
+		{[]string{"and", "above"}, ">="},
+		{[]string{"or", "above"}, ">="},
+		{[]string{"and", "newer"}, ">="},
+		{[]string{"or", "newer"}, ">="},
+		{[]string{"and", "higher"}, ">="},
+		{[]string{"or", "higher"}, ">="},
+	}
+
+	return before, after
+}
+
+// normalizeAdvisoryRange translates an advisory range into the constraint
+// syntax understood by ParseConstraint.
+func normalizeAdvisoryRange(s string) (string, error) {
+	r := strings.NewReplacer(
+		"\u2265", ">=",
+		"\u2264", "<=",
+		"\u2013", "-",
+		"\u2014", "-",
+		"\u00a0", " ",
+		";", "||",
+	)
+	clauses := strings.Split(splitIntervals(r.Replace(s)), "||")
+	out := make([]string, 0, len(clauses))
+
+	for _, clause := range clauses {
+		words := strings.Fields(clause)
+
+		for {
+			i := alternativeSeparator(words)
+
+			c, err := normalizeAdvisoryClause(strings.Join(words[:i], " "))
+			if err != nil {
+				err.Input = s
+
+				return "", err
+			}
+
+			out = append(out, c)
+
+			if i == len(words) {
+				break
+			}
+
+			words = words[i+1:]
+		}
+	}
+
+	return strings.Join(out, " || "), nil
+}
+
+// splitIntervals separates the adjacent intervals in s with "||". The intervals
+// may be separated by spaces and commas, as in "[1.0.0,2.0.0), [3.0.0,4.0.0)".
+func splitIntervals(s string) string {
+	var sb strings.Builder
+
+	for i := 0; i < len(s); i++ {
+		sb.WriteByte(s[i])
+
+		if s[i] != ']' && s[i] != ')' {
+			continue
+		}
+
+		j := i + 1
+		for j < len(s) && (s[j] == ' ' || s[j] == ',') {
+			j++
+		}
+
+		if j < len(s) && (s[j] == '[' || s[j] == '(') {
+			sb.WriteString("||")
+
+			i = j - 1
+		}
+	}
+
+	return sb.String()
+}
+
+// alternativeSeparator returns the index of the first "or" in words that
+// separates two alternatives, or len(words) if there is none. The "or" in
+// the phrases like "or earlier" and "less than or equal to" doesn't separate
+// alternatives.
+func alternativeSeparator(words []string) int {
+	lower := make([]string, len(words))
+	for i, w := range words {
+		lower[i] = phraseWord(w)
+	}
+
+	before, after := advisoryPhrases()
+	phrases := slices.Concat(before, after)
+
+	for i, w := range lower {
+		if w != "or" {
+			continue
+		}
+
+		inPhrase := slices.ContainsFunc(phrases, func(p advisoryPhrase) bool {
+			k := slices.Index(p.words, "or")
+			if k < 0 || i < k {
+				return false
+			}
+
+			_, ok := matchPhrase(lower[i-k:], []advisoryPhrase{p})
+
+			return ok
+		})
+		if !inPhrase {
+			return i
+		}
+	}
+
+	return len(words)
+}
+
+// normalizeAdvisoryClause translates a single alternative of an advisory range
+// into the constraint syntax.
+//
+//nolint:cyclop,funlen,gocognit // the phrases are simpler to handle in one place
+func normalizeAdvisoryClause(clause string) (string, *ImportError) {
+	clause = strings.TrimRight(clause, ".,")
+
+	if clause == "" {
+		return "", &ImportError{
+			Input:  "",
+			Clause: clause,
+			Token:  "",
+			Err:    fmt.Errorf("%w: empty range", ErrInvalidConstraint),
+		}
+	}
+
+	if c, ok, err := normalizeInterval(clause); ok {
+		return c, err
+	}
+
+	words := strings.FieldsFunc(clause, func(r rune) bool {
+		return r == ' ' || r == ','
+	})
+	lower := make([]string, len(words))
+
+	for i, w := range words {
+		lower[i] = phraseWord(w)
+	}
+
+	before, after := advisoryPhrases()
+	out := make([]string, 0, len(words))
+
+	for i := 0; i < len(words); i++ {
+		w := words[i]
+
+		switch lower[i] {
+		case "all", "any", "affected", "are", "is", "vulnerable", "version", "versions", "and", "v":
+			continue
+		case "-":
+			out = append(out, w)
+
+			continue
+		}
+
+		if p, ok := matchPhrase(lower[i:], before); ok {
+			i += len(p.words)
+			if i >= len(words) {
+				return "", &ImportError{
+					Input:  "",
+					Clause: clause,
+					Token:  strings.Join(p.words, " "),
+					Err:    fmt.Errorf("%w: missing version after the phrase", ErrInvalidConstraint),
+				}
+			}
+
+			// Allow "version" between the phrase and the version, as in
+			// "fixed in version 1.2.3".
+			if (lower[i] == "version" || lower[i] == "v") && i+1 < len(words) {
+				i++
+			}
+
+			out = append(out, p.op+trimVersionToken(words[i]))
+
+			continue
+		}
+
+		if lower[i] == "up" && i+1 < len(words) && lower[i+1] == "to" {
+			return "", &ImportError{
+				Input:  "",
+				Clause: clause,
+				Token:  "up to",
+				Err: fmt.Errorf(
+					"%w: ambiguous phrase, use \"up to (including)\" or \"up to (excluding)\"",
+					ErrInvalidConstraint,
+				),
+			}
+		}
+
+		if isOperator(w) && i+1 < len(words) {
+			out = append(out, w+trimVersionToken(words[i+1]))
+			i++
+
+			continue
+		}
+
+		v := trimVersionToken(w)
+		if !isVersionToken(v) {
+			return "", &ImportError{
+				Input:  "",
+				Clause: clause,
+				Token:  w,
+				Err:    fmt.Errorf("%w: unknown word", ErrInvalidConstraint),
+			}
+		}
+
+		if p, ok := matchPhrase(lower[i+1:], after); ok {
+			out = append(out, p.op+v)
+			i += len(p.words)
+
+			continue
+		}
+
+		out = append(out, v)
+	}
+
+	if len(out) == 0 {
+		return "*", nil
+	}
+
+	return strings.Join(out, " "), nil
+}
+
+// normalizeInterval translates a range in the interval notation, like
+// "[1.0.0,2.0.0)", into the constraint syntax. It reports whether the clause
+// is in the interval notation.
+func normalizeInterval(clause string) (string, bool, *ImportError) {
+	if len(clause) < 3 || !strings.ContainsRune("[(", rune(clause[0])) || //nolint:mnd // brackets and a comma
+		!strings.ContainsRune("])", rune(clause[len(clause)-1])) {
+		return "", false, nil
+	}
+
+	lower, upper, ok := strings.Cut(clause[1:len(clause)-1], ",")
+	if !ok {
+		// A single version in brackets is an exact version.
+		if clause[0] == '[' && clause[len(clause)-1] == ']' {
+			return "=" + strings.TrimSpace(lower), true, nil
+		}
+
+		return "", true, &ImportError{
+			Input:  "",
+			Clause: clause,
+			Token:  "",
+			Err:    fmt.Errorf("%w: interval without a comma", ErrInvalidConstraint),
+		}
+	}
+
+	lower = strings.TrimSpace(lower)
+	upper = strings.TrimSpace(upper)
+	out := make([]string, 0, 2) //nolint:mnd // lower and upper bound
+
+	if lower != "" {
+		op := ">"
+		if clause[0] == '[' {
+			op = ">="
+		}
+
+		out = append(out, op+lower)
+	}
+
+	if upper != "" {
+		op := "<"
+		if clause[len(clause)-1] == ']' {
+			op = "<="
+		}
+
+		out = append(out, op+upper)
+	}
+
+	if len(out) == 0 {
+		return "*", true, nil
+	}
+
+	return strings.Join(out, " "), true, nil
+}
+
+// phraseWord returns w in the form that is matched against the words of
+// the phrases: in lower case and without trailing punctuation, so that for
+// example "fixed in: 1.2.3" matches "fixed in".
+func phraseWord(w string) string {
+	return strings.ToLower(strings.TrimRight(w, ".,:"))
+}
+
+// matchPhrase returns the phrase that words start with.
+func matchPhrase(words []string, phrases []advisoryPhrase) (advisoryPhrase, bool) {
+	for _, p := range phrases {
+		if len(words) >= len(p.words) && slices.Equal(words[:len(p.words)], p.words) {
+			return p, true
+		}
+	}
+
+	return advisoryPhrase{}, false
+}
+
+// trimVersionToken removes the punctuation around a version in an advisory.
+func trimVersionToken(s string) string {
+	return strings.Trim(s, ".,:()\"'")
+}
+
+// isVersionToken reports whether s looks like a version or a comparator in
+// a constraint.
+func isVersionToken(s string) bool {
+	s = strings.TrimLeft(s, "<>=!~^")
+	s = strings.TrimPrefix(s, "v")
+
+	return s != "" && (isDigit(s[0]) || s[0] == 'x' || s[0] == '*')
+}
+
+// intervalToAffectedRange returns the AffectedRange that contains the versions
+// in the interval.
+func intervalToAffectedRange(i Interval) (AffectedRange, error) {
+	r := AffectedRange{Type: osvTypeSemver, Events: make([]AffectedEvent, 0, 2)} //nolint:mnd // bounds
+
+	switch {
+	case i.Lower.Version == nil:
+		r.Events = append(r.Events, AffectedEvent{Version: nil, Kind: Introduced})
+	case i.Lower.Inclusive:
+		r.Events = append(r.Events, AffectedEvent{Version: i.Lower.Version, Kind: Introduced})
+	default:
+		next, err := successor(i.Lower.Version)
+		if err != nil {
+			return AffectedRange{}, err
+		}
+
+		r.Events = append(r.Events, AffectedEvent{Version: next, Kind: Introduced})
+	}
+
+	switch {
+	case i.Upper.Version == nil:
+	case i.Upper.Inclusive:
+		r.Events = append(r.Events, AffectedEvent{Version: i.Upper.Version, Kind: LastAffected})
+	default:
+		r.Events = append(r.Events, AffectedEvent{Version: i.Upper.Version, Kind: Fixed})
+	}
+
+	return r, nil
+}
+
+// successor returns the lowest version that has higher precedence than v.
+func successor(v *Version) (*Version, error) {
+	if len(v.Prerelease) > 0 {
+		p := make(Prerelease, len(v.Prerelease), len(v.Prerelease)+1)
+		copy(p, v.Prerelease)
+
+		return &Version{
+			Major:      v.Major,
+			Minor:      v.Minor,
+			Patch:      v.Patch,
+			Prerelease: append(p, numericIdentifier{0}),
+			Build:      nil,
+		}, nil
+	}
+
+	if v.Patch == math.MaxUint64 {
+		return nil, fmt.Errorf("%w: no version follows %s", ErrInvalidConstraint, v)
+	}
+
+	return lowestVersion(v.Major, v.Minor, v.Patch+1), nil
+}
@@ -0,0 +1,387 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/anttikivi/semver"
+)
+
+func TestAffectedRangesContains(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		ranges string
+		v      string
+		want   bool
+	}{
+		{
+			"introduced zero",
+			`[{"type":"SEMVER","events":[{"introduced":"0"},{"fixed":"1.2.3"}]}]`,
+			"0.0.1",
+			true,
+		},
+		{
+			"fixed",
+			`[{"type":"SEMVER","events":[{"introduced":"0"},{"fixed":"1.2.3"}]}]`,
+			"1.2.3",
+			false,
+		},
+		{
+			"before fixed pre-release",
+			`[{"type":"SEMVER","events":[{"introduced":"0"},{"fixed":"1.2.3"}]}]`,
+			"1.2.3-rc.1",
+			true,
+		},
+		{
+			"before introduced",
+			`[{"type":"SEMVER","events":[{"introduced":"1.0.0"},{"fixed":"1.2.3"}]}]`,
+			"0.9.0",
+			false,
+		},
+		{
+			"last affected",
+			`[{"type":"SEMVER","events":[{"introduced":"1.0.0"},{"last_affected":"1.2.3"}]}]`,
+			"1.2.3",
+			true,
+		},
+		{
+			"after last affected",
+			`[{"type":"SEMVER","events":[{"introduced":"1.0.0"},{"last_affected":"1.2.3"}]}]`,
+			"1.2.4",
+			false,
+		},
+		{
+			"never fixed",
+			`[{"type":"SEMVER","events":[{"introduced":"1.0.0"}]}]`,
+			"100.0.0",
+			true,
+		},
+		{
+			"reintroduced",
+			`[{"type":"SEMVER","events":[{"introduced":"1.0.0"},{"fixed":"1.1.0"},{"introduced":"2.0.0"},{"fixed":"2.0.5"}]}]`,
+			"2.0.1",
+			true,
+		},
+		{
+			"between ranges",
+			`[{"type":"SEMVER","events":[{"fixed":"1.1.0"},{"introduced":"2.0.0"},{"introduced":"1.0.0"},{"fixed":"2.0.5"}]}]`,
+			"1.5.0",
+			false,
+		},
+		{
+			"limit",
+			`[{"type":"SEMVER","events":[{"introduced":"1.0.0"},{"limit":"2.0.0"}]}]`,
+			"2.1.0",
+			false,
+		},
+		{
+			"unlimited",
+			`[{"type":"SEMVER","events":[{"introduced":"1.0.0"},{"limit":"*"}]}]`,
+			"2.1.0",
+			true,
+		},
+		{
+			"multiple ranges",
+			`[{"type":"SEMVER","events":[{"introduced":"1.0.0"},{"fixed":"1.0.5"}]},{"type":"ECOSYSTEM","events":[{"introduced":"3.0.0"},{"fixed":"3.1.0"}]}]`,
+			"3.0.9",
+			true,
+		},
+		{
+			"git skipped",
+			`[{"type":"GIT","repo":"https://example.com/repo","events":[{"introduced":"abc"}]}]`,
+			"1.0.0",
+			false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			r, err := semver.ParseAffectedRanges([]byte(tt.ranges))
+			if err != nil {
+				t.Fatalf("ParseAffectedRanges(%s) failed unexpectedly: %v", tt.ranges, err)
+			}
+
+			if got := r.Contains(semver.MustParse(tt.v)); got != tt.want {
+				t.Errorf("AffectedRanges(%s).Contains(%q) = %v, want %v", tt.ranges, tt.v, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseAffectedRangesInvalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []string{
+		`{}`,
+		`[{"type":"SEMVER","events":[]}]`,
+		`[{"type":"SEMVER","events":[{}]}]`,
+		`[{"type":"SEMVER","events":[{"introduced":"1.0.0","fixed":"2.0.0"}]}]`,
+		`[{"type":"SEMVER","events":[{"introduced":"bad"}]}]`,
+		`[{"type":"SEMVER","events":[{"fixed":"0"}]}]`,
+	}
+
+	for _, tt := range tests {
+		t.Run(tt, func(t *testing.T) {
+			t.Parallel()
+
+			r, err := semver.ParseAffectedRanges([]byte(tt))
+			if err == nil {
+				t.Fatalf("ParseAffectedRanges(%s) = %v, want error", tt, r)
+			}
+
+			if !errors.Is(err, semver.ErrInvalidAffectedRange) {
+				t.Errorf("ParseAffectedRanges(%s) error = %v, want ErrInvalidAffectedRange", tt, err)
+			}
+		})
+	}
+}
+
+func TestAffectedRangeJSON(t *testing.T) {
+	t.Parallel()
+
+	in := `{"type":"SEMVER","events":[{"introduced":"0"},{"fixed":"1.2.3-rc.1"},{"limit":"*"}]}`
+
+	var r semver.AffectedRange
+
+	if err := json.Unmarshal([]byte(in), &r); err != nil {
+		t.Fatalf("json.Unmarshal(%s) failed unexpectedly: %v", in, err)
+	}
+
+	out, err := json.Marshal(r)
+	if err != nil {
+		t.Fatalf("json.Marshal(%v) failed unexpectedly: %v", r, err)
+	}
+
+	if string(out) != in {
+		t.Errorf("json.Marshal(json.Unmarshal(%s)) = %s", in, out)
+	}
+
+	err = json.Unmarshal([]byte(`{"type":"GIT","events":[{"introduced":"abc"}]}`), &r)
+	if !errors.Is(err, semver.ErrUnsupportedRangeType) {
+		t.Errorf("json.Unmarshal() of a GIT range error = %v, want ErrUnsupportedRangeType", err)
+	}
+}
+
+func TestImportConstraint(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		s     string
+		in    []string
+		notIn []string
+	}{
+		{">= 1.2.0, < 1.4.5", []string{"1.2.0", "1.4.4"}, []string{"1.1.9", "1.4.5"}},
+		{">=1.2.0,<1.4.5", []string{"1.3.0"}, []string{"1.4.5"}},
+		{"fixed in 2.3.1", []string{"0.0.1", "2.3.0"}, []string{"2.3.1"}},
+		{"fixed in: 1.2.3", []string{"1.2.2"}, []string{"1.2.3"}},
+		{"Introduced in: 1.0.0, fixed in: 1.2.3", []string{"1.0.0"}, []string{"0.9.9", "1.2.3"}},
+		{"Fixed in version v2.3.1.", []string{"2.3.0"}, []string{"2.3.1"}},
+		{"versions prior to 1.5", []string{"1.4.9"}, []string{"1.5.0"}},
+		{"all versions before 3.0.0", []string{"2.9.9"}, []string{"3.0.0"}},
+		{"1.2.3 and earlier", []string{"1.2.3", "0.1.0"}, []string{"1.2.4"}},
+		{"1.2.3 and later", []string{"1.2.3", "9.0.0"}, []string{"1.2.2"}},
+		{"1.2.3 or earlier", []string{"1.2.3", "0.1.0"}, []string{"1.2.4"}},
+		{"1.2.3 or below", []string{"1.2.3"}, []string{"1.2.4"}},
+		{"1.2.3 or older", []string{"1.2.3"}, []string{"1.2.4"}},
+		{"1.2.3 or lower", []string{"1.2.3"}, []string{"1.2.4"}},
+		{"1.2.3 or later", []string{"1.2.3", "9.0.0"}, []string{"1.2.2"}},
+		{"1.2.3 or above", []string{"1.2.3"}, []string{"1.2.2"}},
+		{"1.2.3 or newer", []string{"1.2.3"}, []string{"1.2.2"}},
+		{"1.2.3 or higher", []string{"1.2.3"}, []string{"1.2.2"}},
+		{"less than or equal to 1.2.3", []string{"1.2.3"}, []string{"1.2.4"}},
+		{"greater than or equal to 1.2.3", []string{"1.2.3"}, []string{"1.2.2"}},
+		{
+			"Greater than or equal to 1.0.0 and less than 1.2.0, or 2.0.0 or later",
+			[]string{"1.0.0", "1.1.9", "2.0.0"},
+			[]string{"0.9.0", "1.2.0"},
+		},
+		{"up to (including) 1.2.3", []string{"1.2.3"}, []string{"1.2.4"}},
+		{"up to (excluding) 1.2.3", []string{"1.2.2"}, []string{"1.2.3"}},
+		{"introduced in 1.0.0, fixed in 1.2.3", []string{"1.0.0", "1.2.2"}, []string{"0.9.9", "1.2.3"}},
+		{"from 1.0.0 before 1.1.0", []string{"1.0.5"}, []string{"1.1.0"}},
+		{"≥ 1.0.0, ≤ 1.0.5", []string{"1.0.5"}, []string{"1.0.6"}},
+		{"< 1.0.0 || >= 2.0.0, < 2.0.4", []string{"0.9.0", "2.0.3"}, []string{"1.0.0", "2.0.4"}},
+		{"<1.0.0; >=2.0.0 <2.0.4", []string{"0.9.0", "2.0.3"}, []string{"1.0.0", "2.0.4"}},
+		{"< 1.0.0 or >= 2.0.0", []string{"0.9.0", "2.0.0"}, []string{"1.0.0"}},
+		{"[1.0.0,2.0.0)", []string{"1.0.0", "1.9.9"}, []string{"2.0.0"}},
+		{"(1.0.0,2.0.0]", []string{"1.0.1", "2.0.0"}, []string{"1.0.0", "2.0.1"}},
+		{"(,1.2.3]", []string{"0.0.1", "1.2.3"}, []string{"1.2.4"}},
+		{"[1.0,1.2),[1.5,1.6)", []string{"1.1.0", "1.5.5"}, []string{"1.3.0", "1.6.0"}},
+		{"[1.0.0,2.0.0), [3.0.0,4.0.0)", []string{"1.0.0", "3.9.9"}, []string{"2.0.0", "4.0.0"}},
+		{"(,1.0.0] ,(1.5.0,1.6.0]", []string{"1.0.0", "1.6.0"}, []string{"1.5.0", "1.6.1"}},
+		{"1.0.0 - 1.2.3", []string{"1.2.3"}, []string{"1.2.4"}},
+		{"1.0.0 – 1.2.3", []string{"1.2.3"}, []string{"1.2.4"}},
+		{"= 1.2.3-RC1", []string{"1.2.3-RC1"}, []string{"1.2.3-rc1"}},
+		{"All versions", []string{"0.0.0", "5.0.0"}, []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			t.Parallel()
+
+			c, err := semver.ImportConstraint(tt.s)
+			if err != nil {
+				t.Fatalf("ImportConstraint(%q) failed unexpectedly: %v", tt.s, err)
+			}
+
+			for _, v := range tt.in {
+				if !c.Check(semver.MustParse(v)) {
+					t.Errorf("ImportConstraint(%q) = %q, does not contain %q", tt.s, c, v)
+				}
+			}
+
+			for _, v := range tt.notIn {
+				if c.Check(semver.MustParse(v)) {
+					t.Errorf("ImportConstraint(%q) = %q, contains %q", tt.s, c, v)
+				}
+			}
+		})
+	}
+}
+
+func TestImportConstraintInvalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		s     string
+		token string
+	}{
+		{"fixed in", "fixed in"},
+		{"up to 1.2.3", "up to"},
+		{"somewhere before 1.2.3", "somewhere"},
+		{">= 1.2.0 ||", ""},
+		{"< 1.2.3.4", ""},
+		{"[1.0.0", "[1.0.0"},
+		{"(1.0.0)", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			t.Parallel()
+
+			c, err := semver.ImportConstraint(tt.s)
+			if err == nil {
+				t.Fatalf("ImportConstraint(%q) = %v, want error", tt.s, c)
+			}
+
+			if !errors.Is(err, semver.ErrInvalidConstraint) {
+				t.Errorf("ImportConstraint(%q) error = %v, want ErrInvalidConstraint", tt.s, err)
+			}
+
+			var ie *semver.ImportError
+			if !errors.As(err, &ie) {
+				t.Fatalf("ImportConstraint(%q) error = %v, want *ImportError", tt.s, err)
+			}
+
+			if ie.Input != tt.s || ie.Token != tt.token {
+				t.Errorf(
+					"ImportConstraint(%q) error input and token = %q, %q, want %q, %q",
+					tt.s,
+					ie.Input,
+					ie.Token,
+					tt.s,
+					tt.token,
+				)
+			}
+		})
+	}
+}
+
+func TestImportConstraintClause(t *testing.T) {
+	t.Parallel()
+
+	s := ">= 1.0.0, < 1.2.0 or < 1.2.3.4"
+
+	_, err := semver.ImportConstraint(s)
+
+	var ie *semver.ImportError
+	if !errors.As(err, &ie) {
+		t.Fatalf("ImportConstraint(%q) error = %v, want *ImportError", s, err)
+	}
+
+	if want := "<1.2.3.4"; ie.Clause != want {
+		t.Errorf("ImportConstraint(%q) error clause = %q, want %q", s, ie.Clause, want)
+	}
+}
+
+func TestImportAffectedRanges(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		s    string
+		want string
+	}{
+		{
+			"fixed in 2.3.1",
+			`[{"type":"SEMVER","events":[{"introduced":"0"},{"fixed":"2.3.1"}]}]`,
+		},
+		{
+			">= 1.2.0, < 1.4.5 || 2.0.0 and earlier",
+			`[{"type":"SEMVER","events":[{"introduced":"1.2.0"},{"fixed":"1.4.5"}]},{"type":"SEMVER","events":[{"introduced":"0"},{"last_affected":"2.0.0"}]}]`,
+		},
+		{
+			"> 1.2.3",
+			`[{"type":"SEMVER","events":[{"introduced":"1.2.4-0"}]}]`,
+		},
+		{
+			">1.0.0-beta <=1.0.0",
+			`[{"type":"SEMVER","events":[{"introduced":"1.0.0-beta.0"},{"last_affected":"1.0.0"}]}]`,
+		},
+		{
+			">=1.0.0 <2.0.0 !=1.5.0",
+			`[{"type":"SEMVER","events":[{"introduced":"1.0.0"},{"fixed":"1.5.0"}]},{"type":"SEMVER","events":[{"introduced":"1.5.1-0"},{"fixed":"2.0.0"}]}]`,
+		},
+		{
+			"=1.2.3",
+			`[{"type":"SEMVER","events":[{"introduced":"1.2.3"},{"last_affected":"1.2.3"}]}]`,
+		},
+		{
+			">2.0.0 <1.0.0",
+			`null`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			t.Parallel()
+
+			r, err := semver.ImportAffectedRanges(tt.s)
+			if err != nil {
+				t.Fatalf("ImportAffectedRanges(%q) failed unexpectedly: %v", tt.s, err)
+			}
+
+			b, err := json.Marshal(r)
+			if err != nil {
+				t.Fatalf("json.Marshal(%v) failed unexpectedly: %v", r, err)
+			}
+
+			if string(b) != tt.want {
+				t.Errorf("ImportAffectedRanges(%q) = %s, want %s", tt.s, b, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,127 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Errors returned by the alias resolver.
+var (
+	// ErrUnknownAlias is returned when resolving an alias that is not
+	// registered and is not a valid constraint.
+	ErrUnknownAlias = errors.New("unknown version alias")
+
+	// ErrNoMatchingVersion is returned when none of the available versions
+	// match the requested alias or constraint.
+	ErrNoMatchingVersion = errors.New("no matching version")
+)
+
+// An AliasResolver maps symbolic version names, like "latest", "stable", or
+// "lts", to constraints or concrete versions. It can be used to resolve
+// the version a user requests, for example, in a command like
+// "tool install latest". The alias names are not case sensitive.
+//
+// An AliasResolver is not safe for concurrent use if any of the goroutines
+// modifies the aliases.
+type AliasResolver struct {
+	aliases map[string]aliasTarget
+}
+
+// An aliasTarget is the target of an alias. Only one of the fields is set.
+type aliasTarget struct {
+	c *Constraint
+	v *Version
+}
+
+// NewAliasResolver returns a new AliasResolver. The returned resolver maps
+// "latest" to the greatest version that doesn't have pre-release identifiers.
+func NewAliasResolver() *AliasResolver {
+	r := &AliasResolver{aliases: make(map[string]aliasTarget)}
+	r.SetConstraint("latest", MustParseConstraint("*"))
+
+	return r
+}
+
+// SetConstraint maps alias to the greatest available version that satisfies
+// c.
+func (r *AliasResolver) SetConstraint(alias string, c *Constraint) {
+	r.set(alias, aliasTarget{c: c, v: nil})
+}
+
+// SetVersion maps alias to the concrete version v. The resolver stores a copy
+// of v.
+func (r *AliasResolver) SetVersion(alias string, v *Version) {
+	r.set(alias, aliasTarget{c: nil, v: v.Clone()})
+}
+
+// Remove removes alias from the resolver.
+func (r *AliasResolver) Remove(alias string) {
+	delete(r.aliases, strings.ToLower(alias))
+}
+
+// Resolve returns the version in available that alias refers to. If alias is
+// mapped to a constraint, the greatest version in available that satisfies
+// the constraint is returned. If alias is mapped to a concrete version,
+// the version is returned from available if it contains an equal version. If
+// alias is not registered, it is parsed as a constraint, so Resolve also
+// resolves requests like "1.2" or "^2.0.0".
+//
+// If alias is neither registered nor a valid constraint, the returned error
+// wraps ErrUnknownAlias. If no version matches, the error wraps
+// ErrNoMatchingVersion.
+func (r *AliasResolver) Resolve(alias string, available Versions) (*Version, error) {
+	t, ok := r.aliases[strings.ToLower(alias)]
+	if !ok {
+		c, err := ParseConstraint(alias)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %q: %w", ErrUnknownAlias, alias, err)
+		}
+
+		t.c = c
+	}
+
+	if t.v != nil {
+		for _, v := range available {
+			if v.Equal(t.v) {
+				return v, nil
+			}
+		}
+
+		return nil, fmt.Errorf("%w: %q refers to %s that is not available", ErrNoMatchingVersion, alias, t.v)
+	}
+
+	v := t.c.MaxSatisfying(available)
+	if v == nil {
+		return nil, fmt.Errorf("%w: %q (%s)", ErrNoMatchingVersion, alias, t.c)
+	}
+
+	return v, nil
+}
+
+func (r *AliasResolver) set(alias string, t aliasTarget) {
+	if r.aliases == nil {
+		r.aliases = make(map[string]aliasTarget)
+	}
+
+	r.aliases[strings.ToLower(alias)] = t
+}
@@ -0,0 +1,101 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/anttikivi/semver"
+)
+
+func TestAliasResolverResolve(t *testing.T) {
+	t.Parallel()
+
+	available := semver.Versions{
+		semver.MustParse("1.2.3"),
+		semver.MustParse("1.4.0"),
+		semver.MustParse("2.0.0"),
+		semver.MustParse("2.1.0+build.7"),
+		semver.MustParse("3.0.0-rc.1"),
+	}
+
+	r := semver.NewAliasResolver()
+	r.SetConstraint("lts", semver.MustParseConstraint("^1.0.0"))
+	r.SetConstraint("next", semver.MustParseConstraint(">=3.0.0-0"))
+	r.SetVersion("stable", semver.MustParse("2.1.0"))
+	r.SetVersion("old", semver.MustParse("0.1.0"))
+
+	tests := []struct {
+		alias   string
+		want    string
+		wantErr error
+	}{
+		{"latest", "2.1.0+build.7", nil},
+		{"LATEST", "2.1.0+build.7", nil},
+		{"lts", "1.4.0", nil},
+		{"next", "3.0.0-rc.1", nil},
+		{"stable", "2.1.0+build.7", nil},
+		{"1.2", "1.2.3", nil},
+		{"^2.0.0", "2.1.0+build.7", nil},
+		{"old", "", semver.ErrNoMatchingVersion},
+		{"^4.0.0", "", semver.ErrNoMatchingVersion},
+		{"nightly", "", semver.ErrUnknownAlias},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.alias, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := r.Resolve(tt.alias, available)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("AliasResolver.Resolve(%q) error = %v, want %v", tt.alias, err, tt.wantErr)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("AliasResolver.Resolve(%q) failed unexpectedly: %v", tt.alias, err)
+			}
+
+			if got.String() != tt.want {
+				t.Errorf("AliasResolver.Resolve(%q) = %q, want %q", tt.alias, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAliasResolverRemove(t *testing.T) {
+	t.Parallel()
+
+	var r semver.AliasResolver
+
+	r.SetVersion("pinned", semver.MustParse("1.0.0"))
+	r.Remove("Pinned")
+
+	if _, err := r.Resolve("pinned", semver.Versions{semver.MustParse("1.0.0")}); !errors.Is(
+		err,
+		semver.ErrUnknownAlias,
+	) {
+		t.Errorf("AliasResolver.Resolve() of a removed alias error = %v, want ErrUnknownAlias", err)
+	}
+}
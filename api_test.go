@@ -0,0 +1,50 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver_test
+
+import (
+	"testing"
+
+	"github.com/anttikivi/semver/internal/apicheck"
+)
+
+func TestAPI(t *testing.T) {
+	t.Parallel()
+
+	want, err := apicheck.Read("api.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := apicheck.Generate(".")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	removed, added := apicheck.Compare(want, got)
+
+	for _, line := range removed {
+		t.Errorf("incompatible API change: removed or changed %q", line)
+	}
+
+	for _, line := range added {
+		t.Errorf("API addition not in api.txt: %q; run go run ./cmd/apicheck -w", line)
+	}
+}
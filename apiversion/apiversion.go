@@ -0,0 +1,279 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+// Package apiversion implements helpers for servers that version their APIs
+// using semantic versions. It parses the requested API version from versioned
+// media types, like "application/vnd.example.v2+json", and from headers, like
+// "X-API-Version: 1.4", and routes the requests to handlers by version
+// constraints using [VersionMux]. It also produces warnings for clients whose
+// versions are not supported by the server according to a [Policy].
+package apiversion
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/anttikivi/semver"
+)
+
+// DefaultHeader is the default name of the header that holds the requested API
+// version.
+const DefaultHeader = "X-API-Version"
+
+// ErrNoVersion is returned when a media type or a request does not specify
+// an API version.
+var ErrNoVersion = errors.New("no API version")
+
+// A VersionMux is an HTTP request multiplexer that routes the requests to
+// handlers by the requested API version. The requested version is read using
+// [FromRequest]. Each handler is registered with a constraint, and a request is
+// routed to the first registered handler whose constraint the requested version
+// satisfies. The requested version is stored in the context of the request
+// passed to the handler, and it can be read using [FromContext].
+//
+// If the request specifies an invalid version, the VersionMux replies with
+// "400 Bad Request". If no handler accepts the version, it replies with
+// "406 Not Acceptable".
+type VersionMux struct {
+	// Default is the version used for the requests that don't specify
+	// an API version. If it is nil, such requests are rejected with "400 Bad
+	// Request".
+	Default *semver.Version
+
+	// Header is the name of the header that holds the requested version. If
+	// it is empty, DefaultHeader is used.
+	Header string
+
+	routes []route
+}
+
+// A route is a handler registered in a VersionMux.
+type route struct {
+	c *semver.Constraint
+	h http.Handler
+}
+
+// contextKey is the type of the context key for the requested version.
+type contextKey struct{}
+
+// ParseMediaType parses the API version from a versioned media type. The
+// version may be written as a segment of the subtype, like in
+// "application/vnd.example.v2+json" or "application/vnd.example.v2.1+json", or
+// as a "version" parameter, like in "application/vnd.example+json;
+// version=2.1". The version may be partial. If the media type does not specify
+// a version, the returned error wraps ErrNoVersion.
+func ParseMediaType(s string) (*semver.Version, error) {
+	mediatype, params, err := mime.ParseMediaType(s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid media type %q: %w", s, err)
+	}
+
+	if p, ok := params["version"]; ok {
+		return ParseHeader(p)
+	}
+
+	_, subtype, _ := strings.Cut(mediatype, "/")
+	subtype, _, _ = strings.Cut(subtype, "+")
+	segments := strings.Split(subtype, ".")
+
+	for i, seg := range segments {
+		if len(seg) < 2 || seg[0] != 'v' || !isNumber(seg[1:]) { //nolint:mnd // "v" and a digit
+			continue
+		}
+
+		nums := []string{seg[1:]}
+
+		for _, next := range segments[i+1:] {
+			if !isNumber(next) || len(nums) == 3 { //nolint:mnd // <major>.<minor>.<patch>
+				break
+			}
+
+			nums = append(nums, next)
+		}
+
+		v, err := semver.ParseLax(strings.Join(nums, "."))
+		if err != nil {
+			return nil, fmt.Errorf("invalid version in media type %q: %w", s, err)
+		}
+
+		return v, nil
+	}
+
+	return nil, fmt.Errorf("%w in media type %q", ErrNoVersion, s)
+}
+
+// ParseHeader parses the API version from the value of a version header, for
+// example, "1.4" or "v2". The version may be partial. If the value is empty,
+// the returned error wraps ErrNoVersion.
+func ParseHeader(s string) (*semver.Version, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return nil, ErrNoVersion
+	}
+
+	v, err := semver.ParseLax(s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid API version %q: %w", s, err)
+	}
+
+	return v, nil
+}
+
+// FromRequest returns the API version requested by r. The version is read from
+// the header with the given name, and if the header is not set, from
+// the versioned media types in the Accept header. If the Accept header has
+// multiple versioned media types, the one with the highest quality value is
+// used. If the request does not specify a version, the returned error wraps
+// ErrNoVersion.
+func FromRequest(r *http.Request, header string) (*semver.Version, error) {
+	if s := r.Header.Get(header); s != "" {
+		return ParseHeader(s)
+	}
+
+	var (
+		best  *semver.Version
+		bestQ = -1.0
+	)
+
+	for _, accept := range r.Header.Values("Accept") {
+		for mt := range strings.SplitSeq(accept, ",") {
+			v, err := ParseMediaType(strings.TrimSpace(mt))
+			if errors.Is(err, ErrNoVersion) {
+				continue
+			}
+
+			if err != nil {
+				return nil, err
+			}
+
+			if q := quality(mt); q > bestQ {
+				best, bestQ = v, q
+			}
+		}
+	}
+
+	if best == nil {
+		return nil, ErrNoVersion
+	}
+
+	return best, nil
+}
+
+// FromContext returns the API version stored in ctx by a VersionMux.
+func FromContext(ctx context.Context) (*semver.Version, bool) {
+	v, ok := ctx.Value(contextKey{}).(*semver.Version)
+
+	return v, ok
+}
+
+// NewContext returns a copy of ctx that holds the API version v.
+func NewContext(ctx context.Context, v *semver.Version) context.Context {
+	return context.WithValue(ctx, contextKey{}, v)
+}
+
+// Handle registers the handler for the API versions that satisfy c.
+func (m *VersionMux) Handle(c *semver.Constraint, h http.Handler) {
+	m.routes = append(m.routes, route{c: c, h: h})
+}
+
+// HandleFunc registers the handler function for the API versions that satisfy
+// c.
+func (m *VersionMux) HandleFunc(c *semver.Constraint, f func(http.ResponseWriter, *http.Request)) {
+	m.Handle(c, http.HandlerFunc(f))
+}
+
+// Handler returns the handler for the API version v. It returns nil if none of
+// the handlers accept the version.
+func (m *VersionMux) Handler(v *semver.Version) http.Handler {
+	for _, r := range m.routes {
+		if r.c.Check(v) {
+			return r.h
+		}
+	}
+
+	return nil
+}
+
+// ServeHTTP dispatches the request to the handler for the requested API
+// version.
+func (m *VersionMux) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	header := m.Header
+	if header == "" {
+		header = DefaultHeader
+	}
+
+	v, err := FromRequest(r, header)
+	if errors.Is(err, ErrNoVersion) && m.Default != nil {
+		v, err = m.Default, nil
+	}
+
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+
+		return
+	}
+
+	h := m.Handler(v)
+	if h == nil {
+		http.Error(w, "unsupported API version "+v.String(), http.StatusNotAcceptable)
+
+		return
+	}
+
+	h.ServeHTTP(w, r.WithContext(NewContext(r.Context(), v)))
+}
+
+// quality returns the quality value of a media range in the Accept header.
+func quality(mt string) float64 {
+	_, params, err := mime.ParseMediaType(mt)
+	if err != nil {
+		return 0
+	}
+
+	q, ok := params["q"]
+	if !ok {
+		return 1
+	}
+
+	f, err := strconv.ParseFloat(q, 64)
+	if err != nil {
+		return 0
+	}
+
+	return f
+}
+
+func isNumber(s string) bool {
+	if s == "" {
+		return false
+	}
+
+	for i := range len(s) {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+
+	return true
+}
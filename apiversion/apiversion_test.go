@@ -0,0 +1,187 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package apiversion_test
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/anttikivi/semver"
+	"github.com/anttikivi/semver/apiversion"
+)
+
+func TestParseMediaType(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		mt      string
+		want    string
+		wantErr error
+	}{
+		{"application/vnd.foo.v2+json", "2.0.0", nil},
+		{"application/vnd.foo.v2.1+json", "2.1.0", nil},
+		{"application/vnd.github.v3.raw+json", "3.0.0", nil},
+		{"application/vnd.foo.v1.2.3.4+json", "1.2.3", nil},
+		{"application/vnd.foo+json; version=1.4", "1.4.0", nil},
+		{"application/vnd.foo+json; version=v2", "2.0.0", nil},
+		{"application/json", "", apiversion.ErrNoVersion},
+		{"application/vnd.foo.version+json", "", apiversion.ErrNoVersion},
+		{"application/vnd.foo.v01+json", "", semver.ErrInvalidVersion},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.mt, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := apiversion.ParseMediaType(tt.mt)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("ParseMediaType(%q) error = %v, want %v", tt.mt, err, tt.wantErr)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("ParseMediaType(%q) failed unexpectedly: %v", tt.mt, err)
+			}
+
+			if got.String() != tt.want {
+				t.Errorf("ParseMediaType(%q) = %q, want %q", tt.mt, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFromRequest(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		header  string
+		accept  string
+		want    string
+		wantErr bool
+	}{
+		{"header", "1.4", "application/vnd.foo.v2+json", "1.4.0", false},
+		{"accept", "", "application/vnd.foo.v2+json", "2.0.0", false},
+		{
+			"quality",
+			"",
+			"application/vnd.foo.v1+json;q=0.5, application/vnd.foo.v3+json;q=0.9, application/json",
+			"3.0.0",
+			false,
+		},
+		{"none", "", "application/json", "", true},
+		{"invalid header", "a.b", "", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			r := httptest.NewRequest(http.MethodGet, "/", nil)
+
+			if tt.header != "" {
+				r.Header.Set(apiversion.DefaultHeader, tt.header)
+			}
+
+			if tt.accept != "" {
+				r.Header.Set("Accept", tt.accept)
+			}
+
+			got, err := apiversion.FromRequest(r, apiversion.DefaultHeader)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("FromRequest() = %q, want error", got)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("FromRequest() failed unexpectedly: %v", err)
+			}
+
+			if got.String() != tt.want {
+				t.Errorf("FromRequest() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVersionMux(t *testing.T) {
+	t.Parallel()
+
+	handler := func(name string) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			v, _ := apiversion.FromContext(r.Context())
+			_, _ = io.WriteString(w, name+" "+v.String())
+		}
+	}
+
+	mux := &apiversion.VersionMux{Default: semver.MustParse("1.0.0"), Header: ""}
+	mux.Handle(semver.MustParseConstraint("^1.0.0"), handler("v1"))
+	mux.HandleFunc(semver.MustParseConstraint(">=2.0.0 <2.5.0"), handler("v2"))
+
+	tests := []struct {
+		name       string
+		header     string
+		accept     string
+		wantStatus int
+		wantBody   string
+	}{
+		{"default", "", "", http.StatusOK, "v1 1.0.0"},
+		{"header", "1.3", "", http.StatusOK, "v1 1.3.0"},
+		{"accept", "", "application/vnd.foo.v2.4+json", http.StatusOK, "v2 2.4.0"},
+		{"unsupported", "2.5", "", http.StatusNotAcceptable, ""},
+		{"invalid", "abc", "", http.StatusBadRequest, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			r := httptest.NewRequest(http.MethodGet, "/", nil)
+
+			if tt.header != "" {
+				r.Header.Set(apiversion.DefaultHeader, tt.header)
+			}
+
+			if tt.accept != "" {
+				r.Header.Set("Accept", tt.accept)
+			}
+
+			w := httptest.NewRecorder()
+			mux.ServeHTTP(w, r)
+
+			if w.Code != tt.wantStatus {
+				t.Errorf("VersionMux.ServeHTTP() status = %d, want %d", w.Code, tt.wantStatus)
+			}
+
+			if tt.wantBody != "" && w.Body.String() != tt.wantBody {
+				t.Errorf("VersionMux.ServeHTTP() body = %q, want %q", w.Body.String(), tt.wantBody)
+			}
+		})
+	}
+}
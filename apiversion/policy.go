@@ -0,0 +1,194 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package apiversion
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/anttikivi/semver"
+)
+
+// MetadataKey is the metadata key for the version warnings in protocols that
+// use metadata instead of HTTP headers, like gRPC.
+const MetadataKey = "x-api-version-warning"
+
+// warnCode is the warn code of the Warning header for miscellaneous persistent
+// warnings.
+const warnCode = 299
+
+// Values for WarningKind.
+const (
+	// ClientUnsupported means that the client version is below the minimum
+	// supported version.
+	ClientUnsupported WarningKind = iota + 1
+
+	// ClientDeprecated means that the client version is deprecated but still
+	// supported.
+	ClientDeprecated
+
+	// ClientOutdated means that the client version is more major versions
+	// behind the server than the policy allows.
+	ClientOutdated
+
+	// ClientNewer means that the client version has a greater major version
+	// than the server.
+	ClientNewer
+)
+
+// A Policy describes which client versions a server supports.
+type Policy struct {
+	// Minimum is the minimum supported client version. If it is nil, there is
+	// no minimum version.
+	Minimum *semver.Version
+
+	// Deprecated is the constraint for the client versions that are
+	// deprecated but still supported. If it is nil, no versions are
+	// deprecated.
+	Deprecated *semver.Constraint
+
+	// MaxMajorsBehind is the number of major versions the client may be
+	// behind the server. If it is zero, the major version skew is not
+	// checked.
+	MaxMajorsBehind uint64
+
+	// WarnNewer tells whether to warn about clients that have greater major
+	// version than the server.
+	WarnNewer bool
+}
+
+// A Warning is a warning about the version of a client.
+type Warning struct {
+	// Message is the human-readable message of the warning, like "client
+	// version 1.4.0 is below the minimum supported version 2.0.0".
+	Message string
+
+	// Kind is the kind of the warning.
+	Kind WarningKind
+}
+
+// A WarningKind is the kind of a Warning.
+type WarningKind int
+
+// CheckClient returns the warnings about the client version according to
+// policy p. The server version is used for checking the version skew between
+// the client and the server. It returns nil if there are no warnings.
+func CheckClient(server, client *semver.Version, p Policy) []Warning {
+	var warnings []Warning
+
+	if p.Minimum != nil && client.Compare(p.Minimum) < 0 {
+		warnings = append(warnings, Warning{
+			Message: fmt.Sprintf(
+				"client version %s is below the minimum supported version %s",
+				client,
+				p.Minimum,
+			),
+			Kind: ClientUnsupported,
+		})
+	}
+
+	if p.Deprecated != nil && p.Deprecated.Check(client) {
+		warnings = append(warnings, Warning{
+			Message: fmt.Sprintf("client version %s is deprecated", client),
+			Kind:    ClientDeprecated,
+		})
+	}
+
+	if p.MaxMajorsBehind > 0 && server.Major > client.Major &&
+		server.Major-client.Major > p.MaxMajorsBehind {
+		warnings = append(warnings, Warning{
+			Message: fmt.Sprintf(
+				"client version %s is %d major versions behind the server version %s",
+				client,
+				server.Major-client.Major,
+				server,
+			),
+			Kind: ClientOutdated,
+		})
+	}
+
+	if p.WarnNewer && client.Major > server.Major {
+		warnings = append(warnings, Warning{
+			Message: fmt.Sprintf(
+				"client version %s is newer than the server version %s",
+				client,
+				server,
+			),
+			Kind: ClientNewer,
+		})
+	}
+
+	return warnings
+}
+
+// SetWarningHeaders adds the warnings to h as Warning headers. The agent is
+// the name of the server that is included in the headers, for example,
+// the host name. If the agent is empty, "-" is used.
+func SetWarningHeaders(h http.Header, agent string, warnings []Warning) {
+	for _, w := range warnings {
+		h.Add("Warning", w.Header(agent))
+	}
+}
+
+// SetWarningMetadata adds the messages of the warnings to md under
+// [MetadataKey]. The metadata of gRPC has the same type, so md may be
+// a metadata.MD of google.golang.org/grpc/metadata:
+//
+//	md := metadata.MD{}
+//	apiversion.SetWarningMetadata(md, warnings)
+//	grpc.SetHeader(ctx, md)
+func SetWarningMetadata(md map[string][]string, warnings []Warning) {
+	for _, w := range warnings {
+		md[MetadataKey] = append(md[MetadataKey], w.Message)
+	}
+}
+
+// Header returns the warning formatted as the value of a Warning header, like
+// `299 example.com "client version 1.0.0 is deprecated"`. If the agent is
+// empty, "-" is used.
+func (w Warning) Header(agent string) string {
+	if agent == "" {
+		agent = "-"
+	}
+
+	return strconv.Itoa(warnCode) + " " + agent + " " + strconv.Quote(w.Message)
+}
+
+// String returns the message of the warning.
+func (w Warning) String() string {
+	return w.Message
+}
+
+// String returns the name of the warning kind.
+func (k WarningKind) String() string {
+	switch k {
+	case ClientUnsupported:
+		return "unsupported"
+	case ClientDeprecated:
+		return "deprecated"
+	case ClientOutdated:
+		return "outdated"
+	case ClientNewer:
+		return "newer"
+	default:
+		return "WarningKind(" + strconv.Itoa(int(k)) + ")"
+	}
+}
@@ -0,0 +1,118 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package apiversion_test
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/anttikivi/semver"
+	"github.com/anttikivi/semver/apiversion"
+)
+
+func TestCheckClient(t *testing.T) {
+	t.Parallel()
+
+	policy := apiversion.Policy{
+		Minimum:         semver.MustParse("2.0.0"),
+		Deprecated:      semver.MustParseConstraint("<3.0.0"),
+		MaxMajorsBehind: 2,
+		WarnNewer:       true,
+	}
+	server := semver.MustParse("5.1.0")
+
+	tests := []struct {
+		client string
+		want   []apiversion.WarningKind
+	}{
+		{"5.0.0", nil},
+		{"3.0.0", nil},
+		{"2.5.0", []apiversion.WarningKind{apiversion.ClientDeprecated, apiversion.ClientOutdated}},
+		{
+			"1.4.0",
+			[]apiversion.WarningKind{
+				apiversion.ClientUnsupported,
+				apiversion.ClientDeprecated,
+				apiversion.ClientOutdated,
+			},
+		},
+		{"6.0.0", []apiversion.WarningKind{apiversion.ClientNewer}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.client, func(t *testing.T) {
+			t.Parallel()
+
+			warnings := apiversion.CheckClient(server, semver.MustParse(tt.client), policy)
+
+			var got []apiversion.WarningKind
+			for _, w := range warnings {
+				got = append(got, w.Kind)
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("CheckClient(%q) = %v, want %v", tt.client, warnings, tt.want)
+			}
+		})
+	}
+}
+
+func TestSetWarningHeaders(t *testing.T) {
+	t.Parallel()
+
+	warnings := apiversion.CheckClient(
+		semver.MustParse("2.0.0"),
+		semver.MustParse("1.4.0"),
+		apiversion.Policy{Minimum: semver.MustParse("2.0.0")},
+	)
+
+	h := http.Header{}
+	apiversion.SetWarningHeaders(h, "", warnings)
+
+	want := []string{`299 - "client version 1.4.0 is below the minimum supported version 2.0.0"`}
+	if got := h.Values("Warning"); !reflect.DeepEqual(got, want) {
+		t.Errorf("SetWarningHeaders() = %q, want %q", got, want)
+	}
+}
+
+func TestSetWarningMetadata(t *testing.T) {
+	t.Parallel()
+
+	warnings := apiversion.CheckClient(
+		semver.MustParse("3.0.0"),
+		semver.MustParse("1.4.0"),
+		apiversion.Policy{Minimum: semver.MustParse("2.0.0"), MaxMajorsBehind: 1},
+	)
+
+	md := map[string][]string{"other": {"value"}}
+	apiversion.SetWarningMetadata(md, warnings)
+
+	want := map[string][]string{
+		"other": {"value"},
+		apiversion.MetadataKey: {
+			"client version 1.4.0 is below the minimum supported version 2.0.0",
+			"client version 1.4.0 is 2 major versions behind the server version 3.0.0",
+		},
+	}
+	if !reflect.DeepEqual(md, want) {
+		t.Errorf("SetWarningMetadata() = %q, want %q", md, want)
+	}
+}
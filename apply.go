@@ -0,0 +1,156 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Apply returns the version that results from applying the comma-separated
+// operations in expr to v, so release pipelines can express bump rules in
+// configuration, for example "minor+1, patch=0, pre=rc.1". The operations
+// are applied in order, and each of them changes one part of the version:
+//
+//   - "major", "minor", and "patch" can be incremented with "+N",
+//     decremented with "-N", or set with "=N".
+//   - "pre" (or "prerelease") and "build" can be set with "=value", or
+//     removed with "=" without a value. The last identifier of "pre" can be
+//     incremented with "+N" if it is numeric.
+//
+// The other parts of the version are not changed, so "minor+1" alone doesn't
+// reset the patch version. v is not modified. Apply returns an error that
+// wraps [ErrInvalidExpression] if an operation is not valid, and an error
+// that wraps [ErrInvalidVersion] if the result is not a valid version.
+func Apply(v *Version, expr string) (*Version, error) {
+	nums := [3]uint64{v.Major, v.Minor, v.Patch}
+	pre, build := v.Prerelease.String(), v.Build.String()
+
+	for op := range strings.SplitSeq(expr, ",") {
+		op = strings.TrimSpace(op)
+		if op == "" {
+			continue
+		}
+
+		i := strings.IndexAny(op, "+-=")
+		if i < 0 {
+			return nil, fmt.Errorf("%w: missing operator in %q", ErrInvalidExpression, op)
+		}
+
+		field, operator, operand := strings.TrimSpace(op[:i]), op[i], strings.TrimSpace(op[i+1:])
+
+		switch field {
+		case "major", "minor", "patch":
+			j := slices.Index([]string{"major", "minor", "patch"}, field)
+
+			n, err := applyNumber(nums[j], operator, operand)
+			if err != nil {
+				return nil, fmt.Errorf("%w: %q: %w", ErrInvalidExpression, op, err)
+			}
+
+			nums[j] = n
+		case "pre", "prerelease":
+			s, err := applyPrerelease(pre, operator, operand)
+			if err != nil {
+				return nil, fmt.Errorf("%w: %q: %w", ErrInvalidExpression, op, err)
+			}
+
+			pre = s
+		case "build":
+			if operator != '=' {
+				return nil, fmt.Errorf("%w: %q: build can only be set", ErrInvalidExpression, op)
+			}
+
+			build = operand
+		default:
+			return nil, fmt.Errorf("%w: unknown part %q", ErrInvalidExpression, field)
+		}
+	}
+
+	s := strconv.FormatUint(nums[0], 10) + "." + strconv.FormatUint(nums[1], 10) + "." +
+		strconv.FormatUint(nums[2], 10)
+
+	if pre != "" {
+		s += "-" + pre
+	}
+
+	if build != "" {
+		s += "+" + build
+	}
+
+	w, err := Parse(s)
+	if err != nil {
+		return nil, fmt.Errorf("applying %q resulted in an invalid version: %w", expr, err)
+	}
+
+	return w, nil
+}
+
+// applyNumber applies an operation to a version number.
+func applyNumber(n uint64, operator byte, operand string) (uint64, error) {
+	d, err := strconv.ParseUint(operand, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid number %q", operand)
+	}
+
+	switch {
+	case operator == '=':
+		return d, nil
+	case operator == '+' && n > math.MaxUint64-d:
+		return 0, fmt.Errorf("%d+%d overflows", n, d)
+	case operator == '+':
+		return n + d, nil
+	case d > n:
+		return 0, fmt.Errorf("%d-%d is negative", n, d)
+	default:
+		return n - d, nil
+	}
+}
+
+// applyPrerelease applies an operation to a pre-release string.
+func applyPrerelease(pre string, operator byte, operand string) (string, error) {
+	switch operator {
+	case '=':
+		return operand, nil
+	case '+':
+		head, last := "", pre
+		if i := strings.LastIndexByte(pre, '.'); i >= 0 {
+			head, last = pre[:i+1], pre[i+1:]
+		}
+
+		n, err := strconv.ParseUint(last, 10, 64)
+		if err != nil {
+			return "", fmt.Errorf("the last identifier of %q is not numeric", pre)
+		}
+
+		n, err = applyNumber(n, operator, operand)
+		if err != nil {
+			return "", err
+		}
+
+		return head + strconv.FormatUint(n, 10), nil
+	default:
+		return "", errors.New("pre-release can't be decremented")
+	}
+}
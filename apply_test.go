@@ -0,0 +1,87 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/anttikivi/semver"
+)
+
+func TestApply(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		v    string
+		expr string
+		want string
+	}{
+		{"1.2.3", "minor+1, patch=0, pre=rc.1", "1.3.0-rc.1"},
+		{"1.3.0-rc.1", "pre+1", "1.3.0-rc.2"},
+		{"1.3.0-rc.9+b.1", "pre=, build=", "1.3.0"},
+		{"2.0.0", "major-1,minor=9", "1.9.0"},
+		{"1.2.3", "build=sha.abc", "1.2.3+sha.abc"},
+		{"1.2.3", "", "1.2.3"},
+	}
+
+	for _, tt := range tests {
+		v := semver.MustParse(tt.v)
+
+		got, err := semver.Apply(v, tt.expr)
+		if err != nil {
+			t.Errorf("Apply(%q, %q) error = %v", tt.v, tt.expr, err)
+
+			continue
+		}
+
+		if got.String() != tt.want {
+			t.Errorf("Apply(%q, %q) = %q, want %q", tt.v, tt.expr, got, tt.want)
+		}
+
+		if v.String() != tt.v {
+			t.Errorf("Apply(%q, %q) modified the version to %q", tt.v, tt.expr, v)
+		}
+	}
+}
+
+func TestApplyInvalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		expr string
+		want error
+	}{
+		{"minor", semver.ErrInvalidExpression},
+		{"micro+1", semver.ErrInvalidExpression},
+		{"patch-4", semver.ErrInvalidExpression},
+		{"patch+x", semver.ErrInvalidExpression},
+		{"pre+1", semver.ErrInvalidExpression},
+		{"build+1", semver.ErrInvalidExpression},
+		{"pre=rc..1", semver.ErrInvalidVersion},
+		{"build=a+b", semver.ErrInvalidVersion},
+	}
+
+	for _, tt := range tests {
+		if _, err := semver.Apply(semver.MustParse("1.2.3"), tt.expr); !errors.Is(err, tt.want) {
+			t.Errorf("Apply(%q) error = %v, want %v", tt.expr, err, tt.want)
+		}
+	}
+}
@@ -0,0 +1,153 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver
+
+import (
+	"fmt"
+	"slices"
+)
+
+// An ArtifactVersion is a version of an artifact built for a specific
+// platform, written as "<version>-<os>-<arch>", for example
+// "v1.2.3-darwin-arm64". The platform is not part of the precedence of
+// the version, so it is kept separate from the pre-release identifiers.
+type ArtifactVersion struct {
+	*Version
+
+	OS   string
+	Arch string
+}
+
+// A Platform identifies the operating system and the architecture of
+// an artifact.
+type Platform struct {
+	OS   string
+	Arch string
+}
+
+// MustParseArtifactVersion parses the given string into an ArtifactVersion and
+// panics if it encounters an error.
+func MustParseArtifactVersion(s string) *ArtifactVersion {
+	a, err := ParseArtifactVersion(s)
+	if err != nil {
+		panic(fmt.Sprintf("failed to parse the string %q into an artifact version: %v", s, err))
+	}
+
+	return a
+}
+
+// ParseArtifactVersion parses the given string into an ArtifactVersion. If
+// the string ends with an operating system and optionally an architecture,
+// separated by hyphens or underscores, they are parsed as the platform. Only
+// the common operating system and architecture names, like the values of GOOS
+// and GOARCH, are recognized. The rest of the string is parsed using [Parse].
+// For example, "v1.2.3-rc.1-darwin-arm64" is parsed as version "1.2.3-rc.1"
+// for the operating system "darwin" and the architecture "arm64".
+func ParseArtifactVersion(s string) (*ArtifactVersion, error) {
+	// The hyphens are tried first, as an architecture like "x86_64" may
+	// contain an underscore.
+	rest, goos, goarch := cutPlatform(s, '-')
+	if goos == "" {
+		rest, goos, goarch = cutPlatform(s, '_')
+	}
+
+	v, err := Parse(rest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse artifact version: %w", err)
+	}
+
+	return &ArtifactVersion{Version: v, OS: goos, Arch: goarch}, nil
+}
+
+// GroupByPlatform groups the artifacts by their platform. The artifacts in
+// each group are sorted in increasing order of their versions.
+func GroupByPlatform(artifacts []*ArtifactVersion) map[Platform][]*ArtifactVersion {
+	groups := make(map[Platform][]*ArtifactVersion)
+
+	for _, a := range artifacts {
+		p := a.Platform()
+		groups[p] = append(groups[p], a)
+	}
+
+	for _, g := range groups {
+		slices.SortStableFunc(g, func(a, b *ArtifactVersion) int {
+			return a.Version.Compare(b.Version)
+		})
+	}
+
+	return groups
+}
+
+// LatestPerPlatform returns the artifact with the greatest version for each
+// platform. If several artifacts of a platform have the greatest version,
+// the first one of them is returned.
+func LatestPerPlatform(artifacts []*ArtifactVersion) map[Platform]*ArtifactVersion {
+	latest := make(map[Platform]*ArtifactVersion)
+
+	for _, a := range artifacts {
+		p := a.Platform()
+		if l, ok := latest[p]; !ok || a.Version.Compare(l.Version) > 0 {
+			latest[p] = a
+		}
+	}
+
+	return latest
+}
+
+// Artifact returns the version and the platform in the file name as
+// an ArtifactVersion.
+func (f *FileName) Artifact() *ArtifactVersion {
+	return &ArtifactVersion{Version: f.Version, OS: f.OS, Arch: f.Arch}
+}
+
+// Platform returns the platform of the artifact.
+func (a *ArtifactVersion) Platform() Platform {
+	return Platform{OS: a.OS, Arch: a.Arch}
+}
+
+// String returns the string representation of the artifact version. The parts
+// of the platform that are empty are omitted.
+func (a *ArtifactVersion) String() string {
+	s := a.Version.String()
+
+	if a.OS != "" {
+		s += "-" + a.OS
+	}
+
+	if a.Arch != "" {
+		s += "-" + a.Arch
+	}
+
+	return s
+}
+
+// String returns the string representation of the platform in the format of
+// Go, for example "linux/amd64". The parts of the platform that are empty are
+// omitted.
+func (p Platform) String() string {
+	switch {
+	case p.Arch == "":
+		return p.OS
+	case p.OS == "":
+		return p.Arch
+	default:
+		return p.OS + "/" + p.Arch
+	}
+}
@@ -0,0 +1,114 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver_test
+
+import (
+	"testing"
+
+	"github.com/anttikivi/semver"
+)
+
+func TestParseArtifactVersion(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		s        string
+		version  string
+		platform string
+		wantErr  bool
+	}{
+		{"v1.2.3-darwin-arm64", "1.2.3", "darwin/arm64", false},
+		{"1.2.3-rc.1-linux-amd64", "1.2.3-rc.1", "linux/amd64", false},
+		{"1.2.3_windows_x86_64", "1.2.3", "windows/x86_64", false},
+		{"v1.2.3-linux-x86_64", "1.2.3", "linux/x86_64", false},
+		{"1.2.3-rc.1-windows-amd64", "1.2.3-rc.1", "windows/amd64", false},
+		{"1.2.3_darwin_amd64", "1.2.3", "darwin/amd64", false},
+		{"1.2.3-linux", "1.2.3", "linux", false},
+		{"1.2.3+build.5", "1.2.3+build.5", "", false},
+		{"1.2-linux-amd64", "", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := semver.ParseArtifactVersion(tt.s)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseArtifactVersion(%q) error = %v, wantErr %v", tt.s, err, tt.wantErr)
+			}
+
+			if err != nil {
+				return
+			}
+
+			if got.Version.String() != tt.version || got.Platform().String() != tt.platform {
+				t.Errorf(
+					"ParseArtifactVersion(%q) = %q %q, want %q %q",
+					tt.s, got.Version, got.Platform(), tt.version, tt.platform,
+				)
+			}
+		})
+	}
+}
+
+func TestLatestPerPlatform(t *testing.T) {
+	t.Parallel()
+
+	artifacts := []*semver.ArtifactVersion{
+		semver.MustParseArtifactVersion("1.2.3-linux-amd64"),
+		semver.MustParseArtifactVersion("1.3.0-linux-amd64"),
+		semver.MustParseArtifactVersion("1.2.3-darwin-arm64"),
+		semver.MustParseArtifactVersion("1.3.0-rc.1-darwin-arm64"),
+		semver.MustParseArtifactVersion("1.1.0-darwin-arm64"),
+	}
+
+	latest := semver.LatestPerPlatform(artifacts)
+
+	want := map[semver.Platform]string{
+		{OS: "linux", Arch: "amd64"}:  "1.3.0-linux-amd64",
+		{OS: "darwin", Arch: "arm64"}: "1.3.0-rc.1-darwin-arm64",
+	}
+
+	if len(latest) != len(want) {
+		t.Fatalf("LatestPerPlatform() returned %d platforms, want %d", len(latest), len(want))
+	}
+
+	for p, w := range want {
+		if got := latest[p]; got == nil || got.String() != w {
+			t.Errorf("LatestPerPlatform()[%v] = %v, want %s", p, got, w)
+		}
+	}
+
+	groups := semver.GroupByPlatform(artifacts)
+	darwin := groups[semver.Platform{OS: "darwin", Arch: "arm64"}]
+
+	if len(darwin) != 3 || darwin[0].Version.String() != "1.1.0" {
+		t.Errorf("GroupByPlatform()[darwin/arm64] = %v", darwin)
+	}
+
+	f, err := semver.ParseFileName("tool-1.2.3-linux-arm64.tar.gz")
+	if err != nil {
+		t.Fatalf("ParseFileName() error = %v", err)
+	}
+
+	if got := f.Artifact().String(); got != "1.2.3-linux-arm64" {
+		t.Errorf("FileName.Artifact() = %q, want \"1.2.3-linux-arm64\"", got)
+	}
+}
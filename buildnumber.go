@@ -0,0 +1,118 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver
+
+import (
+	"slices"
+	"strconv"
+)
+
+// BuildNumberLabel is the identifier that precedes the build number in
+// the versions created by [Version.WithBuildNumber] and
+// [Version.WithPrereleaseBuildNumber]. For example, build number 42 is stored
+// as "+build.42" in the build metadata and as "-build.42" in the pre-release.
+const BuildNumberLabel = "build"
+
+// WithBuildNumber returns a copy of v with the build number n stored in
+// the build metadata. An existing build number in the build metadata is
+// replaced. As the build metadata doesn't affect the precedence, the returned
+// version has the same precedence as v.
+func (v *Version) WithBuildNumber(n uint64) *Version {
+	build := make(Build, 0, len(v.Build)+2)
+
+	for i := 0; i < len(v.Build); i++ {
+		if v.Build[i] == BuildNumberLabel && i+1 < len(v.Build) && isNumericIdentifier(v.Build[i+1]) {
+			i++
+
+			continue
+		}
+
+		build = append(build, v.Build[i])
+	}
+
+	build = append(build, BuildNumberLabel, strconv.FormatUint(n, 10))
+
+	return &Version{
+		Major:      v.Major,
+		Minor:      v.Minor,
+		Patch:      v.Patch,
+		Prerelease: slices.Clone(v.Prerelease),
+		Build:      build,
+	}
+}
+
+// WithPrereleaseBuildNumber returns a copy of v with the build number n stored
+// in the pre-release identifiers. An existing build number in the pre-release
+// is replaced. The build number is stored as a numeric identifier so that
+// the versions with greater build numbers have higher precedence. Note that if
+// v is not a pre-release, the returned version has lower precedence than v.
+func (v *Version) WithPrereleaseBuildNumber(n uint64) *Version {
+	prerelease := make(Prerelease, 0, len(v.Prerelease)+2)
+
+	for i := 0; i < len(v.Prerelease); i++ {
+		if isBuildNumberLabel(v.Prerelease[i]) && i+1 < len(v.Prerelease) && v.Prerelease[i+1].isNumeric() {
+			i++
+
+			continue
+		}
+
+		prerelease = append(prerelease, v.Prerelease[i])
+	}
+
+	prerelease = append(prerelease, alphanumericIdentifier{BuildNumberLabel}, numericIdentifier{n})
+
+	return &Version{
+		Major:      v.Major,
+		Minor:      v.Minor,
+		Patch:      v.Patch,
+		Prerelease: prerelease,
+		Build:      slices.Clone(v.Build),
+	}
+}
+
+// ParseBuildNumber returns the build number stored in v and reports whether
+// v has one. The build number is looked up first from the build metadata and
+// then from the pre-release identifiers.
+func ParseBuildNumber(v *Version) (uint64, bool) {
+	for i := len(v.Build) - 2; i >= 0; i-- {
+		if v.Build[i] == BuildNumberLabel && isNumericIdentifier(v.Build[i+1]) {
+			n, err := strconv.ParseUint(v.Build[i+1], 10, 64)
+			if err == nil {
+				return n, true
+			}
+		}
+	}
+
+	for i := len(v.Prerelease) - 2; i >= 0; i-- {
+		if n, ok := v.Prerelease[i+1].(numericIdentifier); ok && isBuildNumberLabel(v.Prerelease[i]) {
+			return n.v, true
+		}
+	}
+
+	return 0, false
+}
+
+// isBuildNumberLabel reports whether the pre-release identifier is
+// the build number label.
+func isBuildNumberLabel(i PrereleaseIdentifier) bool {
+	a, ok := i.(alphanumericIdentifier)
+
+	return ok && a.v == BuildNumberLabel
+}
@@ -0,0 +1,103 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver_test
+
+import (
+	"testing"
+
+	"github.com/anttikivi/semver"
+)
+
+func TestWithBuildNumber(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		v    string
+		n    uint64
+		want string
+	}{
+		{"1.2.3", 42, "1.2.3+build.42"},
+		{"1.2.3-rc.1+linux", 7, "1.2.3-rc.1+linux.build.7"},
+		{"1.2.3+build.41.linux", 42, "1.2.3+linux.build.42"},
+	}
+
+	for _, tt := range tests {
+		v := semver.MustParse(tt.v)
+		if got := v.WithBuildNumber(tt.n); got.String() != tt.want {
+			t.Errorf("Version{%q}.WithBuildNumber(%d) = %q, want %q", tt.v, tt.n, got, tt.want)
+		}
+
+		if v.String() != tt.v {
+			t.Errorf("Version{%q}.WithBuildNumber(%d) modified the version to %q", tt.v, tt.n, v)
+		}
+	}
+}
+
+func TestWithPrereleaseBuildNumber(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		v    string
+		n    uint64
+		want string
+	}{
+		{"1.2.3", 42, "1.2.3-build.42"},
+		{"1.2.3-rc.1+linux", 7, "1.2.3-rc.1.build.7+linux"},
+		{"1.2.3-build.41", 42, "1.2.3-build.42"},
+	}
+
+	for _, tt := range tests {
+		if got := semver.MustParse(tt.v).WithPrereleaseBuildNumber(tt.n); got.String() != tt.want {
+			t.Errorf("Version{%q}.WithPrereleaseBuildNumber(%d) = %q, want %q", tt.v, tt.n, got, tt.want)
+		}
+	}
+
+	a := semver.MustParse("1.2.3-rc.1").WithPrereleaseBuildNumber(9)
+	b := semver.MustParse("1.2.3-rc.1").WithPrereleaseBuildNumber(10)
+
+	if a.Compare(b) >= 0 {
+		t.Errorf("%q is not less than %q", a, b)
+	}
+}
+
+func TestParseBuildNumber(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		v      string
+		want   uint64
+		wantOK bool
+	}{
+		{"1.2.3+build.42", 42, true},
+		{"1.2.3-build.7", 7, true},
+		{"1.2.3-build.7+build.8", 8, true},
+		{"1.2.3+build.007", 7, true},
+		{"1.2.3+build", 0, false},
+		{"1.2.3-build.x", 0, false},
+		{"1.2.3", 0, false},
+	}
+
+	for _, tt := range tests {
+		got, ok := semver.ParseBuildNumber(semver.MustParse(tt.v))
+		if got != tt.want || ok != tt.wantOK {
+			t.Errorf("ParseBuildNumber(%q) = %d, %v, want %d, %v", tt.v, got, ok, tt.want, tt.wantOK)
+		}
+	}
+}
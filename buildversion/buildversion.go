@@ -0,0 +1,213 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+// Package buildversion implements publishing the version of the running
+// program. The version is usually set at build time with the linker flag
+// "-X", for example "-ldflags '-X main.version=1.2.3'", parsed and validated
+// at startup with [Parse] or [MustParse], and served with [Handler] or
+// [Publish]:
+//
+//	var version string
+//
+//	var v = buildversion.MustParse(version)
+//
+// Alternatively, [FromBuildInfo] reads the version from the build information
+// that the go command embeds in the binary.
+//
+//	func main() {
+//		buildversion.Publish("version", v)
+//		http.Handle("/version", buildversion.Handler(v))
+//		// ...
+//	}
+package buildversion
+
+import (
+	"encoding/json"
+	"errors"
+	"expvar"
+	"fmt"
+	"net/http"
+	"runtime/debug"
+	"strings"
+
+	"github.com/anttikivi/semver"
+)
+
+// The length of the abbreviated VCS revision in the build metadata of
+// development versions.
+const revisionLen = 12
+
+// Errors returned by the functions in this package.
+var (
+	// ErrNotSet is returned when the version string set at build time is
+	// empty.
+	ErrNotSet = errors.New("version not set at build time")
+
+	// ErrNoBuildInfo is returned when the build information is not available
+	// in the running binary.
+	ErrNoBuildInfo = errors.New("build information not available")
+)
+
+// info is the JSON representation of a version that [Handler] and [Publish]
+// serve.
+type info struct {
+	Version    string `json:"version"`
+	Major      uint64 `json:"major"`
+	Minor      uint64 `json:"minor"`
+	Patch      uint64 `json:"patch"`
+	Prerelease string `json:"prerelease,omitempty"`
+	Build      string `json:"build,omitempty"`
+}
+
+// Parse parses the version string s that was set at build time. The version
+// may have the "v" prefix. Parse returns an error that wraps [ErrNotSet] if s
+// is empty, and an error that wraps [semver.ErrInvalidVersion] if it is not
+// a valid version.
+func Parse(s string) (*semver.Version, error) {
+	if s == "" {
+		return nil, ErrNotSet
+	}
+
+	v, err := semver.Parse(s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid build version: %w", err)
+	}
+
+	return v, nil
+}
+
+// MustParse is like [Parse] but panics if s is not a valid version. It is
+// meant for validating the version at startup, for example in the initializer
+// of a package-level variable, so that a binary with a bad version fails
+// immediately.
+func MustParse(s string) *semver.Version {
+	v, err := Parse(s)
+	if err != nil {
+		panic(err)
+	}
+
+	return v
+}
+
+// FromBuildInfo returns the version of the main module of the running binary
+// from the build information that the go command embeds in it, so that
+// the version doesn't have to be set with the linker flags. It is
+// [ParseBuildInfo] for the result of [debug.ReadBuildInfo], and it returns an
+// error that wraps [ErrNoBuildInfo] if the binary doesn't have the build
+// information.
+func FromBuildInfo() (*semver.Version, error) {
+	bi, ok := debug.ReadBuildInfo()
+	if !ok {
+		return nil, ErrNoBuildInfo
+	}
+
+	return ParseBuildInfo(bi)
+}
+
+// ParseBuildInfo returns the version of the main module in bi. The version is
+// a tag, like "v1.2.3", when the binary was installed with "go install
+// module@version", and a pseudo-version, like
+// "v1.2.4-0.20250101120000-abcdef123456+dirty", when it was built in
+// a checkout of a repository; both parse as semantic versions. When the go
+// command doesn't know the version, it uses "(devel)", and ParseBuildInfo
+// returns "0.0.0-devel" with the abbreviated VCS revision and "dirty" for
+// uncommitted changes as build metadata if bi has them, for example
+// "0.0.0-devel+abcdef123456.dirty".
+func ParseBuildInfo(bi *debug.BuildInfo) (*semver.Version, error) {
+	if bi == nil || bi.Main.Version == "" {
+		return nil, ErrNoBuildInfo
+	}
+
+	if bi.Main.Version != "(devel)" {
+		v, err := semver.Parse(bi.Main.Version)
+		if err != nil {
+			return nil, fmt.Errorf("invalid main module version: %w", err)
+		}
+
+		return v, nil
+	}
+
+	var build []string
+
+	for _, s := range bi.Settings {
+		switch {
+		case s.Key == "vcs.revision" && s.Value != "":
+			build = append([]string{s.Value[:min(len(s.Value), revisionLen)]}, build...)
+		case s.Key == "vcs.modified" && s.Value == "true":
+			build = append(build, "dirty")
+		}
+	}
+
+	s := "0.0.0-devel"
+	if len(build) > 0 {
+		s += "+" + strings.Join(build, ".")
+	}
+
+	return semver.MustParse(s), nil
+}
+
+// Handler returns an HTTP handler that serves v as a JSON object with
+// the members "version", "major", "minor", "patch", "prerelease", and "build".
+// The members "prerelease" and "build" are omitted if they are empty. Only
+// the methods GET and HEAD are allowed.
+func Handler(v *semver.Version) http.Handler {
+	body, err := json.Marshal(newInfo(v))
+	if err != nil {
+		// The info contains only strings and numbers.
+		panic(fmt.Sprintf("buildversion: failed to marshal version: %v", err))
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			w.Header().Set("Allow", "GET, HEAD")
+			http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Cache-Control", "no-cache")
+
+		if r.Method == http.MethodGet {
+			_, _ = w.Write(body)
+		}
+	})
+}
+
+// Publish publishes v as an expvar variable with the given name, so it is
+// served at "/debug/vars" with the other variables. The value of the variable
+// is the same JSON object that [Handler] serves. Like [expvar.Publish],
+// Publish panics if the name is already in use.
+func Publish(name string, v *semver.Version) {
+	i := newInfo(v)
+
+	expvar.Publish(name, expvar.Func(func() any { return i }))
+}
+
+// newInfo returns the JSON representation of v.
+func newInfo(v *semver.Version) info {
+	return info{
+		Version:    v.String(),
+		Major:      v.Major,
+		Minor:      v.Minor,
+		Patch:      v.Patch,
+		Prerelease: v.Prerelease.String(),
+		Build:      v.Build.String(),
+	}
+}
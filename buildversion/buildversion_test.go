@@ -0,0 +1,146 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package buildversion_test
+
+import (
+	"errors"
+	"expvar"
+	"net/http"
+	"net/http/httptest"
+	"runtime/debug"
+	"testing"
+
+	"github.com/anttikivi/semver"
+	"github.com/anttikivi/semver/buildversion"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	v, err := buildversion.Parse("v1.2.3-rc.1")
+	if err != nil || v.String() != "1.2.3-rc.1" {
+		t.Errorf("Parse() = %v, %v, want 1.2.3-rc.1, nil", v, err)
+	}
+
+	if _, err := buildversion.Parse(""); !errors.Is(err, buildversion.ErrNotSet) {
+		t.Errorf("Parse(\"\") error = %v, want %v", err, buildversion.ErrNotSet)
+	}
+
+	if _, err := buildversion.Parse("dev"); !errors.Is(err, semver.ErrInvalidVersion) {
+		t.Errorf("Parse(\"dev\") error = %v, want %v", err, semver.ErrInvalidVersion)
+	}
+}
+
+func TestMustParsePanics(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		if recover() == nil {
+			t.Error("MustParse(\"\") did not panic")
+		}
+	}()
+
+	buildversion.MustParse("")
+}
+
+func TestHandler(t *testing.T) {
+	t.Parallel()
+
+	h := buildversion.Handler(semver.MustParse("1.2.3+build.5"))
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/version", nil))
+
+	want := `{"version":"1.2.3+build.5","major":1,"minor":2,"patch":3,"build":"build.5"}`
+	if rec.Code != http.StatusOK || rec.Body.String() != want {
+		t.Errorf("GET /version = %d %s, want 200 %s", rec.Code, rec.Body, want)
+	}
+
+	if ct := rec.Header().Get("Content-Type"); ct != "application/json" {
+		t.Errorf("Content-Type = %q, want application/json", ct)
+	}
+
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequestWithContext(t.Context(), http.MethodPost, "/version", nil))
+
+	if rec.Code != http.StatusMethodNotAllowed {
+		t.Errorf("POST /version = %d, want %d", rec.Code, http.StatusMethodNotAllowed)
+	}
+}
+
+func TestPublish(t *testing.T) {
+	t.Parallel()
+
+	buildversion.Publish("buildversion_test", semver.MustParse("2.0.0-beta.1"))
+
+	want := `{"version":"2.0.0-beta.1","major":2,"minor":0,"patch":0,"prerelease":"beta.1"}`
+	if got := expvar.Get("buildversion_test").String(); got != want {
+		t.Errorf("published variable = %s, want %s", got, want)
+	}
+}
+
+func TestParseBuildInfo(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		version  string
+		settings []debug.BuildSetting
+		want     string
+	}{
+		{"v1.2.3", nil, "1.2.3"},
+		{
+			"v1.2.4-0.20250101120000-abcdef123456+dirty",
+			nil,
+			"1.2.4-0.20250101120000-abcdef123456+dirty",
+		},
+		{"(devel)", nil, "0.0.0-devel"},
+		{
+			"(devel)",
+			[]debug.BuildSetting{
+				{Key: "vcs.modified", Value: "true"},
+				{Key: "vcs.revision", Value: "abcdef1234567890abcdef1234567890abcdef12"},
+			},
+			"0.0.0-devel+abcdef123456.dirty",
+		},
+	}
+
+	for _, tt := range tests {
+		bi := &debug.BuildInfo{
+			Main:     debug.Module{Path: "example.com/app", Version: tt.version},
+			Settings: tt.settings,
+		}
+
+		v, err := buildversion.ParseBuildInfo(bi)
+		if err != nil {
+			t.Errorf("ParseBuildInfo(%q) error = %v", tt.version, err)
+
+			continue
+		}
+
+		if got := v.String(); got != tt.want {
+			t.Errorf("ParseBuildInfo(%q) = %q, want %q", tt.version, got, tt.want)
+		}
+	}
+
+	_, err := buildversion.ParseBuildInfo(&debug.BuildInfo{})
+	if !errors.Is(err, buildversion.ErrNoBuildInfo) {
+		t.Errorf("ParseBuildInfo() error = %v, want %v", err, buildversion.ErrNoBuildInfo)
+	}
+}
@@ -0,0 +1,226 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// ErrInvalidLevel is returned when a version can't be bumped at the given
+// release level.
+var ErrInvalidLevel = errors.New("invalid release level")
+
+// A FileChange is a version that [BumpInFile] changed in a file.
+type FileChange struct {
+	// Line is the line number of the version in the file, starting from 1.
+	Line int
+
+	// Old is the version before the change.
+	Old *Version
+
+	// New is the version after the change.
+	New *Version
+}
+
+// Bump returns the next version after v at the given release level. Bumping
+// a pre-release version at a level that the pre-release is already for
+// releases it, so bumping "1.3.0-rc.1" at LevelMinor returns "1.3.0" and
+// bumping "1.2.3-rc.1" at LevelPatch returns "1.2.3". At LevelPrerelease,
+// the last identifier of a pre-release is incremented if it is numeric, and
+// otherwise the identifier 0 is added; a release version is bumped to
+// the first pre-release of the next patch version. The build metadata is
+// dropped. Bump returns an error that wraps [ErrInvalidLevel] for the levels
+// below LevelPrerelease.
+func Bump(v *Version, level ReleaseLevel) (*Version, error) {
+	pre := len(v.Prerelease) > 0
+
+	switch level {
+	case LevelMajor:
+		if pre && v.Minor == 0 && v.Patch == 0 {
+			return coreVersion(v.Major, 0, 0), nil
+		}
+
+		if v.Major == math.MaxUint64 {
+			return nil, fmt.Errorf("%w: major version of %s overflows", ErrInvalidVersion, v)
+		}
+
+		return coreVersion(v.Major+1, 0, 0), nil
+	case LevelMinor:
+		if pre && v.Patch == 0 {
+			return coreVersion(v.Major, v.Minor, 0), nil
+		}
+
+		if v.Minor == math.MaxUint64 {
+			return nil, fmt.Errorf("%w: minor version of %s overflows", ErrInvalidVersion, v)
+		}
+
+		return coreVersion(v.Major, v.Minor+1, 0), nil
+	case LevelPatch:
+		if pre {
+			return coreVersion(v.Major, v.Minor, v.Patch), nil
+		}
+
+		if v.Patch == math.MaxUint64 {
+			return nil, fmt.Errorf("%w: patch version of %s overflows", ErrInvalidVersion, v)
+		}
+
+		return coreVersion(v.Major, v.Minor, v.Patch+1), nil
+	case LevelPrerelease:
+		return bumpPrerelease(v)
+	case LevelNone, LevelBuild:
+	}
+
+	return nil, fmt.Errorf("%w: cannot bump %s at %s", ErrInvalidLevel, v, level)
+}
+
+// BumpInFile bumps the versions in the file at path that satisfy selector at
+// the given release level using [Bump]. If selector is nil, all of
+// the versions in the file are bumped. The versions are found using
+// [FindAll], and their "v" prefixes are kept. The file is replaced
+// atomically by writing the new contents to a temporary file in the same
+// directory and renaming it, and the file is not written at all if no version
+// changed. BumpInFile returns the changes in the order they appear in the file.
+//
+// BumpInFile is idempotent if selector doesn't match the bumped versions, so
+// a release automation should use an exact version like "=1.2.3" as
+// the selector to safely retry it. Running it again with a range like "^1.2"
+// bumps the versions again, as the range also matches the bumped versions.
+func BumpInFile(path string, selector *Constraint, level ReleaseLevel) ([]FileChange, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file: %w", err)
+	}
+
+	var (
+		changes []FileChange
+		bumpErr error
+		sb      strings.Builder
+	)
+
+	for i, line := range strings.SplitAfter(string(data), "\n") {
+		s, _, err := ReplaceAll(line, func(v *Version) *Version {
+			if bumpErr != nil || selector != nil && !selector.Check(v) {
+				return nil
+			}
+
+			w, err := Bump(v, level)
+			if err != nil {
+				bumpErr = fmt.Errorf("line %d: %w", i+1, err)
+
+				return nil
+			}
+
+			changes = append(changes, FileChange{Line: i + 1, Old: v, New: w})
+
+			return w
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to bump versions on line %d: %w", i+1, err)
+		}
+
+		if bumpErr != nil {
+			return nil, fmt.Errorf("failed to bump version: %w", bumpErr)
+		}
+
+		sb.WriteString(s)
+	}
+
+	if len(changes) == 0 {
+		return nil, nil
+	}
+
+	if err := writeFileAtomic(path, []byte(sb.String())); err != nil {
+		return nil, err
+	}
+
+	return changes, nil
+}
+
+// bumpPrerelease returns the next pre-release version after v.
+func bumpPrerelease(v *Version) (*Version, error) {
+	if len(v.Prerelease) == 0 {
+		if v.Patch == math.MaxUint64 {
+			return nil, fmt.Errorf("%w: patch version of %s overflows", ErrInvalidVersion, v)
+		}
+
+		return lowestVersion(v.Major, v.Minor, v.Patch+1), nil
+	}
+
+	p := make(Prerelease, len(v.Prerelease), len(v.Prerelease)+1)
+	copy(p, v.Prerelease)
+
+	if last, ok := p[len(p)-1].(numericIdentifier); ok {
+		if last.v == math.MaxUint64 {
+			return nil, fmt.Errorf("%w: pre-release of %s overflows", ErrInvalidVersion, v)
+		}
+
+		p[len(p)-1] = numericIdentifier{last.v + 1}
+	} else {
+		p = append(p, numericIdentifier{0})
+	}
+
+	return &Version{Major: v.Major, Minor: v.Minor, Patch: v.Patch, Prerelease: p, Build: nil}, nil
+}
+
+// writeFileAtomic replaces the file at path with data by writing it to
+// a temporary file in the same directory and renaming the temporary file.
+// The permissions of the original file are kept.
+func writeFileAtomic(path string, data []byte) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return fmt.Errorf("failed to stat file: %w", err)
+	}
+
+	f, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("failed to create temporary file: %w", err)
+	}
+
+	tmp := f.Name()
+
+	if _, err = f.Write(data); err == nil {
+		err = f.Chmod(info.Mode().Perm())
+	}
+
+	if err == nil {
+		err = f.Sync()
+	}
+
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+
+	if err == nil {
+		err = os.Rename(tmp, path)
+	}
+
+	if err != nil {
+		_ = os.Remove(tmp)
+
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,127 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/anttikivi/semver"
+)
+
+func TestBump(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		v     string
+		level semver.ReleaseLevel
+		want  string
+	}{
+		{"1.2.3", semver.LevelMajor, "2.0.0"},
+		{"1.2.3", semver.LevelMinor, "1.3.0"},
+		{"1.2.3+build.1", semver.LevelPatch, "1.2.4"},
+		{"2.0.0-rc.1", semver.LevelMajor, "2.0.0"},
+		{"1.3.0-rc.1", semver.LevelMinor, "1.3.0"},
+		{"1.3.1-rc.1", semver.LevelMinor, "1.4.0"},
+		{"1.2.3-rc.1", semver.LevelPatch, "1.2.3"},
+		{"1.2.3", semver.LevelPrerelease, "1.2.4-0"},
+		{"1.2.3-rc.1", semver.LevelPrerelease, "1.2.3-rc.2"},
+		{"1.2.3-rc", semver.LevelPrerelease, "1.2.3-rc.0"},
+	}
+
+	for _, tt := range tests {
+		got, err := semver.Bump(semver.MustParse(tt.v), tt.level)
+		if err != nil {
+			t.Errorf("Bump(%q, %v) error = %v", tt.v, tt.level, err)
+
+			continue
+		}
+
+		if got.String() != tt.want {
+			t.Errorf("Bump(%q, %v) = %q, want %q", tt.v, tt.level, got, tt.want)
+		}
+	}
+
+	_, err := semver.Bump(semver.MustParse("1.2.3"), semver.LevelBuild)
+	if !errors.Is(err, semver.ErrInvalidLevel) {
+		t.Errorf("Bump() at LevelBuild error = %v, want %v", err, semver.ErrInvalidLevel)
+	}
+}
+
+func TestBumpInFile(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "VERSIONS")
+
+	const contents = "app v1.2.3\nlib 0.4.0 1.2.4\nimage: app:1.2.3+build.5\n"
+	if err := os.WriteFile(path, []byte(contents), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	selector := semver.MustParseConstraint("=1.2.3")
+
+	changes, err := semver.BumpInFile(path, selector, semver.LevelMinor)
+	if err != nil {
+		t.Fatalf("BumpInFile() error = %v", err)
+	}
+
+	if len(changes) != 2 || changes[0].Line != 1 || changes[1].Line != 3 ||
+		changes[1].Old.String() != "1.2.3+build.5" || changes[1].New.String() != "1.3.0" {
+		t.Errorf("BumpInFile() changes = %+v", changes)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := "app v1.3.0\nlib 0.4.0 1.2.4\nimage: app:1.3.0\n"; string(data) != want {
+		t.Errorf("BumpInFile() wrote %q, want %q", data, want)
+	}
+
+	changes, err = semver.BumpInFile(path, selector, semver.LevelMinor)
+	if err != nil || len(changes) != 0 {
+		t.Errorf("second BumpInFile() = %+v, %v, want no changes", changes, err)
+	}
+
+	if data2, err := os.ReadFile(path); err != nil || string(data2) != string(data) {
+		t.Errorf("second BumpInFile() changed the file to %q, %v", data2, err)
+	}
+
+	changes, err = semver.BumpInFile(path, semver.MustParseConstraint("^1.2"), semver.LevelPatch)
+	if err != nil || len(changes) != 3 {
+		t.Errorf("BumpInFile() with a range = %+v, %v, want 3 changes", changes, err)
+	}
+
+	if data, err = os.ReadFile(path); err != nil {
+		t.Fatal(err)
+	}
+
+	if want := "app v1.3.1\nlib 0.4.0 1.2.5\nimage: app:1.3.1\n"; string(data) != want {
+		t.Errorf("BumpInFile() with a range wrote %q, want %q", data, want)
+	}
+
+	_, err = semver.BumpInFile(path, nil, semver.LevelBuild)
+	if !errors.Is(err, semver.ErrInvalidLevel) {
+		t.Errorf("BumpInFile() at LevelBuild error = %v, want %v", err, semver.ErrInvalidLevel)
+	}
+}
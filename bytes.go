@@ -0,0 +1,78 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver
+
+import (
+	"fmt"
+	"strings"
+	"unsafe"
+)
+
+// ParseBytes parses the given byte slice into a Version like [Parse]. It parses
+// the bytes in place without converting them to a string first, which avoids
+// the copy of the input when the version is parsed out of a larger buffer, for
+// example in a network protocol decoder.
+//
+// ParseBytes doesn't retain b: the returned version doesn't share memory with
+// it, so the caller may reuse or modify b after the call. b must not be
+// modified concurrently during the call.
+func ParseBytes(b []byte) (*Version, error) {
+	if len(b) == 0 {
+		return Parse("")
+	}
+
+	// The string is only used during the call and the parts of it that end up
+	// in the version are copied before returning, so aliasing the bytes is
+	// safe.
+	s := unsafe.String(unsafe.SliceData(b), len(b)) //nolint:gosec // see above
+
+	v, err := parse(s, 3) //nolint:mnd // <major>.<minor>.<patch>
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse version: %w", err)
+	}
+
+	if len(v.Prerelease) == 0 && len(v.Build) == 0 {
+		return v, nil
+	}
+
+	// The identifiers are substrings of s, so they are moved to a single copy
+	// of s by using their offsets in s.
+	c := strings.Clone(s)
+
+	for i, id := range v.Prerelease {
+		if a, ok := id.(alphanumericIdentifier); ok {
+			v.Prerelease[i] = alphanumericIdentifier{rebase(a.v, s, c)}
+		}
+	}
+
+	for i, id := range v.Build {
+		v.Build[i] = rebase(id, s, c)
+	}
+
+	return v, nil
+}
+
+// rebase returns the substring of c that is at the same position as
+// the substring sub is in s. The strings s and c must have equal contents.
+func rebase(sub, s, c string) string {
+	off := int(uintptr(unsafe.Pointer(unsafe.StringData(sub))) - uintptr(unsafe.Pointer(unsafe.StringData(s))))
+
+	return c[off : off+len(sub)]
+}
@@ -0,0 +1,57 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver_test
+
+import (
+	"testing"
+
+	"github.com/anttikivi/semver"
+)
+
+func TestParseBytes(t *testing.T) {
+	t.Parallel()
+
+	for _, s := range []string{
+		"1.2.3", "v1.2.3-rc.1", "1.2.3-alpha.beta.11+linux.amd64", "1.2.3+001", "", "1.2", "1.2.3-01", "1.2.3+",
+	} {
+		want, wantErr := semver.Parse(s)
+
+		b := []byte(s)
+		got, err := semver.ParseBytes(b)
+
+		if (err != nil) != (wantErr != nil) {
+			t.Errorf("ParseBytes(%q) error = %v, want %v", s, err, wantErr)
+
+			continue
+		}
+
+		if err != nil {
+			continue
+		}
+
+		for i := range b {
+			b[i] = 'x'
+		}
+
+		if !got.StrictEqual(want) || got.String() != want.String() {
+			t.Errorf("ParseBytes(%q) = %q after modifying the input, want %q", s, got, want)
+		}
+	}
+}
@@ -0,0 +1,188 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver
+
+import (
+	"fmt"
+	"strings"
+)
+
+// The operators of the clauses of a constraint.
+const (
+	// ClauseEqual requires the version to equal the version of the clause.
+	ClauseEqual ClauseOp = iota
+
+	// ClauseNotEqual requires the version not to equal the version of
+	// the clause.
+	ClauseNotEqual
+
+	// ClauseGreater requires the version to be greater than the version of
+	// the clause, or equal to it if the clause is inclusive.
+	ClauseGreater
+
+	// ClauseLess requires the version to be less than the version of
+	// the clause, or equal to it if the clause is inclusive.
+	ClauseLess
+)
+
+// A ClauseOp is the operator of a [Clause].
+type ClauseOp int
+
+// A Clause is a single primitive comparison of a [Constraint] in a structured
+// form. The comparators of the shorthand ranges, like "^1.2.3" and "1.x", are
+// expanded to the primitive comparisons, so the clauses can be rendered in any
+// language or user interface without parsing the constraint syntax.
+type Clause struct {
+	// Op is the operator of the clause.
+	Op ClauseOp
+
+	// Version is the version the clause compares to.
+	Version *Version
+
+	// Inclusive reports whether a version equal to Version satisfies
+	// a ClauseGreater or a ClauseLess clause. It is true for ClauseEqual and
+	// false for ClauseNotEqual.
+	Inclusive bool
+}
+
+// Clauses returns the clauses of the constraint. Each of the returned slices is
+// a range of clauses that all must be satisfied, and the constraint is
+// satisfied if any of the ranges is. For example, the clauses of
+// "^1.2.3 || 3.0.0" are:
+//
+//	[[{ClauseGreater 1.2.3 true} {ClauseLess 2.0.0-0 false}] [{ClauseEqual 3.0.0 true}]]
+//
+// The versions in the clauses are copies, so they may be modified.
+func (c *Constraint) Clauses() [][]Clause {
+	ranges := make([][]Clause, len(c.sets))
+
+	for i, set := range c.sets {
+		ranges[i] = make([]Clause, len(set))
+
+		for j, comp := range set {
+			ranges[i][j] = comp.clause()
+		}
+	}
+
+	return ranges
+}
+
+// Describe returns an English description of the constraint, for example
+// "at least 1.2.3 and less than 2.0.0-0, or exactly 3.0.0" for
+// "^1.2.3 || 3.0.0". Use [Constraint.Clauses] for describing the constraint in
+// another language.
+func (c *Constraint) Describe() string {
+	ranges := make([]string, 0, len(c.sets))
+
+	for _, clauses := range c.Clauses() {
+		parts := make([]string, len(clauses))
+		for i, cl := range clauses {
+			parts[i] = cl.Describe()
+		}
+
+		ranges = append(ranges, strings.Join(parts, " and "))
+	}
+
+	return strings.Join(ranges, ", or ")
+}
+
+// Describe returns an English description of the clause, for example
+// "at least 1.2.3".
+func (c Clause) Describe() string {
+	var prefix string
+
+	switch {
+	case c.Op == ClauseEqual:
+		prefix = "exactly"
+	case c.Op == ClauseNotEqual:
+		prefix = "not"
+	case c.Op == ClauseGreater && c.Inclusive:
+		prefix = "at least"
+	case c.Op == ClauseGreater:
+		prefix = "greater than"
+	case c.Op == ClauseLess && c.Inclusive:
+		prefix = "at most"
+	default:
+		prefix = "less than"
+	}
+
+	return prefix + " " + c.Version.String()
+}
+
+// String returns the clause in the constraint syntax, for example ">=1.2.3".
+func (c Clause) String() string {
+	return c.Op.symbol(c.Inclusive) + c.Version.String()
+}
+
+// String returns the name of the operator.
+func (o ClauseOp) String() string {
+	switch o {
+	case ClauseEqual:
+		return "ClauseEqual"
+	case ClauseNotEqual:
+		return "ClauseNotEqual"
+	case ClauseGreater:
+		return "ClauseGreater"
+	case ClauseLess:
+		return "ClauseLess"
+	default:
+		return fmt.Sprintf("ClauseOp(%d)", int(o))
+	}
+}
+
+// symbol returns the operator in the constraint syntax.
+func (o ClauseOp) symbol(inclusive bool) string {
+	switch {
+	case o == ClauseEqual:
+		return "="
+	case o == ClauseNotEqual:
+		return "!="
+	case o == ClauseGreater && inclusive:
+		return ">="
+	case o == ClauseGreater:
+		return ">"
+	case o == ClauseLess && inclusive:
+		return "<="
+	default:
+		return "<"
+	}
+}
+
+// clause returns the comparator as a Clause.
+func (c comparator) clause() Clause {
+	v := c.v.Clone()
+
+	switch c.op {
+	case opEqual:
+		return Clause{Op: ClauseEqual, Version: v, Inclusive: true}
+	case opNotEqual:
+		return Clause{Op: ClauseNotEqual, Version: v, Inclusive: false}
+	case opLess:
+		return Clause{Op: ClauseLess, Version: v, Inclusive: false}
+	case opLessOrEqual:
+		return Clause{Op: ClauseLess, Version: v, Inclusive: true}
+	case opGreater:
+		return Clause{Op: ClauseGreater, Version: v, Inclusive: false}
+	case opGreaterOrEqual:
+		return Clause{Op: ClauseGreater, Version: v, Inclusive: true}
+	default:
+		panic(fmt.Sprintf("invalid operator: %d", c.op))
+	}
+}
@@ -0,0 +1,78 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/anttikivi/semver"
+)
+
+func TestConstraintClauses(t *testing.T) {
+	t.Parallel()
+
+	c := semver.MustParseConstraint("^1.2.3 || 3.0.0 || !=1.5.0 <=1.9.0")
+
+	got := c.Clauses()
+	want := [][]semver.Clause{
+		{
+			{Op: semver.ClauseGreater, Version: semver.MustParse("1.2.3"), Inclusive: true},
+			{Op: semver.ClauseLess, Version: semver.MustParse("2.0.0-0"), Inclusive: false},
+		},
+		{{Op: semver.ClauseEqual, Version: semver.MustParse("3.0.0"), Inclusive: true}},
+		{
+			{Op: semver.ClauseNotEqual, Version: semver.MustParse("1.5.0"), Inclusive: false},
+			{Op: semver.ClauseLess, Version: semver.MustParse("1.9.0"), Inclusive: true},
+		},
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Constraint.Clauses() = %v, want %v", got, want)
+	}
+
+	got[0][0].Version.Major = 9
+
+	if !c.Check(semver.MustParse("1.2.3")) {
+		t.Error("modifying the clauses changed the constraint")
+	}
+
+	if s := got[2][1].String(); s != "<=1.9.0" {
+		t.Errorf("Clause.String() = %q, want \"<=1.9.0\"", s)
+	}
+}
+
+func TestConstraintDescribe(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		c    string
+		want string
+	}{
+		{"^1.2.3 || 3.0.0", "at least 1.2.3 and less than 2.0.0-0, or exactly 3.0.0"},
+		{">1.0.0 <=1.5.0 !=1.2.0", "greater than 1.0.0 and at most 1.5.0 and not 1.2.0"},
+	}
+
+	for _, tt := range tests {
+		if got := semver.MustParseConstraint(tt.c).Describe(); got != tt.want {
+			t.Errorf("Constraint{%q}.Describe() = %q, want %q", tt.c, got, tt.want)
+		}
+	}
+}
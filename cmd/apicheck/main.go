@@ -0,0 +1,104 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+// Command apicheck checks that the exported API of the module doesn't change
+// incompatibly. It compares the exported symbols of the packages of
+// the module against the manifest in api.txt and fails if a symbol was removed
+// or its signature changed. The new symbols are listed but they don't fail
+// the check.
+//
+// Usage:
+//
+//	go run ./cmd/apicheck [-w] [-C dir]
+//
+// The -w flag regenerates api.txt from the current API instead of checking it.
+// It should be run when the API is extended, and when the API is changed
+// incompatibly on purpose for a new major version.
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/anttikivi/semver/internal/apicheck"
+)
+
+// errIncompatible is returned when the API has changed incompatibly.
+var errIncompatible = errors.New("incompatible API changes")
+
+func main() {
+	write := flag.Bool("w", false, "write the manifest instead of checking it")
+	dir := flag.String("C", ".", "the root `directory` of the module")
+
+	flag.Parse()
+
+	if err := run(*dir, *write); err != nil {
+		fmt.Fprintf(os.Stderr, "apicheck: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(dir string, write bool) error {
+	manifest := filepath.Join(dir, "api.txt")
+
+	got, err := apicheck.Generate(dir)
+	if err != nil {
+		return err //nolint:wrapcheck // the errors of apicheck are descriptive
+	}
+
+	if write {
+		if err := apicheck.Write(manifest, got); err != nil {
+			return err //nolint:wrapcheck // the errors of apicheck are descriptive
+		}
+
+		return nil
+	}
+
+	want, err := apicheck.Read(manifest)
+	if err != nil {
+		return err //nolint:wrapcheck // the errors of apicheck are descriptive
+	}
+
+	removed, added := apicheck.Compare(want, got)
+
+	for _, line := range added {
+		fmt.Fprintf(os.Stdout, "+%s\n", line)
+	}
+
+	for _, line := range removed {
+		fmt.Fprintf(os.Stdout, "-%s\n", line)
+	}
+
+	if len(removed) > 0 {
+		return fmt.Errorf("%w: %d symbols removed or changed", errIncompatible, len(removed))
+	}
+
+	if len(added) > 0 {
+		fmt.Fprintf(
+			os.Stderr,
+			"apicheck: %d API additions; run with -w to update api.txt\n",
+			len(added),
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,270 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Kinds of the arguments of the commands for the shell completions.
+const (
+	// completeNone means that the arguments are not completed.
+	completeNone completion = iota
+
+	// completeFiles means that the arguments are file names.
+	completeFiles
+
+	// completeShells means that the arguments are the supported shells.
+	completeShells
+)
+
+// A completion is the kind of the arguments of a command for the shell
+// completions.
+type completion int
+
+// A commandFlags is a command and the flags it defines.
+type commandFlags struct {
+	command
+
+	fs    *flag.FlagSet
+	flags []*flag.Flag
+}
+
+// defineCompletion defines the flags of the completion command and returns
+// the command. The command writes the completion script for the shell to
+// the standard output. The scripts are generated from the commands and their
+// flags, so they are always up to date. For example, the completions are
+// installed for bash with:
+//
+//	semver completion bash > /etc/bash_completion.d/semver
+func defineCompletion(*flag.FlagSet) runFunc {
+	return func(fs *flag.FlagSet, stdout, _ io.Writer) error {
+		if fs.NArg() != 1 {
+			fs.Usage()
+
+			return fmt.Errorf("%w: expected a shell", errUsage)
+		}
+
+		var script string
+
+		switch fs.Arg(0) {
+		case "bash":
+			script = bashCompletion(commandsWithFlags())
+		case "zsh":
+			script = zshCompletion(commandsWithFlags())
+		case "fish":
+			script = fishCompletion(commandsWithFlags())
+		default:
+			return fmt.Errorf("%w: unsupported shell %q", errUsage, fs.Arg(0))
+		}
+
+		_, err := io.WriteString(stdout, script)
+
+		return err //nolint:wrapcheck // writing to the standard output
+	}
+}
+
+// commandsWithFlags returns the commands with the flags that they define.
+func commandsWithFlags() []commandFlags {
+	cmds := commands()
+	result := make([]commandFlags, 0, len(cmds))
+
+	for _, c := range cmds {
+		fs := flag.NewFlagSet(c.name, flag.ContinueOnError)
+		c.define(fs)
+
+		var flags []*flag.Flag
+
+		fs.VisitAll(func(f *flag.Flag) {
+			flags = append(flags, f)
+		})
+
+		result = append(result, commandFlags{command: c, fs: fs, flags: flags})
+	}
+
+	return result
+}
+
+// takesValue reports whether the flag f takes a value.
+func takesValue(f *flag.Flag) bool {
+	b, ok := f.Value.(interface{ IsBoolFlag() bool })
+
+	return !ok || !b.IsBoolFlag()
+}
+
+// bashCompletion returns the bash completion script for the commands.
+func bashCompletion(cmds []commandFlags) string {
+	var sb strings.Builder
+
+	names := make([]string, len(cmds))
+	for i, c := range cmds {
+		names[i] = c.name
+	}
+
+	sb.WriteString("# bash completion for semver; generated by \"semver completion bash\".\n\n")
+	sb.WriteString("_semver() {\n")
+	sb.WriteString("\tlocal cur=\"${COMP_WORDS[COMP_CWORD]}\"\n")
+	sb.WriteString("\tlocal prev=\"${COMP_WORDS[COMP_CWORD-1]}\"\n\n")
+	sb.WriteString("\tif [ \"$COMP_CWORD\" -eq 1 ]; then\n")
+	fmt.Fprintf(&sb, "\t\tCOMPREPLY=($(compgen -W %q -- \"$cur\"))\n", strings.Join(names, " "))
+	sb.WriteString("\t\treturn\n\tfi\n\n")
+	sb.WriteString("\tcase \"${COMP_WORDS[1]}\" in\n")
+
+	for _, c := range cmds {
+		flags := []string{"-h"}
+		values := []string{}
+
+		for _, f := range c.flags {
+			flags = append(flags, "-"+f.Name)
+			if takesValue(f) {
+				values = append(values, "-"+f.Name)
+			}
+		}
+
+		fmt.Fprintf(&sb, "\t%s)\n", c.name)
+
+		if len(values) > 0 {
+			fmt.Fprintf(&sb, "\t\tcase \"$prev\" in\n\t\t%s)\n\t\t\treturn\n\t\t\t;;\n\t\tesac\n\n",
+				strings.Join(values, " | "))
+		}
+
+		sb.WriteString("\t\tcase \"$cur\" in\n")
+		fmt.Fprintf(&sb, "\t\t-*)\n\t\t\tCOMPREPLY=($(compgen -W %q -- \"$cur\"))\n\t\t\t;;\n",
+			strings.Join(flags, " "))
+
+		switch c.complete {
+		case completeFiles:
+			sb.WriteString("\t\t*)\n\t\t\tCOMPREPLY=($(compgen -f -- \"$cur\"))\n\t\t\t;;\n")
+		case completeShells:
+			sb.WriteString("\t\t*)\n")
+			sb.WriteString("\t\t\tCOMPREPLY=($(compgen -W \"bash zsh fish\" -- \"$cur\"))\n")
+			sb.WriteString("\t\t\t;;\n")
+		case completeNone:
+		}
+
+		sb.WriteString("\t\tesac\n\t\t;;\n")
+	}
+
+	sb.WriteString("\tesac\n}\n\ncomplete -F _semver semver\n")
+
+	return sb.String()
+}
+
+// zshCompletion returns the zsh completion script for the commands.
+func zshCompletion(cmds []commandFlags) string {
+	var sb strings.Builder
+
+	sb.WriteString("#compdef semver\n\n")
+	sb.WriteString("# zsh completion for semver; generated by \"semver completion zsh\".\n\n")
+	sb.WriteString("_semver() {\n\tlocal -a commands\n\tcommands=(\n")
+
+	for _, c := range cmds {
+		fmt.Fprintf(&sb, "\t\t'%s:%s'\n", c.name, zshEscape(c.summary))
+	}
+
+	sb.WriteString("\t)\n\n")
+	sb.WriteString("\tif (( CURRENT == 2 )); then\n")
+	sb.WriteString("\t\t_describe 'command' commands\n\t\treturn\n\tfi\n\n")
+	sb.WriteString("\tlocal cmd=\"$words[2]\"\n\tshift words\n\t(( CURRENT-- ))\n\n")
+	sb.WriteString("\tcase \"$cmd\" in\n")
+
+	for _, c := range cmds {
+		fmt.Fprintf(&sb, "\t%s)\n\t\t_arguments \\\n", c.name)
+
+		for _, f := range c.flags {
+			name, usage := flag.UnquoteUsage(f)
+
+			spec := fmt.Sprintf("-%s[%s]", f.Name, zshEscape(usage))
+			if takesValue(f) {
+				spec += ":" + zshEscape(name) + ":"
+			}
+
+			fmt.Fprintf(&sb, "\t\t\t'%s' \\\n", spec)
+		}
+
+		switch c.complete {
+		case completeFiles:
+			sb.WriteString("\t\t\t'*:file:_files'\n")
+		case completeShells:
+			sb.WriteString("\t\t\t'1:shell:(bash zsh fish)'\n")
+		case completeNone:
+			sb.WriteString("\t\t\t'*: :'\n")
+		}
+
+		sb.WriteString("\t\t;;\n")
+	}
+
+	sb.WriteString("\tesac\n}\n\n_semver \"$@\"\n")
+
+	return sb.String()
+}
+
+// fishCompletion returns the fish completion script for the commands.
+func fishCompletion(cmds []commandFlags) string {
+	var sb strings.Builder
+
+	sb.WriteString("# fish completion for semver; generated by \"semver completion fish\".\n\n")
+	sb.WriteString("complete -c semver -f\n")
+
+	for _, c := range cmds {
+		fmt.Fprintf(&sb, "complete -c semver -n __fish_use_subcommand -a %s -d %s\n",
+			c.name, fishQuote(c.summary))
+	}
+
+	for _, c := range cmds {
+		cond := fishQuote("__fish_seen_subcommand_from " + c.name)
+
+		for _, f := range c.flags {
+			_, usage := flag.UnquoteUsage(f)
+
+			r := ""
+			if takesValue(f) {
+				r = " -r"
+			}
+
+			fmt.Fprintf(&sb, "complete -c semver -n %s -o %s%s -d %s\n", cond, f.Name, r,
+				fishQuote(usage))
+		}
+
+		switch c.complete {
+		case completeFiles:
+			fmt.Fprintf(&sb, "complete -c semver -n %s -F\n", cond)
+		case completeShells:
+			fmt.Fprintf(&sb, "complete -c semver -n %s -a 'bash zsh fish'\n", cond)
+		case completeNone:
+		}
+	}
+
+	return sb.String()
+}
+
+// zshEscape escapes s for a single-quoted specification of _arguments or
+// _describe.
+func zshEscape(s string) string {
+	return strings.NewReplacer(`'`, `'\''`, `[`, `\[`, `]`, `\]`, `:`, `\:`).Replace(s)
+}
+
+// fishQuote quotes s for fish.
+func fishQuote(s string) string {
+	return "'" + strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(s) + "'"
+}
@@ -0,0 +1,81 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package main
+
+import (
+	"bytes"
+	"os/exec"
+	"strings"
+	"testing"
+)
+
+func TestRunCompletion(t *testing.T) {
+	t.Parallel()
+
+	for _, shell := range []string{"bash", "zsh", "fish"} {
+		t.Run(shell, func(t *testing.T) {
+			t.Parallel()
+
+			var stdout bytes.Buffer
+
+			args := []string{"completion", shell}
+			if code := run(args, &stdout, &bytes.Buffer{}); code != exitOK {
+				t.Fatalf("run(%q) = %d", args, code)
+			}
+
+			script := stdout.String()
+
+			for _, c := range commandsWithFlags() {
+				if !strings.Contains(script, c.name) {
+					t.Errorf("%s completion doesn't contain the command %q", shell, c.name)
+				}
+
+				for _, f := range c.flags {
+					if !strings.Contains(script, f.Name) {
+						t.Errorf("%s completion doesn't contain the flag -%s", shell, f.Name)
+					}
+				}
+			}
+
+			// Check the syntax of the script if the shell is installed.
+			// The fish shell has no option for checking the syntax.
+			if shell == "fish" {
+				return
+			}
+
+			path, err := exec.LookPath(shell)
+			if err != nil {
+				return
+			}
+
+			cmd := exec.CommandContext(t.Context(), path, "-n")
+			cmd.Stdin = strings.NewReader(script)
+
+			if out, err := cmd.CombinedOutput(); err != nil {
+				t.Errorf("%s -n failed: %v\n%s", shell, err, out)
+			}
+		})
+	}
+
+	args := []string{"completion", "powershell"}
+	if code := run(args, &bytes.Buffer{}, &bytes.Buffer{}); code != exitUsage {
+		t.Errorf("run(%q) = %d, want %d", args, code, exitUsage)
+	}
+}
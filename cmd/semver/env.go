@@ -0,0 +1,75 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/anttikivi/semver"
+)
+
+// defineEnv defines the flags of the env command and returns the command.
+// The command parses the version and writes its components as environment
+// variables using [semver.Version.Environ]. With -export, the lines are export
+// commands that can be evaluated by a POSIX shell:
+//
+//	eval "$(semver env -export "$(cat VERSION)")"
+func defineEnv(fs *flag.FlagSet) runFunc {
+	prefix := fs.String("prefix", "VERSION", "the `name` of the version variable and the prefix")
+	export := fs.Bool("export", false, "write the variables as export commands")
+
+	return func(fs *flag.FlagSet, stdout, _ io.Writer) error {
+		return env(fs, stdout, *prefix, *export)
+	}
+}
+
+// env writes the environment variables of the version in the arguments of fs.
+func env(fs *flag.FlagSet, stdout io.Writer, prefix string, export bool) error {
+	if fs.NArg() != 1 {
+		fs.Usage()
+
+		return fmt.Errorf("%w: expected one version", errUsage)
+	}
+
+	v, err := semver.Parse(fs.Arg(0))
+	if err != nil {
+		return err //nolint:wrapcheck // the error describes the version
+	}
+
+	var sb strings.Builder
+
+	for _, kv := range v.Environ(prefix) {
+		if export {
+			// The values never contain quotes, so quoting them keeps
+			// the empty values explicit.
+			k, val, _ := strings.Cut(kv, "=")
+			kv = "export " + k + "='" + val + "'"
+		}
+
+		sb.WriteString(kv + "\n")
+	}
+
+	_, err = io.WriteString(stdout, sb.String())
+
+	return err //nolint:wrapcheck // writing to the standard output
+}
@@ -0,0 +1,66 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package main
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestRunEnv(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		args []string
+		code int
+		want string
+	}{
+		{
+			[]string{"env", "1.2.3-rc.1"},
+			exitOK,
+			"VERSION=1.2.3-rc.1\nVERSION_MAJOR=1\nVERSION_MINOR=2\nVERSION_PATCH=3\n" +
+				"VERSION_PRERELEASE=rc.1\nVERSION_BUILD=\n",
+		},
+		{
+			[]string{"env", "-prefix", "APP", "-export", "v2.0.0+b"},
+			exitOK,
+			"export APP='2.0.0+b'\nexport APP_MAJOR='2'\nexport APP_MINOR='0'\n" +
+				"export APP_PATCH='0'\nexport APP_PRERELEASE=''\nexport APP_BUILD='b'\n",
+		},
+		{[]string{"env", "1.2"}, exitFailure, ""},
+		{[]string{"env"}, exitUsage, ""},
+	}
+
+	for _, tt := range tests {
+		var stdout bytes.Buffer
+
+		code := run(tt.args, &stdout, &bytes.Buffer{})
+		if code != tt.code || stdout.String() != tt.want {
+			t.Errorf(
+				"run(%q) = %d, %q, want %d, %q",
+				tt.args,
+				code,
+				stdout.String(),
+				tt.code,
+				tt.want,
+			)
+		}
+	}
+}
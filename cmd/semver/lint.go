@@ -0,0 +1,388 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/anttikivi/semver"
+)
+
+// errLint is returned when the lint command finds problems.
+var errLint = errors.New("invalid versions found")
+
+// A field is a string value in a structured file.
+type field struct {
+	path  []string
+	value string
+	line  int
+}
+
+// defineLint defines the flags of the lint command and returns the command.
+// The command checks the versions in the given files and fails if a version is
+// invalid or not in its canonical form, so it can be used as a pre-commit hook
+// or in CI. In JSON and YAML files, the versions are read from the fields at
+// the paths given with -path, which default to "version". A path is
+// a dot-separated list of keys, and "*" matches any key or element of a list,
+// for example "packages.*.version". Other files must contain only a version,
+// like VERSION files.
+func defineLint(fs *flag.FlagSet) runFunc {
+	var paths [][]string
+
+	addPath := func(s string) error {
+		if s == "" {
+			return fmt.Errorf("%w: empty path", errUsage)
+		}
+
+		paths = append(paths, strings.Split(s, "."))
+
+		return nil
+	}
+
+	fs.Func("path", "check the field at `path` in JSON and YAML files (repeatable)", addPath)
+
+	allowPrefix := fs.Bool("allow-prefix", false, `allow the "v" prefix in the versions`)
+
+	return func(fs *flag.FlagSet, stdout, _ io.Writer) error {
+		return lint(fs, stdout, paths, *allowPrefix)
+	}
+}
+
+// lint checks the versions in the files in the arguments of fs.
+func lint(fs *flag.FlagSet, stdout io.Writer, paths [][]string, allowPrefix bool) error {
+	if fs.NArg() == 0 {
+		fs.Usage()
+
+		return fmt.Errorf("%w: no files given", errUsage)
+	}
+
+	if len(paths) == 0 {
+		paths = [][]string{{"version"}}
+	}
+
+	problems := 0
+
+	for _, name := range fs.Args() {
+		fields, err := readFields(name)
+		if err != nil {
+			return err
+		}
+
+		for _, f := range fields {
+			if f.path != nil && !matchPaths(paths, f.path) {
+				continue
+			}
+
+			if msg := lintVersion(f.value, allowPrefix); msg != "" {
+				fmt.Fprintf(stdout, "%s:%d: %s\n", name, f.line, msg)
+
+				problems++
+			}
+		}
+	}
+
+	if problems > 0 {
+		return fmt.Errorf("%w: %d problems", errLint, problems)
+	}
+
+	return nil
+}
+
+// lintVersion checks the version s and returns the description of the problem
+// or an empty string if s is valid and canonical.
+func lintVersion(s string, allowPrefix bool) string {
+	v, err := semver.Parse(s)
+	if err != nil {
+		return fmt.Sprintf("invalid version %q: %v", s, err)
+	}
+
+	want := v.String()
+	if allowPrefix && strings.HasPrefix(s, "v") {
+		want = "v" + want
+	}
+
+	if s != want {
+		return fmt.Sprintf("non-canonical version %q, want %q", s, want)
+	}
+
+	return ""
+}
+
+// readFields reads the string values of the file. The fields of files that
+// are not JSON or YAML have no path.
+func readFields(name string) ([]field, error) {
+	data, err := os.ReadFile(name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file: %w", err)
+	}
+
+	switch strings.ToLower(filepath.Ext(name)) {
+	case ".json":
+		fields, err := jsonFields(data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", name, err)
+		}
+
+		return fields, nil
+	case ".yaml", ".yml":
+		return yamlFields(data), nil
+	default:
+		s := string(data)
+		line := 1 + strings.Count(s[:len(s)-len(strings.TrimLeft(s, " \t\r\n"))], "\n")
+
+		return []field{{path: nil, value: strings.TrimSpace(s), line: line}}, nil
+	}
+}
+
+// matchPaths reports whether the path p matches one of the patterns.
+func matchPaths(patterns [][]string, p []string) bool {
+	for _, pattern := range patterns {
+		if len(pattern) != len(p) {
+			continue
+		}
+
+		ok := true
+
+		for i := range p {
+			if pattern[i] != "*" && pattern[i] != p[i] {
+				ok = false
+
+				break
+			}
+		}
+
+		if ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// jsonFields returns the string values in the JSON document data.
+func jsonFields(data []byte) ([]field, error) {
+	d := json.NewDecoder(bytes.NewReader(data))
+
+	var fields []field
+
+	var walk func(path []string) error
+
+	walk = func(path []string) error {
+		tok, err := d.Token()
+		if err != nil {
+			return fmt.Errorf("invalid JSON: %w", err)
+		}
+
+		switch t := tok.(type) {
+		case json.Delim:
+			for i := 0; d.More(); i++ {
+				key := strconv.Itoa(i)
+
+				if t == '{' {
+					k, err := d.Token()
+					if err != nil {
+						return fmt.Errorf("invalid JSON: %w", err)
+					}
+
+					key, _ = k.(string)
+				}
+
+				if err := walk(append(path[:len(path):len(path)], key)); err != nil {
+					return err
+				}
+			}
+
+			if _, err := d.Token(); err != nil {
+				return fmt.Errorf("invalid JSON: %w", err)
+			}
+		case string:
+			line := 1 + bytes.Count(data[:d.InputOffset()], []byte("\n"))
+			fields = append(fields, field{path: path, value: t, line: line})
+		}
+
+		return nil
+	}
+
+	if err := walk([]string{}); err != nil {
+		return nil, err
+	}
+
+	return fields, nil
+}
+
+// yamlFields returns the scalar values in the YAML document data. It supports
+// the block mappings and sequences that are used for the versions in
+// configuration files, and it skips the multiline and flow values.
+func yamlFields(data []byte) []field {
+	type entry struct {
+		indent int
+		key    string
+		item   bool
+		next   int
+	}
+
+	var (
+		fields []field
+		stack  []entry
+
+		// block is the indentation of the key of the current multiline
+		// value, or -1 if there is none.
+		block = -1
+	)
+
+	path := func(keys ...string) []string {
+		p := make([]string, 0, len(stack)+len(keys))
+		for _, e := range stack {
+			p = append(p, e.key)
+		}
+
+		return append(p, keys...)
+	}
+
+	for i, line := range strings.Split(string(data), "\n") {
+		content := strings.TrimLeft(line, " ")
+		indent := len(line) - len(content)
+		content = strings.TrimSpace(stripYAMLComment(content))
+
+		if content == "" || content == "---" || content == "..." {
+			continue
+		}
+
+		if block >= 0 && indent > block {
+			continue
+		}
+
+		block = -1
+
+		for content == "-" || strings.HasPrefix(content, "- ") {
+			for len(stack) > 0 && (stack[len(stack)-1].indent > indent ||
+				stack[len(stack)-1].indent == indent && stack[len(stack)-1].item) {
+				stack = stack[:len(stack)-1]
+			}
+
+			index := 0
+			if len(stack) > 0 {
+				index = stack[len(stack)-1].next
+				stack[len(stack)-1].next++
+			}
+
+			rest := strings.TrimLeft(strings.TrimPrefix(content, "-"), " ")
+			stack = append(
+				stack,
+				entry{indent: indent, key: strconv.Itoa(index), item: true, next: 0},
+			)
+			indent += len(content) - len(rest)
+			content = rest
+		}
+
+		if content == "" {
+			continue
+		}
+
+		key, value, ok := strings.Cut(content, ": ")
+		if !ok && strings.HasSuffix(content, ":") {
+			key, ok = strings.TrimSuffix(content, ":"), true
+		}
+
+		if !ok {
+			// A scalar item of a sequence.
+			if len(stack) > 0 && stack[len(stack)-1].item && isYAMLScalar(content) {
+				fields = append(
+					fields,
+					field{path: path(), value: unquoteYAML(content), line: i + 1},
+				)
+			}
+
+			continue
+		}
+
+		for len(stack) > 0 && stack[len(stack)-1].indent >= indent {
+			stack = stack[:len(stack)-1]
+		}
+
+		key = unquoteYAML(strings.TrimSpace(key))
+		value = strings.TrimSpace(value)
+
+		if value == "" {
+			stack = append(stack, entry{indent: indent, key: key, item: false, next: 0})
+
+			continue
+		}
+
+		switch {
+		case isYAMLScalar(value):
+			fields = append(fields, field{path: path(key), value: unquoteYAML(value), line: i + 1})
+		case value[0] == '|' || value[0] == '>':
+			block = indent
+		}
+	}
+
+	return fields
+}
+
+// isYAMLScalar reports whether the YAML value s is a single-line scalar.
+func isYAMLScalar(s string) bool {
+	return s != "" && !strings.ContainsAny(s[:1], "|>[{&*!")
+}
+
+// unquoteYAML removes the quotes around the YAML scalar s.
+func unquoteYAML(s string) string {
+	if len(s) >= 2 && s[0] == '"' && s[len(s)-1] == '"' {
+		if u, err := strconv.Unquote(s); err == nil {
+			return u
+		}
+	}
+
+	if len(s) >= 2 && s[0] == '\'' && s[len(s)-1] == '\'' {
+		return strings.ReplaceAll(s[1:len(s)-1], "''", "'")
+	}
+
+	return s
+}
+
+// stripYAMLComment removes the comment from the YAML line s. A "#" starts
+// a comment at the start of the line or after whitespace outside quotes.
+func stripYAMLComment(s string) string {
+	quote := byte(0)
+
+	for i := range len(s) {
+		switch c := s[i]; {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == '#' && (i == 0 || s[i-1] == ' ' || s[i-1] == '\t'):
+			return s[:i]
+		}
+	}
+
+	return s
+}
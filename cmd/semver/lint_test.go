@@ -0,0 +1,155 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestYAMLFields(t *testing.T) {
+	t.Parallel()
+
+	data := `# config
+version: v1.2.3
+services:
+  - name: api
+    version: '1.2.0'  # comment
+  - name: "web"
+    description: |
+      text
+tags:
+- 1.0.0
+other:
+  version: "3.0.0"
+`
+
+	want := []field{
+		{path: []string{"version"}, value: "v1.2.3", line: 2},
+		{path: []string{"services", "0", "name"}, value: "api", line: 4},
+		{path: []string{"services", "0", "version"}, value: "1.2.0", line: 5},
+		{path: []string{"services", "1", "name"}, value: "web", line: 6},
+		{path: []string{"tags", "0"}, value: "1.0.0", line: 10},
+		{path: []string{"other", "version"}, value: "3.0.0", line: 12},
+	}
+	if got := yamlFields([]byte(data)); !reflect.DeepEqual(got, want) {
+		t.Errorf("yamlFields() = %v, want %v", got, want)
+	}
+}
+
+func TestJSONFields(t *testing.T) {
+	t.Parallel()
+
+	data := `{
+  "version": "1.2.3",
+  "n": 1,
+  "deps": [{"version": "v2.0.0"}]
+}
+`
+
+	want := []field{
+		{path: []string{"version"}, value: "1.2.3", line: 2},
+		{path: []string{"deps", "0", "version"}, value: "v2.0.0", line: 4},
+	}
+
+	got, err := jsonFields([]byte(data))
+	if err != nil || !reflect.DeepEqual(got, want) {
+		t.Errorf("jsonFields() = %v, %v, want %v", got, err, want)
+	}
+
+	if _, err := jsonFields([]byte(`{"version": `)); err == nil {
+		t.Error("jsonFields() error = nil for invalid JSON")
+	}
+}
+
+func TestRunLint(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	files := map[string]string{
+		"package.json": `{"version": "1.2.3", "deps": [{"version": "v2.0.0"}]}`,
+		"VERSION":      "1.02.0\n",
+		"ok.yaml":      "version: 1.0.0\n",
+	}
+
+	for name, data := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(data), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		flags []string
+		files []string
+		code  int
+		want  string
+	}{
+		{nil, []string{"ok.yaml", "package.json"}, exitOK, ""},
+		{
+			[]string{"-path", "deps.*.version"},
+			[]string{"package.json"},
+			exitFailure,
+			"package.json:1: non-canonical version \"v2.0.0\", want \"2.0.0\"\n",
+		},
+		{
+			[]string{"-allow-prefix", "-path", "deps.*.version"},
+			[]string{"package.json"},
+			exitOK,
+			"",
+		},
+		{
+			nil,
+			[]string{"VERSION"},
+			exitFailure,
+			"VERSION:1: invalid version \"1.02.0\": failed to parse version: " +
+				"invalid semantic version: leading zero in \"02\"\n",
+		},
+		{nil, nil, exitUsage, ""},
+	}
+
+	for _, tt := range tests {
+		args := append([]string{"lint"}, tt.flags...)
+		for _, name := range tt.files {
+			args = append(args, filepath.Join(dir, name))
+		}
+
+		want := ""
+		if tt.want != "" {
+			want = filepath.Join(dir, tt.want)
+		}
+
+		var stdout bytes.Buffer
+
+		code := run(args, &stdout, &bytes.Buffer{})
+		if code != tt.code || stdout.String() != want {
+			t.Errorf(
+				"run(%q) = %d, stdout %q, want %d, %q",
+				args,
+				code,
+				stdout.String(),
+				tt.code,
+				want,
+			)
+		}
+	}
+}
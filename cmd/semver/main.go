@@ -0,0 +1,235 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+// Command semver is a command-line tool for working with semantic versions.
+//
+// Usage:
+//
+//	semver <command> [arguments]
+//
+// The commands are:
+//
+//	completion    print a shell completion script
+//	env           print the components of a version as environment variables
+//	lint          check the versions in files
+//	man           print the manual page
+//	merge-driver  merge files that contain a single version as a git merge driver
+//	parse         parse a version and print its components
+//
+// Run "semver <command> -h" for the usage of a command.
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+)
+
+// Exit codes of the command.
+const (
+	exitOK      = 0
+	exitFailure = 1
+	exitUsage   = 2
+)
+
+// errUsage is returned by the commands when they are invoked incorrectly.
+var errUsage = errors.New("invalid usage")
+
+// A command is a subcommand of semver.
+type command struct {
+	name    string
+	summary string
+
+	// args is the synopsis of the arguments after the flags.
+	args string
+
+	// complete is the kind of the arguments for the shell completions.
+	complete completion
+
+	// define defines the flags of the command in the flag set and returns
+	// the function that runs the command after the flags are parsed.
+	define func(fs *flag.FlagSet) runFunc
+}
+
+// A runFunc runs a command with the parsed flag set.
+type runFunc func(fs *flag.FlagSet, stdout, stderr io.Writer) error
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+// commands returns the subcommands in the order they are listed in the usage.
+func commands() []command {
+	return []command{
+		{
+			name:     "completion",
+			summary:  "print a shell completion script",
+			args:     "<bash|zsh|fish>",
+			complete: completeShells,
+			define:   defineCompletion,
+		},
+		{
+			name:     "env",
+			summary:  "print the components of a version as environment variables",
+			args:     "<version>",
+			complete: completeNone,
+			define:   defineEnv,
+		},
+		{
+			name:     "lint",
+			summary:  "check the versions in files",
+			args:     "<files...>",
+			complete: completeFiles,
+			define:   defineLint,
+		},
+		{
+			name:     "man",
+			summary:  "print the manual page",
+			args:     "",
+			complete: completeNone,
+			define:   defineMan,
+		},
+		{
+			name:     "merge-driver",
+			summary:  "merge files that contain a single version as a git merge driver",
+			args:     "<base> <current> <other>",
+			complete: completeFiles,
+			define:   defineMergeDriver,
+		},
+		{
+			name:     "parse",
+			summary:  "parse a version and print its components",
+			args:     "<version>",
+			complete: completeNone,
+			define:   defineParse,
+		},
+	}
+}
+
+// run runs semver with the command-line arguments args and returns the exit
+// code.
+func run(args []string, stdout, stderr io.Writer) int {
+	if len(args) == 0 || args[0] == "-h" || args[0] == "-help" || args[0] == "help" {
+		usage(stderr)
+
+		if len(args) == 0 {
+			return exitUsage
+		}
+
+		return exitOK
+	}
+
+	for _, c := range commands() {
+		if c.name != args[0] {
+			continue
+		}
+
+		fs := newFlagSet(c, stderr)
+		exec := c.define(fs)
+
+		err := parseFlags(fs, args[1:])
+		if err == nil {
+			err = exec(fs, stdout, stderr)
+		}
+
+		switch {
+		case err == nil:
+			return exitOK
+		case errors.Is(err, flag.ErrHelp):
+			return exitOK
+		case errors.Is(err, errUsage):
+			fmt.Fprintf(stderr, "semver %s: %v\n", c.name, err)
+
+			return exitUsage
+		default:
+			fmt.Fprintf(stderr, "semver %s: %v\n", c.name, err)
+
+			return exitFailure
+		}
+	}
+
+	fmt.Fprintf(stderr, "semver: unknown command %q\n", args[0])
+	usage(stderr)
+
+	return exitUsage
+}
+
+// usage writes the usage of semver to w.
+func usage(w io.Writer) {
+	fmt.Fprint(w, "Usage:\n\n\tsemver <command> [arguments]\n\nThe commands are:\n\n")
+
+	for _, c := range commands() {
+		fmt.Fprintf(w, "\t%-13s %s\n", c.name, c.summary)
+	}
+
+	fmt.Fprint(w, "\nRun \"semver <command> -h\" for the usage of a command.\n")
+}
+
+// newFlagSet returns a flag set for the command c that writes its output to
+// stderr. The usage is printed before the flags.
+func newFlagSet(c command, stderr io.Writer) *flag.FlagSet {
+	fs := flag.NewFlagSet("semver "+c.name, flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.Usage = func() {
+		fmt.Fprintf(stderr, "Usage: %s\n", synopsis(c, fs))
+		fs.PrintDefaults()
+	}
+
+	return fs
+}
+
+// synopsis returns the synopsis of the command c with the flag set fs, for
+// example "semver env [-export] [-prefix name] <version>".
+func synopsis(c command, fs *flag.FlagSet) string {
+	s := "semver " + c.name
+
+	fs.VisitAll(func(f *flag.Flag) {
+		name, _ := flag.UnquoteUsage(f)
+
+		s += " [-" + f.Name
+		if name != "" {
+			s += " " + name
+		}
+
+		s += "]"
+	})
+
+	if c.args != "" {
+		s += " " + c.args
+	}
+
+	return s
+}
+
+// parseFlags parses args using fs. The returned error is [flag.ErrHelp] if
+// the help was requested, and it wraps errUsage if the flags are invalid.
+func parseFlags(fs *flag.FlagSet, args []string) error {
+	err := fs.Parse(args)
+
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, flag.ErrHelp):
+		return flag.ErrHelp
+	default:
+		return fmt.Errorf("%w: %w", errUsage, err)
+	}
+}
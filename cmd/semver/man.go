@@ -0,0 +1,112 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// defineMan defines the flags of the man command and returns the command.
+// The command writes the manual page of semver in the roff format to
+// the standard output. The page is generated from the commands and their
+// flags. For example, the page is installed with:
+//
+//	semver man > /usr/local/share/man/man1/semver.1
+func defineMan(*flag.FlagSet) runFunc {
+	return func(fs *flag.FlagSet, stdout, _ io.Writer) error {
+		if fs.NArg() != 0 {
+			fs.Usage()
+
+			return fmt.Errorf("%w: unexpected arguments", errUsage)
+		}
+
+		_, err := io.WriteString(stdout, manPage(commandsWithFlags()))
+
+		return err //nolint:wrapcheck // writing to the standard output
+	}
+}
+
+// manPage returns the manual page for the commands.
+func manPage(cmds []commandFlags) string {
+	var sb strings.Builder
+
+	sb.WriteString(".\\\" Generated by \"semver man\".\n")
+	sb.WriteString(".TH SEMVER 1\n")
+	sb.WriteString(".SH NAME\nsemver \\- work with semantic versions\n")
+	sb.WriteString(".SH SYNOPSIS\n.B semver\n.I command\n.RI [ arguments ]\n")
+	sb.WriteString(".SH DESCRIPTION\n")
+	sb.WriteString(".B semver\nis a command-line tool for working with semantic versions.\n")
+	sb.WriteString("Run\n.B semver\n.I command\n.B \\-h\nfor the usage of a command.\n")
+	sb.WriteString(".SH COMMANDS\n")
+
+	for _, c := range cmds {
+		fmt.Fprintf(
+			&sb,
+			".TP\n.B %s\n%s.\n",
+			roffEscape(synopsis(c.command, c.fs)),
+			roffEscape(c.summary),
+		)
+
+		if len(c.flags) == 0 {
+			continue
+		}
+
+		sb.WriteString(".RS\n")
+
+		for _, f := range c.flags {
+			name, usage := flag.UnquoteUsage(f)
+
+			if takesValue(f) {
+				fmt.Fprintf(&sb, ".TP\n.BI \"\\-%s \" %s\n", roffEscape(f.Name), roffEscape(name))
+			} else {
+				fmt.Fprintf(&sb, ".TP\n.B \\-%s\n", roffEscape(f.Name))
+			}
+
+			if f.DefValue != "" && f.DefValue != "false" && f.DefValue != "[]" {
+				usage += fmt.Sprintf(" (default %q)", f.DefValue)
+			}
+
+			sb.WriteString(roffEscape(usage) + "\n")
+		}
+
+		sb.WriteString(".RE\n")
+	}
+
+	sb.WriteString(".SH EXIT STATUS\n")
+	fmt.Fprintf(&sb, ".TP\n%d\nThe command succeeded.\n", exitOK)
+	fmt.Fprintf(&sb, ".TP\n%d\nThe command failed.\n", exitFailure)
+	fmt.Fprintf(&sb, ".TP\n%d\nThe command was invoked incorrectly.\n", exitUsage)
+	sb.WriteString(".SH SEE ALSO\nhttps://semver.org\n")
+
+	return sb.String()
+}
+
+// roffEscape escapes s for a line of text in roff.
+func roffEscape(s string) string {
+	s = strings.NewReplacer(`\`, `\e`, "-", `\-`).Replace(s)
+	if strings.HasPrefix(s, ".") || strings.HasPrefix(s, "'") {
+		s = `\&` + s
+	}
+
+	return s
+}
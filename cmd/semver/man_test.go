@@ -0,0 +1,73 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestRunMan(t *testing.T) {
+	t.Parallel()
+
+	var stdout bytes.Buffer
+
+	if code := run([]string{"man"}, &stdout, &bytes.Buffer{}); code != exitOK {
+		t.Fatalf("run(man) = %d", code)
+	}
+
+	page := stdout.String()
+
+	for _, want := range []string{
+		".TH SEMVER 1\n",
+		".B semver env [\\-export] [\\-prefix name] <version>\n",
+		".BI \"\\-prefix \" name\nthe name of the version variable and the prefix " +
+			"(default \"VERSION\")\n",
+		".B \\-allow\\-prefix\n",
+		".B semver merge\\-driver <base> <current> <other>\n",
+	} {
+		if !strings.Contains(page, want) {
+			t.Errorf("manual page doesn't contain %q", want)
+		}
+	}
+
+	if code := run([]string{"man", "extra"}, &bytes.Buffer{}, &bytes.Buffer{}); code != exitUsage {
+		t.Errorf("run(man extra) = %d, want %d", code, exitUsage)
+	}
+}
+
+func TestRoffEscape(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]string{
+		"-a":         `\-a`,
+		`a\b`:        `a\eb`,
+		".start":     `\&.start`,
+		"'quoted'":   `\&'quoted'`,
+		"plain text": "plain text",
+	}
+
+	for s, want := range tests {
+		if got := roffEscape(s); got != want {
+			t.Errorf("roffEscape(%q) = %q, want %q", s, got, want)
+		}
+	}
+}
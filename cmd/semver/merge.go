@@ -0,0 +1,143 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/anttikivi/semver"
+)
+
+// errConflict is returned when the merge driver can't merge the versions.
+var errConflict = errors.New("merge conflict")
+
+// defineMergeDriver defines the flags of the merge-driver command and returns
+// the command. The command merges the files that contain a single version
+// string, like VERSION files, using [semver.MergeChoice] and writes the result
+// to the current file as git expects from a merge driver. On a conflict,
+// the current file gets conflict markers and the command fails. The driver is
+// configured with:
+//
+//	git config merge.semver.name "semantic version merge driver"
+//	git config merge.semver.driver "semver merge-driver %O %A %B"
+//	echo "VERSION merge=semver" >> .gitattributes
+func defineMergeDriver(*flag.FlagSet) runFunc {
+	return mergeDriver
+}
+
+// mergeDriver merges the version files in the arguments of fs.
+func mergeDriver(fs *flag.FlagSet, _, _ io.Writer) error {
+	if fs.NArg() != 3 { //nolint:mnd // %O %A %B
+		fs.Usage()
+
+		return fmt.Errorf("%w: expected the base, current, and other files", errUsage)
+	}
+
+	base, err := readVersionFile(fs.Arg(0))
+	if err != nil {
+		return err
+	}
+
+	ours, err := readVersionFile(fs.Arg(1))
+	if err != nil {
+		return err
+	}
+
+	theirs, err := readVersionFile(fs.Arg(2))
+	if err != nil {
+		return err
+	}
+
+	text, conflict := mergeVersionFiles(base, ours, theirs)
+
+	if err := os.WriteFile(fs.Arg(1), []byte(text), 0o644); err != nil { //nolint:gosec // git file
+		return fmt.Errorf("failed to write merged file: %w", err)
+	}
+
+	if conflict != "" {
+		return fmt.Errorf("%w: %s", errConflict, conflict)
+	}
+
+	return nil
+}
+
+// readVersionFile reads the contents of the version file at path.
+func readVersionFile(path string) (string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read version file: %w", err)
+	}
+
+	return string(data), nil
+}
+
+// mergeVersionFiles merges the contents of the version files. It returns
+// the merged contents, or the contents with conflict markers and
+// a description of the conflict. The chosen version is written as it is
+// written in its file, so a "v" prefix is kept, and the merged file ends with
+// a newline if the current file does.
+func mergeVersionFiles(base, ours, theirs string) (string, string) {
+	newline := ""
+	if strings.HasSuffix(ours, "\n") {
+		newline = "\n"
+	}
+
+	conflicted := func(reason string) (string, string) {
+		return "<<<<<<< current\n" + strings.TrimSpace(ours) + "\n=======\n" +
+			strings.TrimSpace(theirs) + "\n>>>>>>> other\n", reason
+	}
+
+	var b *semver.Version
+
+	if s := strings.TrimSpace(base); s != "" {
+		v, err := semver.Parse(s)
+		if err != nil {
+			return conflicted(fmt.Sprintf("invalid base version %q", s))
+		}
+
+		b = v
+	}
+
+	o, err := semver.Parse(strings.TrimSpace(ours))
+	if err != nil {
+		return conflicted(fmt.Sprintf("invalid current version %q", strings.TrimSpace(ours)))
+	}
+
+	t, err := semver.Parse(strings.TrimSpace(theirs))
+	if err != nil {
+		return conflicted(fmt.Sprintf("invalid other version %q", strings.TrimSpace(theirs)))
+	}
+
+	v, conflict := semver.MergeChoice(b, o, t)
+	if v == nil {
+		return conflicted(fmt.Sprintf("%s and %s: %s", o, t, conflict))
+	}
+
+	if v.StrictEqual(o) {
+		return strings.TrimSpace(ours) + newline, ""
+	}
+
+	return strings.TrimSpace(theirs) + newline, ""
+}
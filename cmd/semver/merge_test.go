@@ -0,0 +1,113 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestMergeVersionFiles(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		base, ours, theirs string
+		want               string
+		conflict           bool
+	}{
+		{"1.2.0\n", "1.2.1\n", "1.3.0\n", "1.3.0\n", false},
+		{"1.2.0\n", "v2.0.0\n", "1.2.1\n", "v2.0.0\n", false},
+		{"1.2.0\n", "1.2.0", "v1.2.1\n", "v1.2.1", false},
+		{"", "1.2.0\n", "1.3.0\n", "1.3.0\n", false},
+		{
+			"1.2.0\n",
+			"1.1.0\n",
+			"1.3.0\n",
+			"<<<<<<< current\n1.1.0\n=======\n1.3.0\n>>>>>>> other\n",
+			true,
+		},
+		{
+			"1.2.0\n",
+			"1.3.0\n",
+			"next\n",
+			"<<<<<<< current\n1.3.0\n=======\nnext\n>>>>>>> other\n",
+			true,
+		},
+	}
+
+	for _, tt := range tests {
+		got, conflict := mergeVersionFiles(tt.base, tt.ours, tt.theirs)
+		if got != tt.want || (conflict != "") != tt.conflict {
+			t.Errorf(
+				"mergeVersionFiles(%q, %q, %q) = %q, %q, want %q, conflict %t",
+				tt.base,
+				tt.ours,
+				tt.theirs,
+				got,
+				conflict,
+				tt.want,
+				tt.conflict,
+			)
+		}
+	}
+}
+
+func TestRunMergeDriver(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	files := map[string]string{"O": "1.2.0\n", "A": "1.2.1\n", "B": "1.3.0-rc.1\n"}
+
+	for name, data := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(data), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	args := []string{
+		"merge-driver",
+		filepath.Join(dir, "O"),
+		filepath.Join(dir, "A"),
+		filepath.Join(dir, "B"),
+	}
+
+	var stderr bytes.Buffer
+
+	if code := run(args, &bytes.Buffer{}, &stderr); code != exitOK {
+		t.Fatalf("run(%q) = %d, stderr %q", args, code, stderr.String())
+	}
+
+	if data, err := os.ReadFile(args[2]); err != nil || string(data) != "1.3.0-rc.1\n" {
+		t.Errorf("merged file = %q, %v, want %q", data, err, "1.3.0-rc.1\n")
+	}
+
+	stderr.Reset()
+
+	if code := run(args[:2], &bytes.Buffer{}, &stderr); code != exitUsage {
+		t.Errorf("run(%q) = %d, want %d", args[:2], code, exitUsage)
+	}
+
+	if !strings.Contains(stderr.String(), "Usage: semver merge-driver") {
+		t.Errorf("run(%q) stderr = %q, want the usage", args[:2], stderr.String())
+	}
+}
@@ -0,0 +1,127 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/anttikivi/semver"
+)
+
+// errNoGitHubOutput is returned when -github-output is used outside GitHub
+// Actions.
+var errNoGitHubOutput = errors.New("GITHUB_OUTPUT is not set")
+
+// defineParse defines the flags of the parse command and returns the command.
+// The command parses the version and writes its components as key=value
+// lines:
+//
+//	version=1.2.3-rc.1+build.5
+//	major=1
+//	minor=2
+//	patch=3
+//	prerelease=rc.1
+//	build=build.5
+//	is_prerelease=true
+//
+// With -github-output, the lines are appended to the file named by
+// the GITHUB_OUTPUT environment variable, so the later steps of a GitHub
+// Actions workflow can use them as the outputs of the step.
+func defineParse(fs *flag.FlagSet) runFunc {
+	lax := fs.Bool("lax", false, "parse partial versions like 1.2")
+	githubOutput := fs.Bool("github-output", false, "append the output to $GITHUB_OUTPUT")
+
+	return func(fs *flag.FlagSet, stdout, _ io.Writer) error {
+		return parseVersion(fs, stdout, *lax, *githubOutput)
+	}
+}
+
+// parseVersion writes the components of the version in the arguments of fs.
+func parseVersion(fs *flag.FlagSet, stdout io.Writer, lax, githubOutput bool) error {
+	if fs.NArg() != 1 {
+		fs.Usage()
+
+		return fmt.Errorf("%w: expected one version", errUsage)
+	}
+
+	parse := semver.Parse
+	if lax {
+		parse = semver.ParseLax
+	}
+
+	v, err := parse(fs.Arg(0))
+	if err != nil {
+		return err //nolint:wrapcheck // the error describes the version
+	}
+
+	var sb strings.Builder
+
+	for _, kv := range outputs(v) {
+		sb.WriteString(kv[0] + "=" + kv[1] + "\n")
+	}
+
+	if !githubOutput {
+		_, err := io.WriteString(stdout, sb.String())
+
+		return err //nolint:wrapcheck // writing to the standard output
+	}
+
+	name := os.Getenv("GITHUB_OUTPUT")
+	if name == "" {
+		return errNoGitHubOutput
+	}
+
+	//nolint:gosec // the file is provided by the runner
+	f, err := os.OpenFile(name, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return fmt.Errorf("failed to open GitHub output: %w", err)
+	}
+
+	if _, err := f.WriteString(sb.String()); err != nil {
+		_ = f.Close()
+
+		return fmt.Errorf("failed to write GitHub output: %w", err)
+	}
+
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("failed to write GitHub output: %w", err)
+	}
+
+	return nil
+}
+
+// outputs returns the keys and values of the components of v.
+func outputs(v *semver.Version) [][2]string {
+	return [][2]string{
+		{"version", v.String()},
+		{"major", strconv.FormatUint(v.Major, 10)},
+		{"minor", strconv.FormatUint(v.Minor, 10)},
+		{"patch", strconv.FormatUint(v.Patch, 10)},
+		{"prerelease", v.Prerelease.String()},
+		{"build", v.Build.String()},
+		{"is_prerelease", strconv.FormatBool(len(v.Prerelease) > 0)},
+	}
+}
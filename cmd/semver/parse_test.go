@@ -0,0 +1,96 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestRunParse(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		args []string
+		code int
+		want string
+	}{
+		{
+			[]string{"parse", "v1.2.3-rc.1+build.5"},
+			exitOK,
+			"version=1.2.3-rc.1+build.5\nmajor=1\nminor=2\npatch=3\nprerelease=rc.1\n" +
+				"build=build.5\nis_prerelease=true\n",
+		},
+		{
+			[]string{"parse", "-lax", "2"},
+			exitOK,
+			"version=2.0.0\nmajor=2\nminor=0\npatch=0\nprerelease=\nbuild=\nis_prerelease=false\n",
+		},
+		{[]string{"parse", "2"}, exitFailure, ""},
+		{[]string{"parse"}, exitUsage, ""},
+	}
+
+	for _, tt := range tests {
+		var stdout bytes.Buffer
+
+		code := run(tt.args, &stdout, &bytes.Buffer{})
+		if code != tt.code || stdout.String() != tt.want {
+			t.Errorf(
+				"run(%q) = %d, %q, want %d, %q",
+				tt.args,
+				code,
+				stdout.String(),
+				tt.code,
+				tt.want,
+			)
+		}
+	}
+}
+
+//nolint:paralleltest // sets an environment variable
+func TestRunParseGitHubOutput(t *testing.T) {
+	name := filepath.Join(t.TempDir(), "output")
+	if err := os.WriteFile(name, []byte("previous=1\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Setenv("GITHUB_OUTPUT", name)
+
+	var stdout, stderr bytes.Buffer
+
+	args := []string{"parse", "--github-output", "1.0.0"}
+	if code := run(args, &stdout, &stderr); code != exitOK || stdout.Len() != 0 {
+		t.Fatalf("run(%q) = %d, stdout %q, stderr %q", args, code, stdout.String(), stderr.String())
+	}
+
+	want := "previous=1\nversion=1.0.0\nmajor=1\nminor=0\npatch=0\nprerelease=\nbuild=\n" +
+		"is_prerelease=false\n"
+	if data, err := os.ReadFile(name); err != nil || string(data) != want {
+		t.Errorf("GITHUB_OUTPUT = %q, %v, want %q", data, err, want)
+	}
+
+	t.Setenv("GITHUB_OUTPUT", "")
+
+	if code := run(args, &stdout, &stderr); code != exitFailure {
+		t.Errorf("run(%q) without GITHUB_OUTPUT = %d, want %d", args, code, exitFailure)
+	}
+}
@@ -0,0 +1,255 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver
+
+import (
+	"cmp"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"unsafe"
+)
+
+var _ sort.Interface = (*VersionColumns)(nil)
+
+// VersionColumns is a columnar representation of a list of versions for
+// analytical workloads over large numbers of versions. The major, minor, and
+// patch versions are stored in parallel slices, and the pre-release
+// identifiers and the build metadata of all of the versions are stored in
+// a single byte arena, so the list doesn't store a pointer per version.
+// The zero value is an empty list ready to use.
+//
+// The pre-release identifiers and the build metadata of all of the versions
+// may take at most 4 GiB in total.
+type VersionColumns struct {
+	majors  []uint64
+	minors  []uint64
+	patches []uint64
+
+	// arena holds the pre-release identifiers and the build metadata of
+	// the versions in the format of CompactVersion.
+	arena []byte
+
+	// starts, builds, and ends are the offsets of the version in arena:
+	// the pre-release is arena[starts[i]:builds[i]] and the build metadata
+	// with the leading '+' is arena[builds[i]:ends[i]].
+	starts []uint32
+	builds []uint32
+	ends   []uint32
+}
+
+// NewVersionColumns returns a new VersionColumns that contains the given
+// versions in the same order.
+func NewVersionColumns(versions Versions) *VersionColumns {
+	n := len(versions)
+	c := &VersionColumns{
+		majors:  make([]uint64, 0, n),
+		minors:  make([]uint64, 0, n),
+		patches: make([]uint64, 0, n),
+		arena:   nil,
+		starts:  make([]uint32, 0, n),
+		builds:  make([]uint32, 0, n),
+		ends:    make([]uint32, 0, n),
+	}
+
+	for _, v := range versions {
+		c.Append(v)
+	}
+
+	return c
+}
+
+// Append appends v to the end of the list. It panics if the arena of
+// the pre-release identifiers and the build metadata grows too large.
+func (c *VersionColumns) Append(v *Version) {
+	start := len(c.arena)
+
+	for i, id := range v.Prerelease {
+		if i > 0 {
+			c.arena = append(c.arena, '.')
+		}
+
+		c.arena = append(c.arena, id.String()...)
+	}
+
+	build := len(c.arena)
+
+	for i, id := range v.Build {
+		if i == 0 {
+			c.arena = append(c.arena, '+')
+		} else {
+			c.arena = append(c.arena, '.')
+		}
+
+		c.arena = append(c.arena, id...)
+	}
+
+	if len(c.arena) > math.MaxUint32 {
+		panic(fmt.Sprintf("version columns arena too large: %d bytes", len(c.arena)))
+	}
+
+	c.majors = append(c.majors, v.Major)
+	c.minors = append(c.minors, v.Minor)
+	c.patches = append(c.patches, v.Patch)
+	c.starts = append(c.starts, uint32(start))    //nolint:gosec // checked above
+	c.builds = append(c.builds, uint32(build))    //nolint:gosec // checked above
+	c.ends = append(c.ends, uint32(len(c.arena))) //nolint:gosec // checked above
+}
+
+// Len returns the number of versions in the list.
+func (c *VersionColumns) Len() int {
+	return len(c.majors)
+}
+
+// Majors returns the column of the major versions. The returned slice is
+// shared with c and must not be modified.
+func (c *VersionColumns) Majors() []uint64 {
+	return c.majors
+}
+
+// Minors returns the column of the minor versions. The returned slice is
+// shared with c and must not be modified.
+func (c *VersionColumns) Minors() []uint64 {
+	return c.minors
+}
+
+// Patches returns the column of the patch versions. The returned slice is
+// shared with c and must not be modified.
+func (c *VersionColumns) Patches() []uint64 {
+	return c.patches
+}
+
+// At returns the version at index i as a new [Version].
+func (c *VersionColumns) At(i int) *Version {
+	return c.Compact(i).Version()
+}
+
+// Compact returns the version at index i as a [CompactVersion].
+func (c *VersionColumns) Compact(i int) CompactVersion {
+	ext := string(c.arena[c.starts[i]:c.ends[i]])
+
+	return CompactVersion{
+		Major: c.majors[i],
+		Minor: c.minors[i],
+		Patch: c.patches[i],
+		ext:   ext,
+		build: int(c.builds[i] - c.starts[i]),
+	}
+}
+
+// Versions returns the versions in the list as new versions.
+func (c *VersionColumns) Versions() Versions {
+	vs := make(Versions, c.Len())
+	for i := range vs {
+		vs[i] = c.At(i)
+	}
+
+	return vs
+}
+
+// Compare compares the versions at indexes i and j like [Version.Compare].
+func (c *VersionColumns) Compare(i, j int) int {
+	if d := cmp.Compare(c.majors[i], c.majors[j]); d != 0 {
+		return d
+	}
+
+	if d := cmp.Compare(c.minors[i], c.minors[j]); d != 0 {
+		return d
+	}
+
+	if d := cmp.Compare(c.patches[i], c.patches[j]); d != 0 {
+		return d
+	}
+
+	return comparePrereleaseStrings(c.prerelease(i), c.prerelease(j))
+}
+
+// CompareEach compares every version in the list to v like [Version.Compare]
+// and stores the results in dst, which is grown if needed. It returns
+// the results.
+func (c *VersionColumns) CompareEach(v *Version, dst []int) []int {
+	dst = append(dst[:0], make([]int, c.Len())...)
+	pre := v.Prerelease.String()
+
+	for i := range dst {
+		switch {
+		case c.majors[i] != v.Major:
+			dst[i] = cmp.Compare(c.majors[i], v.Major)
+		case c.minors[i] != v.Minor:
+			dst[i] = cmp.Compare(c.minors[i], v.Minor)
+		case c.patches[i] != v.Patch:
+			dst[i] = cmp.Compare(c.patches[i], v.Patch)
+		default:
+			dst[i] = comparePrereleaseStrings(c.prerelease(i), pre)
+		}
+	}
+
+	return dst
+}
+
+// Less reports whether the version at index i has lower precedence than
+// the version at index j.
+func (c *VersionColumns) Less(i, j int) bool {
+	return c.Compare(i, j) < 0
+}
+
+// Swap swaps the versions at indexes i and j.
+func (c *VersionColumns) Swap(i, j int) {
+	c.majors[i], c.majors[j] = c.majors[j], c.majors[i]
+	c.minors[i], c.minors[j] = c.minors[j], c.minors[i]
+	c.patches[i], c.patches[j] = c.patches[j], c.patches[i]
+	c.starts[i], c.starts[j] = c.starts[j], c.starts[i]
+	c.builds[i], c.builds[j] = c.builds[j], c.builds[i]
+	c.ends[i], c.ends[j] = c.ends[j], c.ends[i]
+}
+
+// Sort sorts the versions in increasing order of precedence. The versions with
+// equal precedence keep their original order.
+func (c *VersionColumns) Sort() {
+	sort.Stable(c)
+}
+
+// prerelease returns the pre-release string of the version at index i. The
+// returned string shares memory with the arena, so it must not be retained
+// after the arena is modified.
+func (c *VersionColumns) prerelease(i int) string {
+	b := c.arena[c.starts[i]:c.builds[i]]
+	if len(b) == 0 {
+		return ""
+	}
+
+	return unsafe.String(unsafe.SliceData(b), len(b)) //nolint:gosec // the string is not retained
+}
+
+// String returns the versions in the list separated by spaces.
+func (c *VersionColumns) String() string {
+	var sb strings.Builder
+
+	for i := range c.Len() {
+		if i > 0 {
+			sb.WriteByte(' ')
+		}
+
+		sb.WriteString(c.Compact(i).String())
+	}
+
+	return sb.String()
+}
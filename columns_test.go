@@ -0,0 +1,91 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver_test
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/anttikivi/semver"
+	"github.com/anttikivi/semver/semvertest"
+)
+
+func TestVersionColumns(t *testing.T) {
+	t.Parallel()
+
+	vs := semvertest.SpecChain()
+	vs = append(vs, semver.MustParse("1.0.0-beta+build.5"), semver.MustParse("0.1.0+linux"))
+
+	c := semver.NewVersionColumns(vs)
+
+	if c.Len() != len(vs) {
+		t.Fatalf("VersionColumns.Len() = %d, want %d", c.Len(), len(vs))
+	}
+
+	for i, v := range vs {
+		if got := c.At(i); !got.StrictEqual(v) {
+			t.Errorf("VersionColumns.At(%d) = %q, want %q", i, got, v)
+		}
+
+		for j, w := range vs {
+			if got, want := c.Compare(i, j), v.Compare(w); got != want {
+				t.Errorf("VersionColumns.Compare(%q, %q) = %d, want %d", v, w, got, want)
+			}
+		}
+	}
+
+	want := make([]int, len(vs))
+	for i, v := range vs {
+		want[i] = v.Compare(semver.MustParse("1.0.0-beta"))
+	}
+
+	if got := c.CompareEach(semver.MustParse("1.0.0-beta"), nil); !reflect.DeepEqual(got, want) {
+		t.Errorf("VersionColumns.CompareEach() = %v, want %v", got, want)
+	}
+}
+
+func TestVersionColumnsSort(t *testing.T) {
+	t.Parallel()
+
+	want := versionStrings(semvertest.SpecChain())
+
+	vs := semvertest.SpecChain()
+	slices.Reverse(vs)
+
+	c := semver.NewVersionColumns(vs)
+	c.Sort()
+
+	if got := versionStrings(c.Versions()); !reflect.DeepEqual(got, want) {
+		t.Errorf("VersionColumns.Sort() = %v, want %v", got, want)
+	}
+
+	if got := c.Majors(); got[0] != 1 || got[len(got)-1] != 2 {
+		t.Errorf("VersionColumns.Majors() = %v after sorting", got)
+	}
+
+	var empty semver.VersionColumns
+
+	empty.Append(semver.MustParse("1.2.3-rc.1"))
+
+	if got := empty.String(); got != "1.2.3-rc.1" {
+		t.Errorf("VersionColumns.String() = %q, want \"1.2.3-rc.1\"", got)
+	}
+}
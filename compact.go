@@ -0,0 +1,230 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver
+
+import (
+	"cmp"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// A CompactVersion is a memory-efficient representation of a [Version] for
+// programs that store large numbers of versions. Instead of a slice of
+// identifiers, it stores the pre-release identifiers and the build metadata in
+// a single string, so a CompactVersion takes a fixed amount of memory and one
+// string allocation at most. The zero value is version "0.0.0".
+//
+// A CompactVersion is an immutable value and it is safe for concurrent use.
+// The identifiers are parsed from the string when they are needed, so
+// the operations on a CompactVersion are slower than the operations on
+// [Version]. Use [CompactVersion.Version] for converting it back to a Version.
+//
+// CompactVersion exists alongside Version instead of replacing its internal
+// layout: the Prerelease and Build fields of Version are part of the stable
+// API, so they can't be hidden behind accessors before a new major version of
+// the module.
+type CompactVersion struct {
+	Major uint64
+	Minor uint64
+	Patch uint64
+
+	// ext is the pre-release part and the build metadata of the version
+	// without the leading '-', separated by a '+'.
+	ext string
+
+	// build is the index of the '+' in ext, or the length of ext if there is
+	// no build metadata.
+	build int
+}
+
+// MustParseCompact parses the given string into a CompactVersion and panics
+// if it encounters an error.
+func MustParseCompact(s string) CompactVersion {
+	c, err := ParseCompact(s)
+	if err != nil {
+		panic(fmt.Sprintf("failed to parse the string %q into a version: %v", s, err))
+	}
+
+	return c
+}
+
+// ParseCompact parses the given string into a CompactVersion. It accepts
+// the same version strings as [Parse].
+func ParseCompact(s string) (CompactVersion, error) {
+	v, err := Parse(s)
+	if err != nil {
+		return CompactVersion{}, err
+	}
+
+	return v.Compact(), nil
+}
+
+// Compact returns the compact representation of v.
+func (v *Version) Compact() CompactVersion {
+	pre := v.Prerelease.String()
+
+	var sb strings.Builder
+
+	sb.Grow(len(pre) + 1 + len(v.Build.String()))
+	sb.WriteString(pre)
+
+	build := sb.Len()
+
+	if len(v.Build) > 0 {
+		sb.WriteByte('+')
+		sb.WriteString(v.Build.String())
+	}
+
+	return CompactVersion{Major: v.Major, Minor: v.Minor, Patch: v.Patch, ext: sb.String(), build: build}
+}
+
+// Version returns c as a new [Version].
+func (c CompactVersion) Version() *Version {
+	v := coreVersion(c.Major, c.Minor, c.Patch)
+
+	if pre := c.PrereleaseString(); pre != "" {
+		parts := strings.Split(pre, ".")
+		v.Prerelease = make(Prerelease, len(parts))
+
+		for i, p := range parts {
+			id, err := parsePrereleaseIdentifier(p)
+			if err != nil {
+				panic(fmt.Sprintf("invalid pre-release identifier in a compact version: %q", p))
+			}
+
+			v.Prerelease[i] = id
+		}
+	}
+
+	if b := c.BuildString(); b != "" {
+		v.Build = strings.Split(b, ".")
+	}
+
+	return v
+}
+
+// PrereleaseString returns the pre-release identifiers of c separated by dots,
+// or an empty string if c is not a pre-release version.
+func (c CompactVersion) PrereleaseString() string {
+	return c.ext[:c.build]
+}
+
+// BuildString returns the build metadata identifiers of c separated by dots,
+// or an empty string if c has no build metadata.
+func (c CompactVersion) BuildString() string {
+	if c.build == len(c.ext) {
+		return ""
+	}
+
+	return c.ext[c.build+1:]
+}
+
+// Compare returns
+//
+//	-1 if c is less than o,
+//	 0 if c equals o,
+//	+1 if c is greater than o.
+//
+// The comparison is done according to the semantic versioning specification
+// and gives the same result as [Version.Compare].
+func (c CompactVersion) Compare(o CompactVersion) int {
+	if d := cmp.Compare(c.Major, o.Major); d != 0 {
+		return d
+	}
+
+	if d := cmp.Compare(c.Minor, o.Minor); d != 0 {
+		return d
+	}
+
+	if d := cmp.Compare(c.Patch, o.Patch); d != 0 {
+		return d
+	}
+
+	return comparePrereleaseStrings(c.PrereleaseString(), o.PrereleaseString())
+}
+
+// Equal reports whether c and o have equal precedence, i.e. whether they are
+// equal excluding the build metadata.
+func (c CompactVersion) Equal(o CompactVersion) bool {
+	return c.Major == o.Major && c.Minor == o.Minor && c.Patch == o.Patch && c.PrereleaseString() == o.PrereleaseString()
+}
+
+// String returns the string representation of c.
+func (c CompactVersion) String() string {
+	var sb strings.Builder
+
+	sb.WriteString(strconv.FormatUint(c.Major, 10))
+	sb.WriteByte('.')
+	sb.WriteString(strconv.FormatUint(c.Minor, 10))
+	sb.WriteByte('.')
+	sb.WriteString(strconv.FormatUint(c.Patch, 10))
+
+	if c.build > 0 {
+		sb.WriteByte('-')
+	}
+
+	sb.WriteString(c.ext)
+
+	return sb.String()
+}
+
+// comparePrereleaseStrings compares two valid pre-release strings, i.e.
+// the pre-release identifiers separated by dots, according to the semantic
+// versioning specification. An empty string means a version without
+// the pre-release identifiers.
+func comparePrereleaseStrings(x, y string) int {
+	switch {
+	case x == y:
+		return 0
+	case x == "":
+		return 1
+	case y == "":
+		return -1
+	}
+
+	for x != "" && y != "" {
+		var xi, yi string
+
+		xi, x, _ = strings.Cut(x, ".")
+		yi, y, _ = strings.Cut(y, ".")
+
+		xNum, yNum := isNumericIdentifier(xi), isNumericIdentifier(yi)
+
+		var d int
+
+		switch {
+		case xNum && yNum:
+			d = compareDigits(xi, yi)
+		case xNum:
+			d = -1
+		case yNum:
+			d = 1
+		default:
+			d = cmp.Compare(xi, yi)
+		}
+
+		if d != 0 {
+			return d
+		}
+	}
+
+	return cmp.Compare(len(x), len(y))
+}
@@ -0,0 +1,79 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver_test
+
+import (
+	"testing"
+	"unsafe"
+
+	"github.com/anttikivi/semver"
+	"github.com/anttikivi/semver/semvertest"
+)
+
+func TestCompactVersion(t *testing.T) {
+	t.Parallel()
+
+	for _, s := range []string{
+		"0.0.0", "1.2.3", "1.2.3-rc.1", "1.2.3+build.5", "1.2.3-alpha.beta.11+linux.amd64", "10.20.30-0",
+	} {
+		c := semver.MustParseCompact(s)
+
+		if got := c.String(); got != s {
+			t.Errorf("ParseCompact(%q).String() = %q", s, got)
+		}
+
+		if got := c.Version(); !got.StrictEqual(semver.MustParse(s)) {
+			t.Errorf("ParseCompact(%q).Version() = %q", s, got)
+		}
+	}
+
+	c := semver.MustParseCompact("1.2.3-rc.1+linux")
+	if c.PrereleaseString() != "rc.1" || c.BuildString() != "linux" {
+		t.Errorf("ParseCompact(\"1.2.3-rc.1+linux\") = %q, %q, want \"rc.1\", \"linux\"", c.PrereleaseString(), c.BuildString())
+	}
+
+	if _, err := semver.ParseCompact("1.2"); err == nil {
+		t.Error("ParseCompact(\"1.2\") succeeded unexpectedly")
+	}
+
+	if size := unsafe.Sizeof(semver.CompactVersion{}); size >= unsafe.Sizeof(semver.Version{}) {
+		t.Errorf("CompactVersion is %d bytes, not smaller than Version", size)
+	}
+}
+
+func TestCompactVersionCompare(t *testing.T) {
+	t.Parallel()
+
+	chain := semvertest.SpecChain()
+	chain = append(chain, semver.MustParse("1.0.0-beta+build"), semver.MustParse("1.0.0-alpha.1.2"))
+
+	for _, v := range chain {
+		for _, w := range chain {
+			want := v.Compare(w)
+			if got := v.Compact().Compare(w.Compact()); got != want {
+				t.Errorf("CompactVersion{%q}.Compare(%q) = %d, want %d", v, w, got, want)
+			}
+
+			if got := v.Compact().Equal(w.Compact()); got != v.Equal(w) {
+				t.Errorf("CompactVersion{%q}.Equal(%q) = %v, want %v", v, w, got, v.Equal(w))
+			}
+		}
+	}
+}
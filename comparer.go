@@ -0,0 +1,190 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+)
+
+// A Comparer compares versions using an ordering that can be configured to
+// differ from the precedence defined by the semantic versioning
+// specification. The zero value compares the versions according to
+// the specification, like [Compare].
+type Comparer struct {
+	// FoldCase makes the alphanumeric identifiers compare case-insensitively.
+	FoldCase bool
+
+	// BuildMetadata makes the build metadata break the ties between versions
+	// that otherwise have equal precedence. A version without build metadata
+	// sorts before a version with it, and the build identifiers are compared
+	// like pre-release identifiers.
+	BuildMetadata bool
+
+	// NumericSuffix makes the alphanumeric identifiers that end in digits
+	// compare the trailing digits numerically when the rest of
+	// the identifiers are equal, so that "beta2" sorts before "beta11".
+	NumericSuffix bool
+
+	// Natural makes the alphanumeric identifiers compare in natural order:
+	// every run of digits in the identifiers is compared numerically, so
+	// that "beta9" sorts before "beta10" and "rc1.a9" before "rc1.a10". It
+	// takes precedence over NumericSuffix. The runs that are numerically
+	// equal but written differently, like "01" and "1", are ordered
+	// lexically.
+	Natural bool
+}
+
+// Compare returns
+//
+//	-1 if v is less than w,
+//	 0 if v equals w,
+//	+1 if v is greater than w.
+//
+// The comparison is done according to the semantic versioning specification
+// as modified by the options of c.
+func (c *Comparer) Compare(v, w *Version) int {
+	if d := cmp.Compare(v.Major, w.Major); d != 0 {
+		return d
+	}
+
+	if d := cmp.Compare(v.Minor, w.Minor); d != 0 {
+		return d
+	}
+
+	if d := cmp.Compare(v.Patch, w.Patch); d != 0 {
+		return d
+	}
+
+	switch {
+	case len(v.Prerelease) == 0 && len(w.Prerelease) > 0:
+		return 1
+	case len(v.Prerelease) > 0 && len(w.Prerelease) == 0:
+		return -1
+	}
+
+	for i := range min(len(v.Prerelease), len(w.Prerelease)) {
+		if d := c.compareIdentifiers(v.Prerelease[i].String(), w.Prerelease[i].String()); d != 0 {
+			return d
+		}
+	}
+
+	if d := cmp.Compare(len(v.Prerelease), len(w.Prerelease)); d != 0 || !c.BuildMetadata {
+		return d
+	}
+
+	for i := range min(len(v.Build), len(w.Build)) {
+		if d := c.compareIdentifiers(v.Build[i], w.Build[i]); d != 0 {
+			return d
+		}
+	}
+
+	return cmp.Compare(len(v.Build), len(w.Build))
+}
+
+// Sort sorts the versions in increasing order according to c. The versions
+// that c considers equal keep their original order.
+func (c *Comparer) Sort(versions Versions) {
+	slices.SortStableFunc(versions, c.Compare)
+}
+
+// compareIdentifiers compares two pre-release or build identifiers.
+func (c *Comparer) compareIdentifiers(x, y string) int {
+	xNum, yNum := isNumericIdentifier(x), isNumericIdentifier(y)
+
+	switch {
+	case xNum && yNum:
+		return compareDigits(x, y)
+	case xNum:
+		return -1
+	case yNum:
+		return 1
+	}
+
+	if c.FoldCase {
+		x, y = strings.ToLower(x), strings.ToLower(y)
+	}
+
+	if c.Natural {
+		return compareNatural(x, y)
+	}
+
+	if c.NumericSuffix {
+		xs, ys := strings.TrimRight(x, "0123456789"), strings.TrimRight(y, "0123456789")
+		if xs == ys && len(x) > len(xs) && len(y) > len(ys) {
+			return compareDigits(x[len(xs):], y[len(ys):])
+		}
+	}
+
+	return cmp.Compare(x, y)
+}
+
+// compareDigits compares two strings of digits numerically. The strings may
+// have leading zeros and they may be too long to fit in an integer.
+func compareDigits(x, y string) int {
+	x, y = strings.TrimLeft(x, "0"), strings.TrimLeft(y, "0")
+
+	if d := cmp.Compare(len(x), len(y)); d != 0 {
+		return d
+	}
+
+	return cmp.Compare(x, y)
+}
+
+// compareNatural compares two strings in natural order.
+func compareNatural(x, y string) int {
+	i, j := 0, 0
+
+	for i < len(x) && j < len(y) {
+		if !isDigit(x[i]) || !isDigit(y[j]) {
+			if d := cmp.Compare(x[i], y[j]); d != 0 {
+				return d
+			}
+
+			i++
+			j++
+
+			continue
+		}
+
+		m, n := i, j
+
+		for m < len(x) && isDigit(x[m]) {
+			m++
+		}
+
+		for n < len(y) && isDigit(y[n]) {
+			n++
+		}
+
+		if d := compareDigits(x[i:m], y[j:n]); d != 0 {
+			return d
+		}
+
+		i, j = m, n
+	}
+
+	if d := cmp.Compare(len(x)-i, len(y)-j); d != 0 {
+		return d
+	}
+
+	return cmp.Compare(x, y)
+}
@@ -0,0 +1,108 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver_test
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/anttikivi/semver"
+	"github.com/anttikivi/semver/semvertest"
+)
+
+func TestComparer(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		c    semver.Comparer
+		v    string
+		w    string
+		want int
+	}{
+		{"spec", semver.Comparer{}, "1.0.0-beta2", "1.0.0-beta11", 1},
+		{"spec case", semver.Comparer{}, "1.0.0-Beta", "1.0.0-alpha", -1},
+		{"spec build", semver.Comparer{}, "1.0.0+b", "1.0.0+a", 0},
+		{"numeric suffix", semver.Comparer{NumericSuffix: true}, "1.0.0-beta2", "1.0.0-beta11", -1},
+		{"numeric suffix prefix", semver.Comparer{NumericSuffix: true}, "1.0.0-alpha9", "1.0.0-beta1", -1},
+		{"numeric suffix none", semver.Comparer{NumericSuffix: true}, "1.0.0-beta", "1.0.0-beta1", -1},
+		{"natural", semver.Comparer{Natural: true}, "1.0.0-beta9", "1.0.0-beta10", -1},
+		{"natural middle", semver.Comparer{Natural: true}, "1.0.0-rc2a10", "1.0.0-rc2a9", 1},
+		{"natural prefix", semver.Comparer{Natural: true}, "1.0.0-beta", "1.0.0-beta1", -1},
+		{"natural letters", semver.Comparer{Natural: true}, "1.0.0-alpha10", "1.0.0-beta2", -1},
+		{"natural zeros", semver.Comparer{Natural: true}, "1.0.0-a01", "1.0.0-a1", -1},
+		{"natural numeric", semver.Comparer{Natural: true}, "1.0.0-10", "1.0.0-a", -1},
+		{"fold case", semver.Comparer{FoldCase: true}, "1.0.0-Beta", "1.0.0-alpha", 1},
+		{"fold case equal", semver.Comparer{FoldCase: true}, "1.0.0-RC.1", "1.0.0-rc.1", 0},
+		{"build", semver.Comparer{BuildMetadata: true}, "1.0.0+b", "1.0.0+a", 1},
+		{"build missing", semver.Comparer{BuildMetadata: true}, "1.0.0", "1.0.0+a", -1},
+		{"build numeric", semver.Comparer{BuildMetadata: true}, "1.0.0+9", "1.0.0+10", -1},
+		{"build precedence", semver.Comparer{BuildMetadata: true}, "1.0.0-rc+z", "1.0.0+a", -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tt.c.Compare(semver.MustParse(tt.v), semver.MustParse(tt.w)); got != tt.want {
+				t.Errorf("%+v.Compare(%q, %q) = %d, want %d", tt.c, tt.v, tt.w, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestComparerSort(t *testing.T) {
+	t.Parallel()
+
+	var vs semver.Versions
+	for _, s := range []string{"1.0.0-beta11", "1.0.0", "1.0.0-beta2", "1.0.0-alpha", "0.9.0"} {
+		vs = append(vs, semver.MustParse(s))
+	}
+
+	c := &semver.Comparer{NumericSuffix: true}
+	c.Sort(vs)
+
+	want := []string{"0.9.0", "1.0.0-alpha", "1.0.0-beta2", "1.0.0-beta11", "1.0.0"}
+	if got := versionStrings(vs); !reflect.DeepEqual(got, want) {
+		t.Errorf("Comparer.Sort() = %v, want %v", got, want)
+	}
+}
+
+func TestComparerSpecChain(t *testing.T) {
+	t.Parallel()
+
+	want := versionStrings(semvertest.SpecChain())
+
+	for _, c := range []semver.Comparer{
+		{},
+		{FoldCase: true, BuildMetadata: true},
+		{NumericSuffix: true},
+		{Natural: true},
+	} {
+		vs := semvertest.SpecChain()
+		slices.Reverse(vs)
+		c.Sort(vs)
+
+		if got := versionStrings(vs); !reflect.DeepEqual(got, want) {
+			t.Errorf("%+v.Sort(SpecChain()) = %v, want %v", c, got, want)
+		}
+	}
+}
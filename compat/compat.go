@@ -0,0 +1,95 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+// Package compat converts versions between package semver and the other
+// widely used semantic versioning libraries, github.com/Masterminds/semver/v3,
+// github.com/blang/semver/v4, and github.com/hashicorp/go-version, for
+// migrating a large codebase to package semver incrementally. The constraints
+// of go-version, which are common in the tools for Terraform, can also be
+// converted. The package is a separate module so that package semver itself
+// doesn't depend on the other libraries.
+//
+// The conversions from the other libraries return an error if the version is
+// not valid according to the semantic versioning specification, as
+// the other libraries accept some versions that package semver doesn't, like
+// the pre-release identifiers with leading zeros.
+package compat
+
+import (
+	"fmt"
+
+	msemver "github.com/Masterminds/semver/v3"
+	bsemver "github.com/blang/semver/v4"
+
+	"github.com/anttikivi/semver"
+)
+
+// ToMasterminds converts v into a Masterminds version.
+func ToMasterminds(v *semver.Version) *msemver.Version {
+	return msemver.New(v.Major, v.Minor, v.Patch, v.Prerelease.String(), v.Build.String())
+}
+
+// FromMasterminds converts the Masterminds version v into a version. The "v"
+// prefix and the missing version numbers of the original version string,
+// which Masterminds accepts, are not kept.
+func FromMasterminds(v *msemver.Version) (*semver.Version, error) {
+	w, err := semver.Parse(v.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert Masterminds version %q: %w", v.Original(), err)
+	}
+
+	return w, nil
+}
+
+// ToBlang converts v into a blang version.
+func ToBlang(v *semver.Version) bsemver.Version {
+	w := bsemver.Version{
+		Major: v.Major,
+		Minor: v.Minor,
+		Patch: v.Patch,
+		Pre:   nil,
+		Build: nil,
+	}
+
+	for _, id := range v.Prerelease {
+		// The identifiers of a valid version are always valid in blang.
+		p, err := bsemver.NewPRVersion(id.String())
+		if err != nil {
+			panic(fmt.Sprintf("compat: invalid pre-release identifier %q: %v", id, err))
+		}
+
+		w.Pre = append(w.Pre, p)
+	}
+
+	if len(v.Build) > 0 {
+		w.Build = append([]string(nil), v.Build...)
+	}
+
+	return w
+}
+
+// FromBlang converts the blang version v into a version.
+func FromBlang(v bsemver.Version) (*semver.Version, error) {
+	w, err := semver.Parse(v.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert blang version %q: %w", v, err)
+	}
+
+	return w, nil
+}
@@ -0,0 +1,90 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package compat_test
+
+import (
+	"errors"
+	"testing"
+
+	msemver "github.com/Masterminds/semver/v3"
+	bsemver "github.com/blang/semver/v4"
+
+	"github.com/anttikivi/semver"
+	"github.com/anttikivi/semver/compat"
+)
+
+var conversionTests = []string{
+	"0.0.0",
+	"1.2.3",
+	"1.2.3-rc.1",
+	"1.2.3-alpha-1.0.x--y",
+	"1.2.3+build.001",
+	"18446744073709551615.0.0-0+sha.abc",
+}
+
+func TestMasterminds(t *testing.T) {
+	t.Parallel()
+
+	for _, s := range conversionTests {
+		v := semver.MustParse(s)
+
+		m := compat.ToMasterminds(v)
+		if m.String() != s {
+			t.Errorf("ToMasterminds(%q) = %q", s, m)
+		}
+
+		w, err := compat.FromMasterminds(m)
+		if err != nil || !w.StrictEqual(v) {
+			t.Errorf("FromMasterminds(ToMasterminds(%q)) = %v, %v", s, w, err)
+		}
+	}
+
+	m, err := msemver.NewVersion("v1.2")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if w, err := compat.FromMasterminds(m); err != nil || w.String() != "1.2.0" {
+		t.Errorf("FromMasterminds(v1.2) = %v, %v, want 1.2.0", w, err)
+	}
+}
+
+func TestBlang(t *testing.T) {
+	t.Parallel()
+
+	for _, s := range conversionTests {
+		v := semver.MustParse(s)
+
+		b := compat.ToBlang(v)
+		if b.String() != s {
+			t.Errorf("ToBlang(%q) = %q", s, b)
+		}
+
+		w, err := compat.FromBlang(b)
+		if err != nil || !w.StrictEqual(v) {
+			t.Errorf("FromBlang(ToBlang(%q)) = %v, %v", s, w, err)
+		}
+	}
+
+	b := bsemver.Version{Major: 1, Pre: []bsemver.PRVersion{{VersionStr: ""}}}
+	if _, err := compat.FromBlang(b); !errors.Is(err, semver.ErrInvalidVersion) {
+		t.Errorf("FromBlang(%v) error = %v, want %v", b, err, semver.ErrInvalidVersion)
+	}
+}
@@ -0,0 +1,13 @@
+module github.com/anttikivi/semver/compat
+
+go 1.24
+
+require (
+	github.com/Masterminds/semver/v3 v3.4.0
+	github.com/anttikivi/semver v1.0.0
+	github.com/blang/semver/v4 v4.0.0
+	github.com/google/go-cmp v0.7.0
+	github.com/hashicorp/go-version v1.9.0
+)
+
+replace github.com/anttikivi/semver => ../
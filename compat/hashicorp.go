@@ -0,0 +1,244 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package compat
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	hversion "github.com/hashicorp/go-version"
+
+	"github.com/anttikivi/semver"
+)
+
+// ErrUnsupportedConstraint is returned by [FromHashicorpConstraints] if the
+// constraints can't be expressed as a [semver.Constraint].
+var ErrUnsupportedConstraint = errors.New("unsupported constraint")
+
+// hashicorpOperators are the operators of go-version constraints in the order
+// in which go-version matches them.
+var hashicorpOperators = []string{"<=", ">=", "!=", "~>", "<", ">", "="}
+
+// hashicorpComparison is a single comparison of go-version constraints.
+type hashicorpComparison struct {
+	op string
+	v  *semver.Version
+
+	// n is the number of version numbers in the original constraint, which
+	// changes the meaning of the pessimistic operator "~>".
+	n int
+}
+
+// ToHashicorp converts v into a go-version version. It returns an error if
+// a version number of v doesn't fit in an int64.
+func ToHashicorp(v *semver.Version) (*hversion.Version, error) {
+	w, err := hversion.NewSemver(v.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert version %q to go-version: %w", v, err)
+	}
+
+	return w, nil
+}
+
+// FromHashicorp converts the go-version version v into a version. It returns an
+// error if v has more than three version numbers or if it's otherwise not
+// a valid semantic version. The "v" prefix and the missing version numbers of
+// the original version string are not kept.
+func FromHashicorp(v *hversion.Version) (*semver.Version, error) {
+	w, err := semver.Parse(v.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert go-version version %q: %w", v.Original(), err)
+	}
+
+	return w, nil
+}
+
+// FromHashicorpConstraints converts the go-version constraints cs into
+// a constraint that is satisfied by the same versions. The constraints in
+// go-version check pre-release versions differently: a pre-release version
+// satisfies a comparison, like ">= 1.0.0-beta", only if the version of the
+// comparison has pre-release identifiers and the same version core, and
+// a pre-release version must satisfy every comparison. The comparisons are
+// rewritten so that the returned constraint selects the same pre-release
+// versions when it's checked without [semver.IncludePrereleases].
+//
+// The constraints that consist of only "!=" comparisons are satisfied by
+// almost every pre-release version in go-version, and they can't be converted.
+// FromHashicorpConstraints returns [ErrUnsupportedConstraint] for them.
+func FromHashicorpConstraints(cs hversion.Constraints) (*semver.Constraint, error) {
+	comps := make([]hashicorpComparison, 0, len(cs))
+
+	for _, c := range cs {
+		comp, err := parseHashicorpComparison(c.String())
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert go-version constraint %q: %w", cs, err)
+		}
+
+		comps = append(comps, comp)
+	}
+
+	// The pre-release versions can satisfy the constraints only if every
+	// ordered comparison has a pre-release version with the same version
+	// core. If there are no ordered comparisons, an "=" comparison must
+	// select the version.
+	var (
+		core        *semver.Version
+		ordered     bool
+		equal       bool
+		prereleases = true
+	)
+
+	for _, c := range comps {
+		switch c.op {
+		case "=":
+			equal = true
+
+			continue
+		case "!=":
+			continue
+		}
+
+		ordered = true
+
+		if len(c.v.Prerelease) == 0 || core != nil && !sameCore(core, c.v) {
+			prereleases = false
+		} else if core == nil {
+			core = c.v
+		}
+	}
+
+	if !ordered && !equal {
+		return nil, fmt.Errorf(
+			"%w: go-version constraint %q is satisfied by the pre-release versions",
+			ErrUnsupportedConstraint,
+			cs,
+		)
+	}
+
+	parts := make([]string, 0, len(comps)+1)
+
+	for _, c := range comps {
+		if !ordered || len(c.v.Prerelease) == 0 || prereleases && sameCore(core, c.v) {
+			parts = append(parts, c.comparators()...)
+
+			continue
+		}
+
+		// No pre-release version of the version core of c can satisfy the
+		// constraints, so the comparison only needs to select the right
+		// release versions.
+		switch c.op {
+		case "=", "~>":
+			// Only the pre-release versions would satisfy the comparison.
+			return semver.MustParseConstraint("<0.0.0"), nil
+		case "!=":
+		case ">", ">=":
+			parts = append(parts, ">="+coreString(c.v))
+		case "<", "<=":
+			parts = append(parts, "<"+coreString(c.v))
+		}
+	}
+
+	c, err := semver.ParseConstraint(strings.Join(parts, " "))
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert go-version constraint %q: %w", cs, err)
+	}
+
+	return c, nil
+}
+
+// comparators returns the comparators for the constraint of package semver
+// that are equal to c. The build metadata is dropped as go-version ignores it
+// in the comparisons.
+func (c hashicorpComparison) comparators() []string {
+	v := coreString(c.v)
+	if len(c.v.Prerelease) > 0 {
+		v += "-" + c.v.Prerelease.String()
+	}
+
+	if c.op != "~>" {
+		return []string{c.op + v}
+	}
+
+	// A pessimistic comparison with a pre-release version is only satisfied
+	// by the pre-release versions of the same version core.
+	if len(c.v.Prerelease) > 0 {
+		return []string{">=" + v, "<" + coreString(c.v)}
+	}
+
+	switch c.n {
+	case 1:
+		return []string{">=" + v}
+	case 2: //nolint:mnd // major and minor version
+		return []string{">=" + v, "<" + strconv.FormatUint(c.v.Major+1, 10) + ".0.0"}
+	default:
+		major := strconv.FormatUint(c.v.Major, 10)
+		minor := strconv.FormatUint(c.v.Minor+1, 10)
+
+		return []string{">=" + v, "<" + major + "." + minor + ".0"}
+	}
+}
+
+// parseHashicorpComparison parses a single comparison of go-version
+// constraints.
+func parseHashicorpComparison(s string) (hashicorpComparison, error) {
+	s = strings.TrimSpace(s)
+	op := "="
+
+	for _, o := range hashicorpOperators {
+		if strings.HasPrefix(s, o) {
+			op = o
+			s = strings.TrimSpace(s[len(o):])
+
+			break
+		}
+	}
+
+	hv, err := hversion.NewVersion(s)
+	if err != nil {
+		return hashicorpComparison{}, fmt.Errorf("%w: %w", semver.ErrInvalidConstraint, err)
+	}
+
+	v, err := FromHashicorp(hv)
+	if err != nil {
+		return hashicorpComparison{}, fmt.Errorf("%w: %w", semver.ErrInvalidConstraint, err)
+	}
+
+	nums := strings.TrimPrefix(s, "v")
+	if i := strings.IndexFunc(nums, func(r rune) bool {
+		return r != '.' && (r < '0' || r > '9')
+	}); i >= 0 {
+		nums = nums[:i]
+	}
+
+	return hashicorpComparison{op: op, v: v, n: strings.Count(nums, ".") + 1}, nil
+}
+
+// coreString returns the version core of v as a string.
+func coreString(v *semver.Version) string {
+	return fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
+}
+
+// sameCore reports whether v and w have the same version core.
+func sameCore(v, w *semver.Version) bool {
+	return v.Major == w.Major && v.Minor == w.Minor && v.Patch == w.Patch
+}
@@ -0,0 +1,153 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package compat_test
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"testing"
+
+	hversion "github.com/hashicorp/go-version"
+
+	"github.com/anttikivi/semver"
+	"github.com/anttikivi/semver/compat"
+)
+
+func TestHashicorp(t *testing.T) {
+	t.Parallel()
+
+	for _, s := range conversionTests[:len(conversionTests)-1] {
+		v := semver.MustParse(s)
+
+		h, err := compat.ToHashicorp(v)
+		if err != nil || h.String() != s {
+			t.Errorf("ToHashicorp(%q) = %v, %v", s, h, err)
+
+			continue
+		}
+
+		w, err := compat.FromHashicorp(h)
+		if err != nil || !w.StrictEqual(v) {
+			t.Errorf("FromHashicorp(ToHashicorp(%q)) = %v, %v", s, w, err)
+		}
+	}
+
+	big := semver.MustParse(strconv.FormatUint(math.MaxInt64+1, 10) + ".0.0")
+	if _, err := compat.ToHashicorp(big); err == nil {
+		t.Errorf("ToHashicorp(%v) error = nil", big)
+	}
+
+	for s, want := range map[string]string{
+		"v1.2":       "1.2.0",
+		"1.02.3beta": "1.2.3-beta",
+		"1.2.3.4":    "",
+		"1.2.3-01":   "",
+	} {
+		w, err := compat.FromHashicorp(hversion.Must(hversion.NewVersion(s)))
+		if want == "" && !errors.Is(err, semver.ErrInvalidVersion) {
+			t.Errorf("FromHashicorp(%s) = %v, %v, want %v", s, w, err, semver.ErrInvalidVersion)
+		} else if want != "" && (err != nil || w.String() != want) {
+			t.Errorf("FromHashicorp(%s) = %v, %v, want %s", s, w, err, want)
+		}
+	}
+}
+
+func TestFromHashicorpConstraints(t *testing.T) {
+	t.Parallel()
+
+	constraints := []string{
+		"1.2",
+		"= 1.0.0-rc.1",
+		">= 1.0",
+		"> 1.2",
+		"< 1.2",
+		"~> 1",
+		"~> 1.2",
+		"~> 1.2.3",
+		"~> 1.2.3-beta",
+		"~> 1.2.0-beta, >= 1.0.0",
+		"> 1.0.0-beta",
+		">= 1.0.0-beta, < 2.0.0",
+		">= 1.0.0-alpha, < 1.0.0-rc.2",
+		"> 1.0.0-alpha, < 2.0.0-beta",
+		">= 1.0.0-alpha, != 1.2.3-beta",
+		">= 1.2.3-alpha, != 1.2.3-beta, <= 1.2.3-rc.1",
+		"= 1.0.0-beta, >= 0.9",
+		"= 1.0.0-beta, != 1.0.0-alpha",
+		"!= 1.0.0, >= 0.9",
+		">= 1.0.0+build.1, < 1.2.3-beta+build.2",
+	}
+
+	versions := []string{
+		"0.9.0",
+		"1.0.0-alpha",
+		"1.0.0-beta",
+		"1.0.0-rc.1",
+		"1.0.0",
+		"1.0.1",
+		"1.2.0-beta",
+		"1.2.0",
+		"1.2.3-alpha",
+		"1.2.3-beta",
+		"1.2.3-rc.1",
+		"1.2.3",
+		"1.2.4",
+		"1.3.0",
+		"2.0.0-alpha",
+		"2.0.0",
+		"2.1.0",
+	}
+
+	for _, s := range constraints {
+		cs := hversion.MustConstraints(hversion.NewConstraint(s))
+
+		c, err := compat.FromHashicorpConstraints(cs)
+		if err != nil {
+			t.Errorf("FromHashicorpConstraints(%q) error = %v", s, err)
+
+			continue
+		}
+
+		for _, vs := range versions {
+			want := cs.Check(hversion.Must(hversion.NewVersion(vs)))
+			if got := c.Check(semver.MustParse(vs)); got != want {
+				t.Errorf(
+					"FromHashicorpConstraints(%q) = %q: Check(%s) = %v, want %v",
+					s,
+					c,
+					vs,
+					got,
+					want,
+				)
+			}
+		}
+	}
+
+	for s, want := range map[string]error{
+		"!= 1.0.0":            compat.ErrUnsupportedConstraint,
+		">= 1.0.0, < 1.2.3.4": semver.ErrInvalidConstraint,
+	} {
+		cs := hversion.MustConstraints(hversion.NewConstraint(s))
+		if _, err := compat.FromHashicorpConstraints(cs); !errors.Is(err, want) {
+			t.Errorf("FromHashicorpConstraints(%q) error = %v, want %v", s, err, want)
+		}
+	}
+}
@@ -0,0 +1,345 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+//go:build parity
+
+package compat_test
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	msemver "github.com/Masterminds/semver/v3"
+	bsemver "github.com/blang/semver/v4"
+
+	"github.com/anttikivi/semver"
+	"github.com/anttikivi/semver/semvertest"
+)
+
+// The parity harness runs the shared corpus through package semver and the
+// other libraries and reports where they differ. Run it with:
+//
+//	go test -tags parity -run Parity -report parity.md
+//	go test -tags parity -run '^$' -bench Parity
+//
+// Only the differences of package semver from the specification fail the
+// tests; the differences of the other libraries are only reported.
+
+var reportFile = flag.String("report", "", "write the compatibility report to `file`")
+
+// parityLibrary is a library in the parity harness.
+type parityLibrary struct {
+	name string
+
+	// parse returns the canonical form of the parsed version or an error.
+	parse func(s string) (string, error)
+
+	// compare compares the valid versions a and b.
+	compare func(a, b string) int
+}
+
+// parityCorpus contains the version strings from the test cases of the regular
+// expression suggested by the specification and whether they are valid. The
+// versions with the "v" prefix are left out as package semver accepts it.
+var parityCorpus = []struct {
+	s     string
+	valid bool
+}{
+	{"0.0.4", true},
+	{"1.2.3", true},
+	{"10.20.30", true},
+	{"1.1.2-prerelease+meta", true},
+	{"1.1.2+meta", true},
+	{"1.1.2+meta-valid", true},
+	{"1.0.0-alpha", true},
+	{"1.0.0-beta", true},
+	{"1.0.0-alpha.beta", true},
+	{"1.0.0-alpha.beta.1", true},
+	{"1.0.0-alpha.1", true},
+	{"1.0.0-alpha0.valid", true},
+	{"1.0.0-alpha.0valid", true},
+	{"1.0.0-alpha-a.b-c-somethinglong+build.1-aef.1-its-okay", true},
+	{"1.0.0-rc.1+build.1", true},
+	{"2.0.0-rc.1+build.123", true},
+	{"1.2.3-beta", true},
+	{"10.2.3-DEV-SNAPSHOT", true},
+	{"1.2.3-SNAPSHOT-123", true},
+	{"1.0.0", true},
+	{"2.0.0", true},
+	{"1.1.7", true},
+	{"2.0.0+build.1848", true},
+	{"2.0.1-alpha.1227", true},
+	{"1.0.0-alpha+beta", true},
+	{"1.2.3----RC-SNAPSHOT.12.9.1--.12+788", true},
+	{"1.2.3----R-S.12.9.1--.12+meta", true},
+	{"1.2.3----RC-SNAPSHOT.12.9.1--.12", true},
+	{"1.0.0+0.build.1-rc.10000aaa-kk-0.1", true},
+	{"99999999999999999999999.999999999999999999.99999999999999999", true},
+	{"1.0.0-0A.is.legal", true},
+	{"1", false},
+	{"1.2", false},
+	{"1.2.3-0123", false},
+	{"1.2.3-0123.0123", false},
+	{"1.1.2+.123", false},
+	{"+invalid", false},
+	{"-invalid", false},
+	{"-invalid+invalid", false},
+	{"-invalid.01", false},
+	{"alpha", false},
+	{"alpha.beta", false},
+	{"alpha.beta.1", false},
+	{"alpha.1", false},
+	{"alpha+beta", false},
+	{"alpha_beta", false},
+	{"alpha.", false},
+	{"alpha..", false},
+	{"beta", false},
+	{"1.0.0-alpha_beta", false},
+	{"-alpha.", false},
+	{"1.0.0-alpha..", false},
+	{"1.0.0-alpha..1", false},
+	{"1.0.0-alpha...1", false},
+	{"1.0.0-alpha....1", false},
+	{"1.0.0-alpha.....1", false},
+	{"1.0.0-alpha......1", false},
+	{"1.0.0-alpha.......1", false},
+	{"01.1.1", false},
+	{"1.01.1", false},
+	{"1.1.01", false},
+	{"1.2.3.DEV", false},
+	{"1.2-SNAPSHOT", false},
+	{"1.2.31.2.3----RC-SNAPSHOT.12.09.1--..12+788", false},
+	{"1.2-RC-SNAPSHOT", false},
+	{"-1.0.3-gamma+b7718", false},
+	{"+justmeta", false},
+	{"9.8.7+meta+meta", false},
+	{"9.8.7-whatever+meta+meta", false},
+	{" 1.2.3", false},
+	{"1.2.3 ", false},
+	{"", false},
+}
+
+// knownDeviations are the inputs that package semver deliberately handles
+// differently from the specification, and the reasons for them. They are
+// reported but don't fail the tests.
+var knownDeviations = map[string]string{
+	"99999999999999999999999.999999999999999999.99999999999999999": "the version numbers " +
+		"are stored as uint64, so the numbers greater than 18446744073709551615 are rejected",
+}
+
+func parityLibraries() []parityLibrary {
+	return []parityLibrary{
+		{
+			name: "anttikivi/semver",
+			parse: func(s string) (string, error) {
+				v, err := semver.Parse(s)
+				if err != nil {
+					return "", err
+				}
+
+				return v.String(), nil
+			},
+			compare: func(a, b string) int {
+				return semver.MustParse(a).Compare(semver.MustParse(b))
+			},
+		},
+		{
+			name: "Masterminds/semver (strict)",
+			parse: func(s string) (string, error) {
+				v, err := msemver.StrictNewVersion(s)
+				if err != nil {
+					return "", err
+				}
+
+				return v.String(), nil
+			},
+			compare: func(a, b string) int {
+				return msemver.MustParse(a).Compare(msemver.MustParse(b))
+			},
+		},
+		{
+			name: "Masterminds/semver",
+			parse: func(s string) (string, error) {
+				v, err := msemver.NewVersion(s)
+				if err != nil {
+					return "", err
+				}
+
+				return v.String(), nil
+			},
+			compare: func(a, b string) int {
+				return msemver.MustParse(a).Compare(msemver.MustParse(b))
+			},
+		},
+		{
+			name: "blang/semver",
+			parse: func(s string) (string, error) {
+				v, err := bsemver.Parse(s)
+				if err != nil {
+					return "", err
+				}
+
+				return v.String(), nil
+			},
+			compare: func(a, b string) int {
+				return bsemver.MustParse(a).Compare(bsemver.MustParse(b))
+			},
+		},
+	}
+}
+
+func TestParity(t *testing.T) {
+	t.Parallel()
+
+	libs := parityLibraries()
+
+	var report strings.Builder
+
+	report.WriteString("# Compatibility report\n\n## Parsing\n\n")
+	report.WriteString("The table lists the inputs that at least one library handles\n")
+	report.WriteString("differently from the specification.\n\n| Input | Valid |")
+
+	for _, lib := range libs {
+		fmt.Fprintf(&report, " %s |", lib.name)
+	}
+
+	report.WriteString("\n|---|---|" + strings.Repeat("---|", len(libs)) + "\n")
+
+	mismatches := make([]int, len(libs))
+
+	for _, tt := range parityCorpus {
+		row := make([]string, len(libs))
+		differs := false
+
+		for i, lib := range libs {
+			got, err := lib.parse(tt.s)
+
+			switch {
+			case err != nil && tt.valid:
+				row[i] = "rejected"
+			case err == nil && !tt.valid:
+				row[i] = fmt.Sprintf("accepted as `%s`", got)
+			case err == nil && got != tt.s:
+				row[i] = fmt.Sprintf("changed to `%s`", got)
+			default:
+				row[i] = "ok"
+
+				continue
+			}
+
+			differs = true
+			mismatches[i]++
+
+			if i == 0 {
+				if _, ok := knownDeviations[tt.s]; ok {
+					row[i] += " (known deviation)"
+				} else {
+					t.Errorf("%s: %q: %s", lib.name, tt.s, row[i])
+				}
+			}
+		}
+
+		if differs {
+			fmt.Fprintf(&report, "| `%s` | %t | %s |\n", tt.s, tt.valid, strings.Join(row, " | "))
+		}
+	}
+
+	report.WriteString("\n### Known deviations\n\n")
+	report.WriteString("Package semver deliberately differs from the specification for\n")
+	report.WriteString("the following inputs.\n\n")
+
+	for _, tt := range parityCorpus {
+		if reason, ok := knownDeviations[tt.s]; ok {
+			fmt.Fprintf(&report, "- `%s`: %s.\n", tt.s, reason)
+		}
+	}
+
+	report.WriteString("\n## Precedence\n\n")
+	report.WriteString("The libraries are checked against the precedence examples of the\n")
+	report.WriteString("specification.\n\n| Library | Parse mismatches | Precedence mismatches |\n")
+	report.WriteString("|---|---|---|\n")
+
+	chain := versionStringsOf(semvertest.SpecChain())
+
+	for i, lib := range libs {
+		n := 0
+
+		for j := range chain {
+			for k := range chain {
+				want := 0
+				if j < k {
+					want = -1
+				} else if j > k {
+					want = 1
+				}
+
+				if got := lib.compare(chain[j], chain[k]); got != want {
+					n++
+
+					if i == 0 {
+						t.Errorf(
+							"%s: compare(%s, %s) = %d, want %d",
+							lib.name,
+							chain[j],
+							chain[k],
+							got,
+							want,
+						)
+					}
+				}
+			}
+		}
+
+		fmt.Fprintf(&report, "| %s | %d | %d |\n", lib.name, mismatches[i], n)
+	}
+
+	if *reportFile == "" {
+		t.Log("\n" + report.String())
+
+		return
+	}
+
+	if err := os.WriteFile(*reportFile, []byte(report.String()), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func BenchmarkParity(b *testing.B) {
+	for _, lib := range parityLibraries() {
+		b.Run(strings.NewReplacer("/", "-", " ", "").Replace(lib.name), func(b *testing.B) {
+			for b.Loop() {
+				for _, tt := range parityCorpus {
+					_, _ = lib.parse(tt.s)
+				}
+			}
+		})
+	}
+}
+
+func versionStringsOf(vs semver.Versions) []string {
+	s := make([]string, len(vs))
+	for i, v := range vs {
+		s[i] = v.String()
+	}
+
+	return s
+}
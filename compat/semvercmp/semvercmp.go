@@ -0,0 +1,57 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+// Package semvercmp provides options for comparing versions with
+// github.com/google/go-cmp. The identifiers of the pre-release versions have
+// unexported fields, so cmp.Diff and cmp.Equal panic on versions unless they
+// are given the options of this package.
+package semvercmp
+
+import (
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/anttikivi/semver"
+)
+
+// Comparer returns an option that makes cmp compare the versions, the values
+// of the type [semver.Version], the pre-release versions, and the pre-release
+// identifiers using their semantic equality. The versions are equal if they
+// have the same precedence and the same build identifiers, as reported by
+// [semver.Version.StrictEqual].
+func Comparer() cmp.Option {
+	return cmp.Options{
+		cmp.Comparer(func(a, b *semver.Version) bool {
+			if a == nil || b == nil {
+				return a == b
+			}
+
+			return a.StrictEqual(b)
+		}),
+		cmp.Comparer(func(a, b semver.Version) bool {
+			return a.StrictEqual(&b)
+		}),
+		cmp.Comparer(func(a, b semver.PrereleaseIdentifier) bool {
+			if a == nil || b == nil {
+				return a == b
+			}
+
+			return a.String() == b.String()
+		}),
+	}
+}
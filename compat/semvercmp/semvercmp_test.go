@@ -0,0 +1,63 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semvercmp_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/anttikivi/semver"
+	"github.com/anttikivi/semver/compat/semvercmp"
+)
+
+func TestComparer(t *testing.T) {
+	t.Parallel()
+
+	type release struct {
+		Name    string
+		Version *semver.Version
+		Pre     semver.Prerelease
+	}
+
+	a := release{"x", semver.MustParse("1.2.3-rc.1+b"), semver.MustParse("1.0.0-a.1").Prerelease}
+	b := release{"x", semver.MustParse("v1.2.3-rc.1+b"), semver.MustParse("2.0.0-a.1").Prerelease}
+
+	if diff := cmp.Diff(a, b, semvercmp.Comparer()); diff != "" {
+		t.Errorf("cmp.Diff() mismatch (-a +b):\n%s", diff)
+	}
+
+	b.Version = semver.MustParse("1.2.3-rc.1+c")
+
+	diff := cmp.Diff(a, b, semvercmp.Comparer())
+	if !strings.Contains(diff, "Version") {
+		t.Errorf("cmp.Diff() = %q, want a difference in Version", diff)
+	}
+
+	v, w := semver.MustParse("1.0.0-1"), semver.MustParse("1.0.0-1")
+	if !cmp.Equal(*v, *w, semvercmp.Comparer()) {
+		t.Error("cmp.Equal() = false for equal version values")
+	}
+
+	if cmp.Equal((*semver.Version)(nil), semver.MustParse("1.0.0"), semvercmp.Comparer()) {
+		t.Error("cmp.Equal() = true for nil and non-nil versions")
+	}
+}
@@ -0,0 +1,297 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Conformance levels of the version parser.
+const (
+	// ConformanceDefault is the behavior of [Parse]: the versions must be
+	// full valid versions but they may have a "v" prefix.
+	ConformanceDefault ConformanceLevel = iota
+
+	// ConformanceStrict accepts only the versions that are valid according to
+	// the specification. Unlike [Parse], it rejects the "v" prefix. Like all of
+	// the levels, it also rejects the version numbers that don't fit in
+	// a uint64 even though the specification allows them.
+	ConformanceStrict
+
+	// ConformanceLax is the behavior of [ParseLax]: the versions may have
+	// a "v" prefix and the missing minor and patch versions default to
+	// zero.
+	ConformanceLax
+)
+
+// A ConformanceLevel is a set of rules for parsing versions that conform to
+// the semantic versioning specification to a different degree. The levels let
+// a validator choose how strictly the versions are checked and, using
+// [RunConformance], prove which clauses of the specification the chosen level
+// satisfies.
+type ConformanceLevel int
+
+// A ConformanceResult is the result of checking a [ConformanceLevel] against
+// one clause of the specification.
+type ConformanceResult struct {
+	// Clause is the number of the clause in the specification, like "9", or
+	// "BNF" for the grammar of the specification.
+	Clause string
+
+	// Summary is a short description of the requirements of the clause.
+	Summary string
+
+	// Satisfied reports whether the level satisfies the clause.
+	Satisfied bool
+
+	// Failures describe the checks of the clause that failed.
+	Failures []string
+}
+
+// conformanceClause is a clause of the specification and the checks for it.
+type conformanceClause struct {
+	id      string
+	summary string
+
+	// accept and reject are the version strings that must be accepted and
+	// rejected.
+	accept []string
+	reject []string
+
+	// ordered are version strings in increasing order of precedence.
+	ordered []string
+
+	// equal are pairs of version strings that have the same precedence.
+	equal [][2]string
+}
+
+// conformanceClauses are the clauses of the specification in order.
+//
+//nolint:gochecknoglobals // fixed test vectors of the specification
+var conformanceClauses = []conformanceClause{
+	{
+		id: "2",
+		summary: "A normal version number takes the form X.Y.Z where X, Y, and Z are " +
+			"non-negative integers without leading zeros.",
+		accept: []string{
+			"0.0.0",
+			"1.2.3",
+			"10.20.30",
+			"1.9.0",
+			"1.10.0",
+			"1.11.0",
+			"18446744073709551616.0.0",
+		},
+		reject:  []string{"1", "1.2", "1.2.3.4", "01.2.3", "1.02.3", "1.2.03", "-1.2.3"},
+		ordered: []string{"1.9.0", "1.10.0", "1.11.0"},
+		equal:   nil,
+	},
+	{
+		id: "9",
+		summary: "A pre-release version is denoted by a hyphen and a series of dot-separated " +
+			"identifiers of [0-9A-Za-z-] that are not empty; numeric identifiers have no " +
+			"leading zeros.",
+		accept: []string{
+			"1.0.0-alpha",
+			"1.0.0-alpha.1",
+			"1.0.0-0.3.7",
+			"1.0.0-x.7.z.92",
+			"1.0.0-x-y-z.--",
+			"1.0.0-0A.is.legal",
+		},
+		reject: []string{
+			"1.0.0-",
+			"1.0.0-alpha.",
+			"1.0.0-alpha..1",
+			"1.0.0-01",
+			"1.0.0-alpha.01",
+			"1.0.0-alpha_beta",
+		},
+		ordered: []string{"1.0.0-alpha", "1.0.0"},
+		equal:   nil,
+	},
+	{
+		id: "10",
+		summary: "Build metadata is denoted by a plus sign and a series of dot-separated " +
+			"identifiers of [0-9A-Za-z-] that are not empty, and it is ignored when " +
+			"determining precedence.",
+		accept: []string{
+			"1.0.0-alpha+001",
+			"1.0.0+20130313144700",
+			"1.0.0-beta+exp.sha.5114f85",
+			"1.0.0+21AF26D3----117B344092BD",
+		},
+		reject:  []string{"1.0.0+", "1.0.0+a.", "1.0.0+a..b", "1.0.0+a_b", "1.0.0+a+b"},
+		ordered: nil,
+		equal:   [][2]string{{"1.0.0+a", "1.0.0+b"}, {"1.0.0-rc.1+001", "1.0.0-rc.1"}},
+	},
+	{
+		id: "11",
+		summary: "Precedence is determined by the major, minor, and patch versions in " +
+			"order, and then by comparing the pre-release identifiers from left to right.",
+		accept: nil,
+		reject: nil,
+		ordered: []string{
+			"1.0.0-alpha",
+			"1.0.0-alpha.1",
+			"1.0.0-alpha.beta",
+			"1.0.0-beta",
+			"1.0.0-beta.2",
+			"1.0.0-beta.11",
+			"1.0.0-rc.1",
+			"1.0.0",
+			"2.0.0",
+			"2.1.0",
+			"2.1.1",
+		},
+		equal: nil,
+	},
+	{
+		id: "BNF",
+		summary: "A valid version consists only of the version core and the optional " +
+			"pre-release and build; there is no prefix, whitespace, or other characters.",
+		accept:  []string{"1.2.3-rc.1+build.5"},
+		reject:  []string{"v1.2.3", " 1.2.3", "1.2.3 ", "1.2.3\n", "=1.2.3", "１.2.3", ""},
+		ordered: nil,
+		equal:   nil,
+	},
+}
+
+// RunConformance checks the parsing and the precedence of versions using level
+// against the clauses of the semantic versioning 2.0.0 specification and
+// returns a result for each clause in the order of the specification. The
+// clauses that don't concern parsing or comparing version strings, like the
+// ones that describe when a version should be incremented, are not included.
+// [ConformanceStrict] satisfies every clause except clause 2: the versions
+// with numbers greater than 18446744073709551615 are valid according to
+// the specification, but the parser rejects them as it stores the numbers as
+// uint64.
+func RunConformance(level ConformanceLevel) []ConformanceResult {
+	results := make([]ConformanceResult, 0, len(conformanceClauses))
+
+	for _, clause := range conformanceClauses {
+		results = append(results, clause.run(level))
+	}
+
+	return results
+}
+
+// Parse parses the given string into a Version using the rules of the level.
+func (l ConformanceLevel) Parse(s string) (*Version, error) {
+	switch l {
+	case ConformanceDefault:
+		return Parse(s)
+	case ConformanceStrict:
+		if strings.HasPrefix(s, "v") {
+			return nil, fmt.Errorf(
+				"failed to parse version: %w: %q has a prefix",
+				ErrInvalidVersion,
+				s,
+			)
+		}
+
+		return Parse(s)
+	case ConformanceLax:
+		return ParseLax(s)
+	default:
+		return nil, fmt.Errorf("%w: unknown conformance level %d", ErrParser, int(l))
+	}
+}
+
+// String returns the name of the level.
+func (l ConformanceLevel) String() string {
+	switch l {
+	case ConformanceDefault:
+		return "default"
+	case ConformanceStrict:
+		return "strict"
+	case ConformanceLax:
+		return "lax"
+	default:
+		return fmt.Sprintf("ConformanceLevel(%d)", int(l))
+	}
+}
+
+// run checks level against the clause.
+func (c conformanceClause) run(level ConformanceLevel) ConformanceResult {
+	var failures []string
+
+	for _, s := range c.accept {
+		if _, err := level.Parse(s); err != nil {
+			failures = append(failures, fmt.Sprintf("rejects %q", s))
+		}
+	}
+
+	for _, s := range c.reject {
+		if v, err := level.Parse(s); err == nil {
+			failures = append(failures, fmt.Sprintf("accepts %q as %q", s, v))
+		} else if errors.Is(err, ErrParser) {
+			failures = append(failures, err.Error())
+		}
+	}
+
+	for i := 1; i < len(c.ordered); i++ {
+		if !c.compare(level, c.ordered[i-1], c.ordered[i], -1, &failures) {
+			break
+		}
+	}
+
+	for _, pair := range c.equal {
+		c.compare(level, pair[0], pair[1], 0, &failures)
+	}
+
+	return ConformanceResult{
+		Clause:    c.id,
+		Summary:   c.summary,
+		Satisfied: len(failures) == 0,
+		Failures:  failures,
+	}
+}
+
+// compare checks that a and b compare to want. It reports whether a and b
+// could be parsed.
+func (c conformanceClause) compare(
+	level ConformanceLevel,
+	a, b string,
+	want int,
+	failures *[]string,
+) bool {
+	v, err := level.Parse(a)
+	if err != nil {
+		*failures = append(*failures, fmt.Sprintf("rejects %q", a))
+
+		return false
+	}
+
+	w, err := level.Parse(b)
+	if err != nil {
+		*failures = append(*failures, fmt.Sprintf("rejects %q", b))
+
+		return false
+	}
+
+	if got := v.Compare(w); got != want {
+		*failures = append(*failures, fmt.Sprintf("compares %q to %q as %d", a, b, got))
+	}
+
+	return true
+}
@@ -0,0 +1,100 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver_test
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/anttikivi/semver"
+)
+
+func TestRunConformance(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		level  semver.ConformanceLevel
+		failed []string
+	}{
+		{semver.ConformanceStrict, []string{"2"}},
+		{semver.ConformanceDefault, []string{"2", "BNF"}},
+		{semver.ConformanceLax, []string{"2", "BNF"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.level.String(), func(t *testing.T) {
+			t.Parallel()
+
+			results := semver.RunConformance(tt.level)
+			if len(results) != 5 {
+				t.Fatalf("RunConformance(%v) returned %d results, want 5", tt.level, len(results))
+			}
+
+			var failed []string
+
+			for _, r := range results {
+				if r.Satisfied != (len(r.Failures) == 0) {
+					t.Errorf(
+						"clause %s: Satisfied = %t with failures %q",
+						r.Clause,
+						r.Satisfied,
+						r.Failures,
+					)
+				}
+
+				if !r.Satisfied {
+					failed = append(failed, r.Clause)
+				}
+			}
+
+			if !slices.Equal(failed, tt.failed) {
+				t.Errorf(
+					"RunConformance(%v) failed clauses %q, want %q",
+					tt.level,
+					failed,
+					tt.failed,
+				)
+			}
+		})
+	}
+}
+
+func TestConformanceLevelParse(t *testing.T) {
+	t.Parallel()
+
+	_, err := semver.ConformanceStrict.Parse("v1.2.3")
+	if !errors.Is(err, semver.ErrInvalidVersion) {
+		t.Errorf(
+			"ConformanceStrict.Parse(\"v1.2.3\") error = %v, want %v",
+			err,
+			semver.ErrInvalidVersion,
+		)
+	}
+
+	if v, err := semver.ConformanceLax.Parse("v1.2"); err != nil || v.String() != "1.2.0" {
+		t.Errorf("ConformanceLax.Parse(\"v1.2\") = %v, %v, want 1.2.0", v, err)
+	}
+
+	_, err = semver.ConformanceLevel(9).Parse("1.2.3")
+	if !errors.Is(err, semver.ErrParser) {
+		t.Errorf("ConformanceLevel(9).Parse() error = %v, want %v", err, semver.ErrParser)
+	}
+}
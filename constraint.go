@@ -0,0 +1,1083 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+)
+
+// Values for operator.
+const (
+	opEqual operator = iota
+	opNotEqual
+	opLess
+	opLessOrEqual
+	opGreater
+	opGreaterOrEqual
+)
+
+// ErrInvalidConstraint is returned by the constraint parsing functions when
+// they encounter an invalid constraint string.
+var ErrInvalidConstraint = errors.New("invalid version constraint")
+
+// A Constraint is a parsed version constraint, also known as a version range.
+// A Constraint consists of ranges separated by "||", and a version satisfies
+// the Constraint if it is in any of the ranges. Each range is a list of
+// comparators separated by spaces or commas, and a version is in the range if
+// it satisfies all of the comparators in it.
+//
+// The syntax follows the syntax of the version ranges used by npm. The parser
+// supports the following comparators:
+//
+//   - The primitive comparators "=", "!=", "<", "<=", ">", and ">=" followed by
+//     a version. A version without an operator equals to "=".
+//   - X-ranges, for example, "1.x", "1.2.*", and "*". A partial version like
+//     "1.2" is an x-range for the missing numbers.
+//   - Tilde ranges that allow changes to the patch version if the minor
+//     version is specified, and to the minor version otherwise, for example,
+//     "~1.2.3" that means ">=1.2.3 <1.3.0-0". The operator can also be written
+//     as "~>".
+//   - Caret ranges that allow changes that do not modify the left-most
+//     non-zero number, for example, "^1.2.3" that means ">=1.2.3 <2.0.0-0" and
+//     "^0.2.3" that means ">=0.2.3 <0.3.0-0".
+//   - Hyphen ranges, for example, "1.2.3 - 2.3.4" that means
+//     ">=1.2.3 <=2.3.4".
+//
+// A version that has pre-release identifiers only satisfies a range if one of
+// the comparators in the range has a version with pre-release identifiers and
+// the same major, minor, and patch version. For example, "1.2.3-beta.2"
+// satisfies ">=1.2.3-beta.1" but "1.2.4-beta.1" does not. This prevents ranges
+// from accidentally selecting pre-release versions.
+type Constraint struct {
+	// sets are the ranges of the constraint. Each of the ranges is a list of
+	// comparators that all must be satisfied.
+	sets [][]comparator
+
+	// str is the string representation of the constraint.
+	str string
+}
+
+// A comparator is a single primitive comparison in a Constraint.
+type comparator struct {
+	v  *Version
+	op operator
+}
+
+// An operator is the comparison operator of a comparator.
+type operator int
+
+// A Bound is a lower or an upper bound of an [Interval] of versions.
+type Bound struct {
+	// Version is the version at the bound. It is nil if the interval is
+	// unbounded in this direction.
+	Version *Version
+
+	// Inclusive reports whether Version is in the interval.
+	Inclusive bool
+}
+
+// An Interval is a continuous interval of versions between the bounds.
+type Interval struct {
+	Lower Bound
+	Upper Bound
+}
+
+// A Boundary is a version at a lower or an upper bound of an interval of
+// versions that satisfy a [Constraint].
+type Boundary struct {
+	// Version is the version at the boundary.
+	Version *Version
+
+	// Upper reports whether the boundary is an upper bound. Otherwise it is
+	// a lower bound.
+	Upper bool
+
+	// Inclusive reports whether Version is in the interval. A pre-release
+	// version in the interval might still not satisfy the constraint because
+	// of the pre-release rule of the constraint.
+	Inclusive bool
+}
+
+// A partialVersion is a possibly partial version in a version constraint.
+// The number of version numbers given is stored in n, and the missing numbers
+// are zeros.
+type partialVersion struct {
+	prerelease Prerelease
+	major      uint64
+	minor      uint64
+	patch      uint64
+	n          int
+}
+
+// A SatisfyOption is an option for selecting versions that satisfy
+// a constraint.
+type SatisfyOption func(*satisfyOptions)
+
+// satisfyOptions holds the options for selecting versions that satisfy
+// a constraint.
+type satisfyOptions struct {
+	yanked      func(*Version) bool
+	prereleases bool
+}
+
+// MustParseConstraint parses the given string into a Constraint and panics if
+// it encounters an error.
+func MustParseConstraint(s string) *Constraint {
+	c, err := ParseConstraint(s)
+	if err != nil {
+		panic(fmt.Sprintf("failed to parse the string %q into a constraint: %v", s, err))
+	}
+
+	return c
+}
+
+// ParseConstraint parses the given string into a Constraint. An empty string
+// is parsed as "*" that is satisfied by every version that doesn't have
+// pre-release identifiers.
+func ParseConstraint(s string) (*Constraint, error) {
+	s = strings.TrimSpace(s)
+
+	if s == "" {
+		return &Constraint{sets: [][]comparator{anyRange()}, str: ""}, nil
+	}
+
+	if !isASCII(s) {
+		return nil, fmt.Errorf("%w: constraint contains non-ASCII characters", ErrInvalidConstraint)
+	}
+
+	parts := strings.Split(s, "||")
+	sets := make([][]comparator, 0, len(parts))
+
+	for _, part := range parts {
+		set, err := parseRange(strings.TrimSpace(part))
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse constraint %q: %w", s, err)
+		}
+
+		sets = append(sets, set)
+	}
+
+	return &Constraint{sets: sets, str: s}, nil
+}
+
+// Check reports whether v satisfies the constraint. By default, a pre-release
+// version satisfies the constraint only if a comparator in the same range has
+// a pre-release version with the same version core. The option
+// [IncludePrereleases] changes this policy.
+func (c *Constraint) Check(v *Version, opts ...SatisfyOption) bool {
+	var o satisfyOptions
+
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	return c.check(v, o)
+}
+
+// IncludePrereleases returns an option that makes pre-release versions satisfy
+// a constraint like any other versions: for example, "1.3.0-beta.1" satisfies
+// "^1.2.0" with this option. The pre-release versions must still be within
+// the bounds of the constraint, so "2.0.0-rc.1" doesn't satisfy "^1.2.0".
+func IncludePrereleases() SatisfyOption {
+	return func(o *satisfyOptions) {
+		o.prereleases = true
+	}
+}
+
+// SkipYanked returns an option that skips the versions for which yanked
+// reports true unless the constraint pins the exact version. This matches
+// the behavior of Cargo and npm where yanked releases are only selected when
+// they are explicitly requested.
+func SkipYanked(yanked func(*Version) bool) SatisfyOption {
+	return func(o *satisfyOptions) {
+		o.yanked = yanked
+	}
+}
+
+// MaxSatisfying returns the greatest version in versions that satisfies
+// the constraint. It returns nil if none of the versions satisfy it.
+func (c *Constraint) MaxSatisfying(versions Versions, opts ...SatisfyOption) *Version {
+	var o satisfyOptions
+
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	var found *Version
+
+	for _, v := range versions {
+		if (found != nil && v.Compare(found) <= 0) || !c.check(v, o) {
+			continue
+		}
+
+		if o.yanked != nil && o.yanked(v) && !c.pins(v) {
+			continue
+		}
+
+		found = v
+	}
+
+	return found
+}
+
+// MinSatisfying returns the least version in versions that satisfies
+// the constraint. It returns nil if none of the versions satisfy it.
+func (c *Constraint) MinSatisfying(versions Versions, opts ...SatisfyOption) *Version {
+	var o satisfyOptions
+
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	var found *Version
+
+	for _, v := range versions {
+		if (found != nil && v.Compare(found) >= 0) || !c.check(v, o) {
+			continue
+		}
+
+		if o.yanked != nil && o.yanked(v) && !c.pins(v) {
+			continue
+		}
+
+		found = v
+	}
+
+	return found
+}
+
+// Boundaries returns the versions at the bounds of the intervals of versions
+// that satisfy the constraint, in increasing order. The unbounded sides of
+// the intervals have no boundaries. For example, the boundaries of
+// ">=1.2.3 <2.0.0 || >3.0.0" are the inclusive lower bound 1.2.3, the exclusive
+// upper bound 2.0.0, and the exclusive lower bound 3.0.0. They can be used for
+// testing the versions on both sides of each of the bounds.
+func (c *Constraint) Boundaries() []Boundary {
+	intervals := c.Intervals()
+	boundaries := make([]Boundary, 0, 2*len(intervals)) //nolint:mnd // two bounds per interval
+
+	for _, i := range intervals {
+		if i.Lower.Version != nil {
+			boundaries = append(
+				boundaries,
+				Boundary{Version: i.Lower.Version, Upper: false, Inclusive: i.Lower.Inclusive},
+			)
+		}
+
+		if i.Upper.Version != nil {
+			boundaries = append(
+				boundaries,
+				Boundary{Version: i.Upper.Version, Upper: true, Inclusive: i.Upper.Inclusive},
+			)
+		}
+	}
+
+	return boundaries
+}
+
+// Intervals returns the continuous intervals of versions that satisfy
+// the constraint, in increasing order. The intervals don't overlap or touch each
+// other. The pre-release rule of the constraint is not represented in
+// the intervals, so a pre-release version in an interval might not satisfy
+// the constraint unless [IncludePrereleases] is used. A constraint that no
+// version satisfies has no intervals.
+func (c *Constraint) Intervals() []Interval {
+	var all []Interval
+
+	for _, set := range c.sets {
+		all = append(all, rangeIntervals(set)...)
+	}
+
+	result := mergeIntervals(all)
+
+	// The bounds are copied so that the caller can't modify the versions of
+	// the constraint.
+	for i := range result {
+		if v := result[i].Lower.Version; v != nil {
+			result[i].Lower.Version = v.Clone()
+		}
+
+		if v := result[i].Upper.Version; v != nil {
+			result[i].Upper.Version = v.Clone()
+		}
+	}
+
+	return result
+}
+
+// Subsumes reports whether every version that satisfies o also satisfies c,
+// including the pre-release versions. For example, "^1.0.0" subsumes "~1.2.0"
+// but ">=1.2.0-beta" doesn't subsume ">=1.3.0-beta", as the pre-release
+// versions of 1.3.0 satisfy only the latter.
+func (c *Constraint) Subsumes(o *Constraint) bool {
+	return len(subtractIntervals(o.releaseIntervals(), c.releaseIntervals())) == 0 &&
+		len(subtractIntervals(o.prereleaseIntervals(), c.prereleaseIntervals())) == 0
+}
+
+// String returns the string representation of c.
+func (c *Constraint) String() string {
+	return c.str
+}
+
+// check reports whether v satisfies the constraint with the given options.
+func (c *Constraint) check(v *Version, o satisfyOptions) bool {
+	for _, set := range c.sets {
+		if checkRange(set, v, o.prereleases) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// pins reports whether the constraint has an alternative that only allows
+// the exact version v.
+func (c *Constraint) pins(v *Version) bool {
+	for _, set := range c.sets {
+		if len(set) == 1 && set[0].op == opEqual && set[0].v.Equal(v) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// String returns the string representation of the operator.
+func (o operator) String() string {
+	switch o {
+	case opEqual:
+		return "="
+	case opNotEqual:
+		return "!="
+	case opLess:
+		return "<"
+	case opLessOrEqual:
+		return "<="
+	case opGreater:
+		return ">"
+	case opGreaterOrEqual:
+		return ">="
+	default:
+		panic(fmt.Sprintf("invalid operator: %d", o))
+	}
+}
+
+// check reports whether v satisfies the comparator.
+func (c comparator) check(v *Version) bool {
+	d := v.Compare(c.v)
+
+	switch c.op {
+	case opEqual:
+		return d == 0
+	case opNotEqual:
+		return d != 0
+	case opLess:
+		return d < 0
+	case opLessOrEqual:
+		return d <= 0
+	case opGreater:
+		return d > 0
+	case opGreaterOrEqual:
+		return d >= 0
+	default:
+		panic(fmt.Sprintf("invalid operator: %d", c.op))
+	}
+}
+
+// String returns the string representation of the comparator.
+func (c comparator) String() string {
+	return c.op.String() + c.v.String()
+}
+
+// full returns the partial version as a full version with the missing numbers
+// set to zero.
+func (p partialVersion) full() *Version {
+	return &Version{
+		Major:      p.major,
+		Minor:      p.minor,
+		Patch:      p.patch,
+		Prerelease: p.prerelease,
+		Build:      nil,
+	}
+}
+
+// next returns the lowest version that is greater than every version matching
+// the partial version as an x-range, i.e. the next version with the last given
+// number incremented, with the "0" pre-release identifier. It must not be
+// called for a partial version with no numbers.
+func (p partialVersion) next() (*Version, error) {
+	switch p.n {
+	case 1:
+		if p.major == math.MaxUint64 {
+			return nil, fmt.Errorf("%w: major version %d cannot be incremented", ErrInvalidConstraint, p.major)
+		}
+
+		return lowestVersion(p.major+1, 0, 0), nil
+	case 2: //nolint:mnd // <major>.<minor>
+		if p.minor == math.MaxUint64 {
+			return nil, fmt.Errorf("%w: minor version %d cannot be incremented", ErrInvalidConstraint, p.minor)
+		}
+
+		return lowestVersion(p.major, p.minor+1, 0), nil
+	case 3: //nolint:mnd // <major>.<minor>.<patch>
+		if p.patch == math.MaxUint64 {
+			return nil, fmt.Errorf("%w: patch version %d cannot be incremented", ErrInvalidConstraint, p.patch)
+		}
+
+		return lowestVersion(p.major, p.minor, p.patch+1), nil
+	default:
+		panic(fmt.Sprintf("invalid number of version numbers in a partial version: %d", p.n))
+	}
+}
+
+// IsEmpty reports whether no version is in the interval.
+func (i Interval) IsEmpty() bool {
+	if i.Lower.Version == nil || i.Upper.Version == nil {
+		return false
+	}
+
+	d := i.Lower.Version.Compare(i.Upper.Version)
+
+	return d > 0 || (d == 0 && (!i.Lower.Inclusive || !i.Upper.Inclusive))
+}
+
+// intersect returns the intersection of the intervals.
+func (i Interval) intersect(o Interval) Interval {
+	if o.Lower.Version != nil {
+		if i.Lower.Version == nil {
+			i.Lower = o.Lower
+		} else if d := o.Lower.Version.Compare(i.Lower.Version); d > 0 || (d == 0 && !o.Lower.Inclusive) {
+			i.Lower = o.Lower
+		}
+	}
+
+	if o.Upper.Version != nil {
+		if i.Upper.Version == nil {
+			i.Upper = o.Upper
+		} else if d := o.Upper.Version.Compare(i.Upper.Version); d < 0 || (d == 0 && !o.Upper.Inclusive) {
+			i.Upper = o.Upper
+		}
+	}
+
+	return i
+}
+
+// Contains reports whether v is in the interval.
+func (i Interval) Contains(v *Version) bool {
+	if i.Lower.Version != nil {
+		if d := v.Compare(i.Lower.Version); d < 0 || (d == 0 && !i.Lower.Inclusive) {
+			return false
+		}
+	}
+
+	if i.Upper.Version != nil {
+		if d := v.Compare(i.Upper.Version); d > 0 || (d == 0 && !i.Upper.Inclusive) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// String returns the interval in the interval notation, for example
+// "[1.2.3,2.0.0-0)". An unbounded side is left empty, like in "(,1.0.0]", and
+// an interval with a single version is written like "[1.2.3]".
+func (i Interval) String() string {
+	if i.Lower.Version != nil && i.Upper.Version != nil && i.Lower.Inclusive && i.Upper.Inclusive &&
+		i.Lower.Version.Equal(i.Upper.Version) {
+		return "[" + i.Lower.Version.String() + "]"
+	}
+
+	var sb strings.Builder
+
+	if i.Lower.Inclusive && i.Lower.Version != nil {
+		sb.WriteByte('[')
+	} else {
+		sb.WriteByte('(')
+	}
+
+	if i.Lower.Version != nil {
+		sb.WriteString(i.Lower.Version.String())
+	}
+
+	sb.WriteByte(',')
+
+	if i.Upper.Version != nil {
+		sb.WriteString(i.Upper.Version.String())
+	}
+
+	if i.Upper.Inclusive && i.Upper.Version != nil {
+		sb.WriteByte(']')
+	} else {
+		sb.WriteByte(')')
+	}
+
+	return sb.String()
+}
+
+// joins reports whether the interval o overlaps or touches i when o doesn't
+// start before i.
+func (i Interval) joins(o Interval) bool {
+	if i.Upper.Version == nil || o.Lower.Version == nil {
+		return true
+	}
+
+	d := o.Lower.Version.Compare(i.Upper.Version)
+
+	return d < 0 || (d == 0 && (i.Upper.Inclusive || o.Lower.Inclusive))
+}
+
+// mergeIntervals sorts the intervals and merges the ones that overlap or touch
+// each other. It modifies the given slice.
+func mergeIntervals(all []Interval) []Interval {
+	slices.SortFunc(all, func(a, b Interval) int {
+		return compareLowerBounds(a.Lower, b.Lower)
+	})
+
+	result := make([]Interval, 0, len(all))
+
+	for _, i := range all {
+		if n := len(result); n > 0 && result[n-1].joins(i) {
+			if compareUpperBounds(i.Upper, result[n-1].Upper) > 0 {
+				result[n-1].Upper = i.Upper
+			}
+
+			continue
+		}
+
+		result = append(result, i)
+	}
+
+	return result
+}
+
+// rangeIntervals returns the intervals of versions that satisfy all of
+// the comparators in the range, ignoring the pre-release rule of the range. The
+// intervals are in increasing order and don't overlap.
+func rangeIntervals(set []comparator) []Interval {
+	unbounded := Bound{Version: nil, Inclusive: false}
+	result := Interval{Lower: unbounded, Upper: unbounded}
+
+	var excluded Versions
+
+	for _, c := range set {
+		i := Interval{Lower: unbounded, Upper: unbounded}
+
+		switch c.op {
+		case opEqual:
+			i.Lower = Bound{Version: c.v, Inclusive: true}
+			i.Upper = Bound{Version: c.v, Inclusive: true}
+		case opNotEqual:
+			excluded = append(excluded, c.v)
+
+			continue
+		case opLess:
+			i.Upper = Bound{Version: c.v, Inclusive: false}
+		case opLessOrEqual:
+			i.Upper = Bound{Version: c.v, Inclusive: true}
+		case opGreater:
+			i.Lower = Bound{Version: c.v, Inclusive: false}
+		case opGreaterOrEqual:
+			i.Lower = Bound{Version: c.v, Inclusive: true}
+		default:
+			panic(fmt.Sprintf("invalid operator: %d", c.op))
+		}
+
+		result = result.intersect(i)
+	}
+
+	if result.IsEmpty() {
+		return nil
+	}
+
+	slices.SortFunc(excluded, Compare)
+	excluded = slices.CompactFunc(excluded, (*Version).Equal)
+
+	intervals := make([]Interval, 0, len(excluded)+1)
+
+	for _, v := range excluded {
+		if !result.Contains(v) {
+			continue
+		}
+
+		before := Interval{Lower: result.Lower, Upper: Bound{Version: v, Inclusive: false}}
+		if !before.IsEmpty() {
+			intervals = append(intervals, before)
+		}
+
+		result.Lower = Bound{Version: v, Inclusive: false}
+	}
+
+	if !result.IsEmpty() {
+		intervals = append(intervals, result)
+	}
+
+	return intervals
+}
+
+// compareLowerBounds compares two lower bounds so that the bound that allows
+// lower versions is less.
+func compareLowerBounds(a, b Bound) int {
+	switch {
+	case a.Version == nil && b.Version == nil:
+		return 0
+	case a.Version == nil:
+		return -1
+	case b.Version == nil:
+		return 1
+	}
+
+	if d := a.Version.Compare(b.Version); d != 0 {
+		return d
+	}
+
+	switch {
+	case a.Inclusive == b.Inclusive:
+		return 0
+	case a.Inclusive:
+		return -1
+	default:
+		return 1
+	}
+}
+
+// compareUpperBounds compares two upper bounds so that the bound that allows
+// greater versions is greater.
+func compareUpperBounds(a, b Bound) int {
+	switch {
+	case a.Version == nil && b.Version == nil:
+		return 0
+	case a.Version == nil:
+		return 1
+	case b.Version == nil:
+		return -1
+	}
+
+	if d := a.Version.Compare(b.Version); d != 0 {
+		return d
+	}
+
+	switch {
+	case a.Inclusive == b.Inclusive:
+		return 0
+	case a.Inclusive:
+		return 1
+	default:
+		return -1
+	}
+}
+
+// anyRange returns a range that is satisfied by every version that doesn't
+// have pre-release identifiers.
+func anyRange() []comparator {
+	return []comparator{{v: coreVersion(0, 0, 0), op: opGreaterOrEqual}}
+}
+
+// checkRange reports whether v satisfies all of the comparators in the range
+// and the pre-release rule of the range. The pre-release rule is not applied if
+// prereleases is true.
+func checkRange(set []comparator, v *Version, prereleases bool) bool {
+	for _, c := range set {
+		if !c.check(v) {
+			return false
+		}
+	}
+
+	if len(v.Prerelease) == 0 || prereleases {
+		return true
+	}
+
+	for _, c := range set {
+		if len(c.v.Prerelease) > 0 && c.v.Major == v.Major && c.v.Minor == v.Minor &&
+			c.v.Patch == v.Patch {
+			return true
+		}
+	}
+
+	return false
+}
+
+// coreVersion returns a version with the given version core and without
+// pre-release identifiers and build metadata.
+func coreVersion(major, minor, patch uint64) *Version {
+	return &Version{Major: major, Minor: minor, Patch: patch, Prerelease: nil, Build: nil}
+}
+
+// lowestVersion returns the lowest version with the given version core, i.e.
+// the version with the single pre-release identifier "0".
+func lowestVersion(major, minor, patch uint64) *Version {
+	return &Version{
+		Major:      major,
+		Minor:      minor,
+		Patch:      patch,
+		Prerelease: Prerelease{numericIdentifier{0}},
+		Build:      nil,
+	}
+}
+
+// parseRange parses a single range of a Constraint into a list of
+// comparators.
+func parseRange(s string) ([]comparator, error) {
+	if s == "" {
+		return nil, fmt.Errorf("%w: empty range", ErrInvalidConstraint)
+	}
+
+	fields := strings.FieldsFunc(s, func(r rune) bool {
+		return r == ' ' || r == '\t' || r == ','
+	})
+
+	// Join the operators that are separated from their versions by spaces.
+	tokens := make([]string, 0, len(fields))
+
+	for i := 0; i < len(fields); i++ {
+		f := fields[i]
+		if isOperator(f) && i+1 < len(fields) {
+			f += fields[i+1]
+			i++
+		}
+
+		tokens = append(tokens, f)
+	}
+
+	if len(tokens) == 3 && tokens[1] == "-" { //nolint:mnd // <from> - <to>
+		return parseHyphenRange(tokens[0], tokens[2])
+	}
+
+	set := make([]comparator, 0, len(tokens))
+
+	for _, t := range tokens {
+		cs, err := parseComparator(t)
+		if err != nil {
+			return nil, err
+		}
+
+		set = append(set, cs...)
+	}
+
+	return set, nil
+}
+
+// parseHyphenRange parses the hyphen range "<from> - <to>" into comparators.
+func parseHyphenRange(from, to string) ([]comparator, error) {
+	lower, err := parsePartialVersion(from)
+	if err != nil {
+		return nil, err
+	}
+
+	upper, err := parsePartialVersion(to)
+	if err != nil {
+		return nil, err
+	}
+
+	set := make([]comparator, 0, 2) //nolint:mnd // lower and upper bound
+
+	if lower.n > 0 {
+		set = append(set, comparator{v: lower.full(), op: opGreaterOrEqual})
+	}
+
+	switch {
+	case upper.n == 3: //nolint:mnd // full version
+		set = append(set, comparator{v: upper.full(), op: opLessOrEqual})
+	case upper.n > 0:
+		next, err := upper.next()
+		if err != nil {
+			return nil, err
+		}
+
+		set = append(set, comparator{v: next, op: opLess})
+	}
+
+	if len(set) == 0 {
+		return anyRange(), nil
+	}
+
+	return set, nil
+}
+
+// parseComparator parses a single comparator token into primitive comparators.
+//
+//nolint:cyclop // the operators are simple to list in one place
+func parseComparator(s string) ([]comparator, error) {
+	var op string
+
+	for _, prefix := range []string{"~>", ">=", "<=", "!=", "==", ">", "<", "=", "~", "^"} {
+		if strings.HasPrefix(s, prefix) {
+			op = prefix
+			s = s[len(prefix):]
+
+			break
+		}
+	}
+
+	p, err := parsePartialVersion(s)
+	if err != nil {
+		return nil, err
+	}
+
+	switch op {
+	case "", "=", "==":
+		return xRange(p)
+	case "!=":
+		if p.n < 3 { //nolint:mnd // full version
+			return nil, fmt.Errorf("%w: partial version %q with \"!=\"", ErrInvalidConstraint, s)
+		}
+
+		return []comparator{{v: p.full(), op: opNotEqual}}, nil
+	case "~", "~>":
+		return tildeRange(p)
+	case "^":
+		return caretRange(p)
+	default:
+		return primitiveRange(op, p)
+	}
+}
+
+// xRange returns the comparators for an x-range, i.e. a partial version
+// without an operator or with "=".
+func xRange(p partialVersion) ([]comparator, error) {
+	switch p.n {
+	case 0:
+		return anyRange(), nil
+	case 3: //nolint:mnd // full version
+		return []comparator{{v: p.full(), op: opEqual}}, nil
+	default:
+		next, err := p.next()
+		if err != nil {
+			return nil, err
+		}
+
+		return []comparator{
+			{v: p.full(), op: opGreaterOrEqual},
+			{v: next, op: opLess},
+		}, nil
+	}
+}
+
+// tildeRange returns the comparators for a tilde range.
+func tildeRange(p partialVersion) ([]comparator, error) {
+	if p.n == 0 {
+		return anyRange(), nil
+	}
+
+	q := p
+	if q.n > 2 { //nolint:mnd // allow patch-level changes
+		q.n = 2
+	}
+
+	next, err := q.next()
+	if err != nil {
+		return nil, err
+	}
+
+	return []comparator{
+		{v: p.full(), op: opGreaterOrEqual},
+		{v: next, op: opLess},
+	}, nil
+}
+
+// caretRange returns the comparators for a caret range.
+func caretRange(p partialVersion) ([]comparator, error) {
+	if p.n == 0 {
+		return anyRange(), nil
+	}
+
+	// Find the left-most non-zero number that is given. If all of the given
+	// numbers are zeros, the last given number is the one that is fixed.
+	q := p
+
+	switch {
+	case p.major != 0 || p.n == 1:
+		q.n = 1
+	case p.minor != 0 || p.n == 2: //nolint:mnd // <major>.<minor>
+		q.n = 2
+	default:
+		q.n = 3
+	}
+
+	next, err := q.next()
+	if err != nil {
+		return nil, err
+	}
+
+	return []comparator{
+		{v: p.full(), op: opGreaterOrEqual},
+		{v: next, op: opLess},
+	}, nil
+}
+
+// primitiveRange returns the comparators for a primitive comparison with
+// a possibly partial version.
+func primitiveRange(op string, p partialVersion) ([]comparator, error) {
+	if p.n == 0 {
+		if op == ">=" || op == "<=" {
+			return anyRange(), nil
+		}
+
+		// Nothing is greater or less than every version.
+		return []comparator{{v: lowestVersion(0, 0, 0), op: opLess}}, nil
+	}
+
+	if p.n == 3 { //nolint:mnd // full version
+		o := map[string]operator{
+			"<":  opLess,
+			"<=": opLessOrEqual,
+			">":  opGreater,
+			">=": opGreaterOrEqual,
+		}[op]
+
+		return []comparator{{v: p.full(), op: o}}, nil
+	}
+
+	switch op {
+	case ">=":
+		return []comparator{{v: p.full(), op: opGreaterOrEqual}}, nil
+	case "<":
+		return []comparator{{v: lowestVersion(p.major, p.minor, p.patch), op: opLess}}, nil
+	}
+
+	// For ">" and "<=", the partial version covers every version in
+	// the x-range.
+	next, err := p.next()
+	if err != nil {
+		return nil, err
+	}
+
+	if op == ">" {
+		return []comparator{{v: coreVersion(next.Major, next.Minor, next.Patch), op: opGreaterOrEqual}}, nil
+	}
+
+	return []comparator{{v: next, op: opLess}}, nil
+}
+
+// parsePartialVersion parses a possibly partial version in a constraint. The
+// missing numbers may be omitted or given as "x", "X", or "*".
+//
+//nolint:cyclop // parsing the numbers is simpler in one function
+func parsePartialVersion(s string) (partialVersion, error) {
+	var p partialVersion
+
+	if s == "" {
+		return p, fmt.Errorf("%w: missing version", ErrInvalidConstraint)
+	}
+
+	orig := s
+	s = strings.TrimPrefix(s, "v")
+
+	// The build metadata is not used in comparisons.
+	if i := strings.IndexByte(s, '+'); i >= 0 {
+		if _, err := parseBuild(s[i+1:]); err != nil {
+			return p, fmt.Errorf("%w: %w", ErrInvalidConstraint, err)
+		}
+
+		s = s[:i]
+	}
+
+	core := s
+	pre := ""
+
+	if i := strings.IndexByte(s, '-'); i >= 0 {
+		core, pre = s[:i], s[i+1:]
+	}
+
+	nums := strings.Split(core, ".")
+	if len(nums) > 3 { //nolint:mnd // <major>.<minor>.<patch>
+		return p, fmt.Errorf("%w: too many version numbers in %q", ErrInvalidConstraint, orig)
+	}
+
+	wildcard := false
+
+	for i, n := range nums {
+		if n == "x" || n == "X" || n == "*" {
+			wildcard = true
+
+			continue
+		}
+
+		if wildcard {
+			return p, fmt.Errorf("%w: version number after a wildcard in %q", ErrInvalidConstraint, orig)
+		}
+
+		u, err := parseVersionNumber(n)
+		if err != nil {
+			return p, fmt.Errorf("%w: invalid version %q: %w", ErrInvalidConstraint, orig, err)
+		}
+
+		switch i {
+		case 0:
+			p.major = u
+		case 1:
+			p.minor = u
+		default:
+			p.patch = u
+		}
+
+		p.n++
+	}
+
+	if pre != "" || strings.HasSuffix(s, "-") {
+		if p.n < 3 { //nolint:mnd // full version
+			return p, fmt.Errorf("%w: pre-release in a partial version %q", ErrInvalidConstraint, orig)
+		}
+
+		for ident := range strings.SplitSeq(pre, ".") {
+			pi, err := parsePrereleaseIdentifier(ident)
+			if err != nil {
+				return p, fmt.Errorf("%w: invalid version %q: %w", ErrInvalidConstraint, orig, err)
+			}
+
+			p.prerelease = append(p.prerelease, pi)
+		}
+	}
+
+	return p, nil
+}
+
+// parseVersionNumber parses a single number of a version core.
+func parseVersionNumber(s string) (uint64, error) {
+	if s == "" {
+		return 0, fmt.Errorf("%w: empty version number", ErrInvalidVersion)
+	}
+
+	n, err := parsePrereleaseIdentifier(s)
+	if err != nil {
+		return 0, err
+	}
+
+	u, ok := n.(numericIdentifier)
+	if !ok {
+		return 0, fmt.Errorf("%w: version number %q is not a number", ErrInvalidVersion, s)
+	}
+
+	return u.v, nil
+}
+
+// isOperator reports whether s consists only of a comparison operator.
+func isOperator(s string) bool {
+	switch s {
+	case "~>", ">=", "<=", "!=", "==", ">", "<", "=", "~", "^":
+		return true
+	default:
+		return false
+	}
+}
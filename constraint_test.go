@@ -0,0 +1,462 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/anttikivi/semver"
+)
+
+func TestConstraintCheck(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		c    string
+		v    string
+		want bool
+	}{
+		{"", "1.0.0", true},
+		{"", "1.0.0-beta", false},
+		{"*", "0.0.0", true},
+		{"x", "99.99.99", true},
+		{"1.2.3", "1.2.3", true},
+		{"1.2.3", "1.2.3+build", true},
+		{"1.2.3", "1.2.4", false},
+		{"=1.2.3", "1.2.3", true},
+		{"==1.2.3", "1.2.3", true},
+		{"v1.2.3", "1.2.3", true},
+		{"!=1.2.3", "1.2.3", false},
+		{"!=1.2.3", "1.2.4", true},
+		{">1.2.3", "1.2.4", true},
+		{">1.2.3", "1.2.3", false},
+		{">=1.2.3", "1.2.3", true},
+		{"<1.2.3", "1.2.2", true},
+		{"<1.2.3", "1.2.3", false},
+		{"<=1.2.3", "1.2.3", true},
+		{"> 1.2.3", "1.2.4", true},
+		{">= 1.2.0, < 1.4.5", "1.4.4", true},
+		{">= 1.2.0, < 1.4.5", "1.4.5", false},
+		{">=1.2.0 <1.4.5", "1.1.9", false},
+		{"1.2", "1.2.9", true},
+		{"1.2", "1.3.0", false},
+		{"1.2.x", "1.2.0", true},
+		{"1.2.*", "1.3.0", false},
+		{"1", "1.9.9", true},
+		{"1.x", "2.0.0", false},
+		{"1.x", "2.0.0-0", false},
+		{">1.2", "1.2.9", false},
+		{">1.2", "1.3.0", true},
+		{">=1.2", "1.2.0", true},
+		{"<1.2", "1.1.9", true},
+		{"<1.2", "1.2.0", false},
+		{"<=1.2", "1.2.9", true},
+		{"<=1.2", "1.3.0", false},
+		{">*", "0.0.0", false},
+		{"<*", "0.0.0", false},
+		{">=*", "1.0.0", true},
+		{"~1.2.3", "1.2.9", true},
+		{"~1.2.3", "1.3.0", false},
+		{"~1.2.3", "1.2.2", false},
+		{"~>1.2.3", "1.2.9", true},
+		{"~1.2", "1.2.0", true},
+		{"~1.2", "1.3.0", false},
+		{"~1", "1.9.0", true},
+		{"~1", "2.0.0", false},
+		{"~0", "0.9.0", true},
+		{"^1.2.3", "1.9.9", true},
+		{"^1.2.3", "2.0.0", false},
+		{"^1.2.3", "1.2.2", false},
+		{"^0.2.3", "0.2.9", true},
+		{"^0.2.3", "0.3.0", false},
+		{"^0.0.3", "0.0.3", true},
+		{"^0.0.3", "0.0.4", false},
+		{"^1.2", "1.9.0", true},
+		{"^0.0", "0.0.9", true},
+		{"^0.0", "0.1.0", false},
+		{"^0.x", "0.9.0", true},
+		{"^0.x", "1.0.0", false},
+		{"^1.2.x", "1.3.0", true},
+		{"1.2.3 - 2.3.4", "1.2.3", true},
+		{"1.2.3 - 2.3.4", "2.3.4", true},
+		{"1.2.3 - 2.3.4", "2.3.5", false},
+		{"1.2 - 2.3.4", "1.2.0", true},
+		{"1.2.3 - 2.3", "2.3.9", true},
+		{"1.2.3 - 2.3", "2.4.0", false},
+		{"1.2.3 - 2", "2.9.9", true},
+		{"1.2.3 - 2", "3.0.0", false},
+		{"* - 2", "0.0.1", true},
+		{"<1.0.0 || >=2.0.0", "0.9.0", true},
+		{"<1.0.0 || >=2.0.0", "1.5.0", false},
+		{"<1.0.0 || >=2.0.0", "2.0.0", true},
+		{"^1.2.3 || ^2.0.0", "2.5.0", true},
+		{">=1.2.3-beta.1", "1.2.3-beta.2", true},
+		{">=1.2.3-beta.1", "1.2.3-alpha", false},
+		{">=1.2.3-beta.1", "1.2.4-beta.1", false},
+		{">=1.2.3-beta.1", "1.2.4", true},
+		{"^1.2.3-beta.1", "1.2.3", true},
+		{"^1.2.3-beta.1", "1.2.3-rc.1", true},
+		{"^1.2.3-beta.1", "1.3.0-rc.1", false},
+		{"<2.0.0", "2.0.0-rc.1", false},
+		{"<2.0.0", "1.9.0-rc.1", false},
+		{"~1.2.3-rc.1", "1.2.3-rc.2", true},
+		{"1.2.3-rc.1", "1.2.3-rc.1", true},
+		{"<1.2.3-rc.2 >1.2.3-rc.0", "1.2.3-rc.1", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.c+"/"+tt.v, func(t *testing.T) {
+			t.Parallel()
+
+			c, err := semver.ParseConstraint(tt.c)
+			if err != nil {
+				t.Fatalf("ParseConstraint(%q) failed unexpectedly: %v", tt.c, err)
+			}
+
+			got := c.Check(semver.MustParse(tt.v))
+			if got != tt.want {
+				t.Errorf("Constraint{%q}.Check(%q) = %v, want %v", tt.c, tt.v, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConstraintCheckIncludePrereleases(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		c    string
+		v    string
+		want bool
+	}{
+		{"", "1.0.0-beta", true},
+		{"^1.2.0", "1.3.0-beta.1", true},
+		{"^1.2.0", "1.2.0-rc.1", false},
+		{"^1.2.0", "2.0.0-rc.1", false},
+		{"<2.0.0", "2.0.0-rc.1", true},
+		{"~1.2.3 || ^3.0.0", "3.1.0-alpha", true},
+		{"1.2.3", "1.2.3-beta", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.c+" "+tt.v, func(t *testing.T) {
+			t.Parallel()
+
+			c := semver.MustParseConstraint(tt.c)
+			if got := c.Check(semver.MustParse(tt.v), semver.IncludePrereleases()); got != tt.want {
+				t.Errorf("Constraint{%q}.Check(%q, IncludePrereleases) = %v, want %v", tt.c, tt.v, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConstraintMaxSatisfying(t *testing.T) {
+	t.Parallel()
+
+	versions := semver.Versions{
+		semver.MustParse("1.2.3"),
+		semver.MustParse("2.0.0-rc.1"),
+		semver.MustParse("1.9.0"),
+		semver.MustParse("1.10.0-beta"),
+		semver.MustParse("0.9.0"),
+	}
+
+	tests := []struct {
+		c    string
+		want string
+	}{
+		{"^1.0.0", "1.9.0"},
+		{"*", "1.9.0"},
+		{"<1.0.0", "0.9.0"},
+		{">=2.0.0-rc.1", "2.0.0-rc.1"},
+		{"^3.0.0", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.c, func(t *testing.T) {
+			t.Parallel()
+
+			got := semver.MustParseConstraint(tt.c).MaxSatisfying(versions)
+
+			switch {
+			case got == nil && tt.want != "":
+				t.Errorf("Constraint{%q}.MaxSatisfying() = nil, want %q", tt.c, tt.want)
+			case got != nil && got.String() != tt.want:
+				t.Errorf("Constraint{%q}.MaxSatisfying() = %q, want %q", tt.c, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConstraintMinSatisfying(t *testing.T) {
+	t.Parallel()
+
+	versions := semver.Versions{
+		semver.MustParse("1.2.3"),
+		semver.MustParse("2.0.0-rc.1"),
+		semver.MustParse("1.9.0"),
+		semver.MustParse("1.0.0-beta"),
+		semver.MustParse("0.9.0"),
+	}
+
+	tests := []struct {
+		c    string
+		want string
+	}{
+		{"^1.0.0", "1.2.3"},
+		{"*", "0.9.0"},
+		{">=1.0.0-alpha", "1.0.0-beta"},
+		{">=1.3.0", "1.9.0"},
+		{"^3.0.0", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.c, func(t *testing.T) {
+			t.Parallel()
+
+			got := semver.MustParseConstraint(tt.c).MinSatisfying(versions)
+
+			switch {
+			case got == nil && tt.want != "":
+				t.Errorf("Constraint{%q}.MinSatisfying() = nil, want %q", tt.c, tt.want)
+			case got != nil && got.String() != tt.want:
+				t.Errorf("Constraint{%q}.MinSatisfying() = %q, want %q", tt.c, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseConstraintInvalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []string{
+		"||",
+		"1.2.3 ||",
+		">=",
+		"1.2.3.4",
+		"01.2.3",
+		"1.02",
+		"1.x.3",
+		"1.2-beta",
+		"1.2.3-",
+		"1.2.3-01",
+		"1.2.3+",
+		"a.b.c",
+		"!=1.2",
+		">=1.2.3 <=",
+		"^18446744073709551615",
+		"1.2.3 - ",
+		"1.2.3 — 2.0.0",
+	}
+
+	for _, tt := range tests {
+		t.Run(tt, func(t *testing.T) {
+			t.Parallel()
+
+			c, err := semver.ParseConstraint(tt)
+			if err == nil {
+				t.Fatalf("ParseConstraint(%q) = %v, want error", tt, c)
+			}
+
+			if !errors.Is(err, semver.ErrInvalidConstraint) {
+				t.Errorf("ParseConstraint(%q) error = %v, want ErrInvalidConstraint", tt, err)
+			}
+		})
+	}
+}
+
+func TestConstraintSubsumes(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		c    string
+		o    string
+		want bool
+	}{
+		{"^1.0.0", "~1.2.0", true},
+		{"~1.2.0", "^1.0.0", false},
+		{"*", "^1.0.0 || ^2.0.0", true},
+		{">=1.0.0 <2.0.0", "^1.0.0", true},
+		{"<=1.2.3", "<1.2.4", true},
+		{"<1.2.4", "<=1.2.3", true},
+		{">1.2.3", ">=1.2.4", true},
+		{"^1.0.0 || ^2.0.0", ">=1.5.0 <2.5.0", true},
+		{"^1.0.0 || ^3.0.0", ">=1.5.0 <3.5.0", false},
+		{">=1.2.0-beta", ">=1.3.0-beta", false},
+		{">=1.2.0-beta", ">=1.2.0-rc", true},
+		{">=1.2.0", ">=1.2.0-beta", false},
+		{"^1.0.0", ">2.0.0 <1.0.0", true},
+		{"=1.2.3", "1.2.3", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.c+" "+tt.o, func(t *testing.T) {
+			t.Parallel()
+
+			c, o := semver.MustParseConstraint(tt.c), semver.MustParseConstraint(tt.o)
+
+			if got := c.Subsumes(o); got != tt.want {
+				t.Errorf("Constraint{%q}.Subsumes(%q) = %v, want %v", tt.c, tt.o, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConstraintBoundaries(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		c    string
+		want []string
+	}{
+		{">=1.2.3 <2.0.0 || >3.0.0", []string{"[1.2.3", "2.0.0)", "(3.0.0"}},
+		{"^1.2.3", []string{"[1.2.3", "2.0.0-0)"}},
+		{"1.2.3", []string{"[1.2.3", "1.2.3]"}},
+		{"<=1.0.0 || ^1.2.0", []string{"1.0.0]", "[1.2.0", "2.0.0-0)"}},
+		{"*", []string{"[0.0.0"}},
+		{"<1.0.0", []string{"1.0.0)"}},
+		{">2.0.0 <1.0.0", []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.c, func(t *testing.T) {
+			t.Parallel()
+
+			boundaries := semver.MustParseConstraint(tt.c).Boundaries()
+			got := make([]string, len(boundaries))
+
+			for i, b := range boundaries {
+				switch {
+				case b.Upper && b.Inclusive:
+					got[i] = b.Version.String() + "]"
+				case b.Upper:
+					got[i] = b.Version.String() + ")"
+				case b.Inclusive:
+					got[i] = "[" + b.Version.String()
+				default:
+					got[i] = "(" + b.Version.String()
+				}
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Constraint{%q}.Boundaries() = %v, want %v", tt.c, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConstraintString(t *testing.T) {
+	t.Parallel()
+
+	c := semver.MustParseConstraint("  >=1.2.3 <2.0.0 || ^3.0.0  ")
+
+	if got, want := c.String(), ">=1.2.3 <2.0.0 || ^3.0.0"; got != want {
+		t.Errorf("Constraint.String() = %q, want %q", got, want)
+	}
+}
+
+func TestConstraintMaxSatisfyingSkipYanked(t *testing.T) {
+	t.Parallel()
+
+	versions := semver.Versions{
+		semver.MustParse("1.2.3"),
+		semver.MustParse("1.9.0"),
+		semver.MustParse("1.8.0"),
+	}
+
+	yanked := func(v *semver.Version) bool {
+		return v.String() == "1.9.0"
+	}
+
+	tests := []struct {
+		c    string
+		want string
+	}{
+		{"^1.0.0", "1.8.0"},
+		{"1.9.0", "1.9.0"},
+		{"=1.9.0 || ^2.0.0", "1.9.0"},
+		{">=1.9.0", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.c, func(t *testing.T) {
+			t.Parallel()
+
+			got := semver.MustParseConstraint(tt.c).MaxSatisfying(versions, semver.SkipYanked(yanked))
+
+			switch {
+			case got == nil && tt.want != "":
+				t.Errorf("Constraint{%q}.MaxSatisfying(SkipYanked) = nil, want %q", tt.c, tt.want)
+			case got != nil && got.String() != tt.want:
+				t.Errorf("Constraint{%q}.MaxSatisfying(SkipYanked) = %q, want %q", tt.c, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConstraintIntervals(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		c    string
+		want []string
+	}{
+		{"^1.2.3", []string{"[1.2.3,2.0.0-0)"}},
+		{"*", []string{"[0.0.0,)"}},
+		{"<1.0.0 || >=2.0.0", []string{"(,1.0.0)", "[2.0.0,)"}},
+		{"^1.0.0 || ^1.5.0 || ^2.0.0", []string{"[1.0.0,2.0.0-0)", "[2.0.0,3.0.0-0)"}},
+		{"^1.0.0 || >=1.5.0 <3.0.0", []string{"[1.0.0,3.0.0)"}},
+		{"1.2.3 || 1.2.4", []string{"[1.2.3]", "[1.2.4]"}},
+		{">=1.0.0 !=1.5.0 <2.0.0", []string{"[1.0.0,1.5.0)", "(1.5.0,2.0.0)"}},
+		{"<=1.0.0 || >1.0.0 <2.0.0", []string{"(,2.0.0)"}},
+		{">2.0.0 <1.0.0", []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.c, func(t *testing.T) {
+			t.Parallel()
+
+			intervals := semver.MustParseConstraint(tt.c).Intervals()
+
+			got := make([]string, len(intervals))
+			for i, iv := range intervals {
+				got[i] = iv.String()
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Constraint{%q}.Intervals() = %v, want %v", tt.c, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConstraintIntervalsCopies(t *testing.T) {
+	t.Parallel()
+
+	c := semver.MustParseConstraint("^1.2.3")
+
+	c.Intervals()[0].Lower.Version.Major = 5
+
+	if !c.Check(semver.MustParse("1.2.3")) {
+		t.Error("modifying an interval bound changed the constraint")
+	}
+}
@@ -0,0 +1,162 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// A GitDescribe is the output of "git describe --tags", like
+// "v1.2.3-14-g2414721-dirty", parsed by [ParseGitDescribe].
+type GitDescribe struct {
+	// Tag is the version of the most recent tag.
+	Tag *Version
+
+	// Commits is the number of commits after the tag.
+	Commits uint64
+
+	// Hash is the abbreviated hash of the described commit without the "g"
+	// prefix. It is empty if the output doesn't have it, i.e. the commit is
+	// the tagged one and the output is not in the long format.
+	Hash string
+
+	// Dirty reports whether the working tree had uncommitted changes, i.e.
+	// the output has the "-dirty" suffix of "git describe --dirty".
+	Dirty bool
+}
+
+// ParseGitDescribe parses the output of "git describe --tags". The output has
+// the format "<tag>[-<commits>-g<hash>][-dirty]", where the tag is a version
+// that may have the "v" prefix and a pre-release, for example "v1.2.3",
+// "v1.2.3-rc.1-14-g2414721", or "1.2.3-0-g2414721-dirty". It returns an error
+// that wraps [ErrInvalidVersion] if the tag is not a valid version.
+func ParseGitDescribe(s string) (*GitDescribe, error) {
+	s = strings.TrimSpace(s)
+	d := &GitDescribe{Tag: nil, Commits: 0, Hash: "", Dirty: false}
+
+	if rest, ok := strings.CutSuffix(s, "-dirty"); ok {
+		s = rest
+		d.Dirty = true
+	}
+
+	if i := strings.LastIndex(s, "-g"); i > 0 && isGitHash(s[i+2:]) {
+		if j := strings.LastIndexByte(s[:i], '-'); j > 0 {
+			if n, err := strconv.ParseUint(s[j+1:i], 10, 64); err == nil {
+				d.Commits = n
+				d.Hash = s[i+2:]
+				s = s[:j]
+			}
+		}
+	}
+
+	v, err := Parse(s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid tag in git describe output: %w", err)
+	}
+
+	d.Tag = v
+
+	return d, nil
+}
+
+// Version returns a version for the described commit that sorts after
+// the tag and before the next release. If the commit is the tagged one and
+// the working tree is clean, it is the tag. Otherwise, the identifiers 0 and
+// the number of commits are added to the pre-release of the tag, and if
+// the tag is a release, its patch version is incremented first, so
+// "v1.2.3-14-g2414721" becomes "1.2.4-0.14" and "v1.2.3-rc.1-14-g2414721"
+// becomes "1.2.3-rc.1.0.14". The build metadata has the hash with the "g"
+// prefix and "dirty" if the working tree was dirty, for example
+// "1.2.4-0.14+g2414721.dirty". Version returns an error if the patch version
+// of the tag can't be incremented.
+func (d *GitDescribe) Version() (*Version, error) {
+	if d.Commits == 0 && !d.Dirty {
+		return &Version{
+			Major:      d.Tag.Major,
+			Minor:      d.Tag.Minor,
+			Patch:      d.Tag.Patch,
+			Prerelease: append(Prerelease(nil), d.Tag.Prerelease...),
+			Build:      nil,
+		}, nil
+	}
+
+	v := &Version{
+		Major:      d.Tag.Major,
+		Minor:      d.Tag.Minor,
+		Patch:      d.Tag.Patch,
+		Prerelease: make(Prerelease, 0, len(d.Tag.Prerelease)+2), //nolint:mnd // 0 and the commits
+		Build:      nil,
+	}
+
+	if len(d.Tag.Prerelease) == 0 {
+		if v.Patch == math.MaxUint64 {
+			return nil, fmt.Errorf("%w: patch version of %s overflows", ErrInvalidVersion, d.Tag)
+		}
+
+		v.Patch++
+	}
+
+	v.Prerelease = append(v.Prerelease, d.Tag.Prerelease...)
+	v.Prerelease = append(v.Prerelease, numericIdentifier{0}, numericIdentifier{d.Commits})
+
+	if d.Hash != "" {
+		v.Build = append(v.Build, "g"+d.Hash)
+	}
+
+	if d.Dirty {
+		v.Build = append(v.Build, "dirty")
+	}
+
+	return v, nil
+}
+
+// String returns d in the format of "git describe --tags". The tag is written
+// with the "v" prefix.
+func (d *GitDescribe) String() string {
+	s := "v" + d.Tag.String()
+
+	if d.Hash != "" {
+		s += "-" + strconv.FormatUint(d.Commits, 10) + "-g" + d.Hash
+	}
+
+	if d.Dirty {
+		s += "-dirty"
+	}
+
+	return s
+}
+
+// isGitHash reports whether s is an abbreviated or full commit hash.
+func isGitHash(s string) bool {
+	if len(s) < 4 || len(s) > 64 { //nolint:mnd // the limits of the abbreviated hashes
+		return false
+	}
+
+	for i := range len(s) {
+		if !isDigit(s[i]) && (s[i] < 'a' || s[i] > 'f') {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,107 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/anttikivi/semver"
+)
+
+func TestParseGitDescribe(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		s       string
+		tag     string
+		commits uint64
+		hash    string
+		dirty   bool
+		version string
+	}{
+		{"v1.2.3", "1.2.3", 0, "", false, "1.2.3"},
+		{"v1.2.3-dirty", "1.2.3", 0, "", true, "1.2.4-0.0+dirty"},
+		{"v1.2.3-14-g2414721", "1.2.3", 14, "2414721", false, "1.2.4-0.14+g2414721"},
+		{"v1.2.3-14-g2414721-dirty", "1.2.3", 14, "2414721", true, "1.2.4-0.14+g2414721.dirty"},
+		{"1.2.3-0-g2414721\n", "1.2.3", 0, "2414721", false, "1.2.3"},
+		{"v2.0.0-rc.1-3-gabcdef0", "2.0.0-rc.1", 3, "abcdef0", false, "2.0.0-rc.1.0.3+gabcdef0"},
+		{"v2.0.0-rc-1", "2.0.0-rc-1", 0, "", false, "2.0.0-rc-1"},
+	}
+
+	for _, tt := range tests {
+		d, err := semver.ParseGitDescribe(tt.s)
+		if err != nil {
+			t.Errorf("ParseGitDescribe(%q) error = %v", tt.s, err)
+
+			continue
+		}
+
+		if d.Tag.String() != tt.tag || d.Commits != tt.commits || d.Hash != tt.hash ||
+			d.Dirty != tt.dirty {
+			t.Errorf(
+				"ParseGitDescribe(%q) = %s, %d, %q, %v, want %s, %d, %q, %v",
+				tt.s,
+				d.Tag,
+				d.Commits,
+				d.Hash,
+				d.Dirty,
+				tt.tag,
+				tt.commits,
+				tt.hash,
+				tt.dirty,
+			)
+		}
+
+		v, err := d.Version()
+		if err != nil || v.String() != tt.version {
+			t.Errorf("ParseGitDescribe(%q).Version() = %v, %v, want %s", tt.s, v, err, tt.version)
+		}
+
+		if v != nil && v.Compare(d.Tag) < 0 {
+			t.Errorf("ParseGitDescribe(%q).Version() = %s sorts before the tag", tt.s, v)
+		}
+	}
+}
+
+func TestParseGitDescribeInvalid(t *testing.T) {
+	t.Parallel()
+
+	for _, s := range []string{"", "release-14-g2414721", "v1.2-14-g2414721", "main"} {
+		if _, err := semver.ParseGitDescribe(s); !errors.Is(err, semver.ErrInvalidVersion) {
+			t.Errorf("ParseGitDescribe(%q) error = %v, want %v", s, err, semver.ErrInvalidVersion)
+		}
+	}
+}
+
+func TestGitDescribeString(t *testing.T) {
+	t.Parallel()
+
+	for _, s := range []string{"v1.2.3", "v1.2.3-14-g2414721-dirty", "v1.0.0-rc.1-0-gabcd"} {
+		d, err := semver.ParseGitDescribe(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if got := d.String(); got != s {
+			t.Errorf("ParseGitDescribe(%q).String() = %q", s, got)
+		}
+	}
+}
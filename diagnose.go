@@ -0,0 +1,143 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Severities of diagnostics. The values are the same as in the Language
+// Server Protocol.
+const (
+	// SeverityError is the severity of a version that is not valid.
+	SeverityError DiagnosticSeverity = iota + 1
+
+	// SeverityWarning is the severity of a problem that doesn't make
+	// the version invalid.
+	SeverityWarning
+
+	// SeverityInformation is the severity of an informational message.
+	SeverityInformation
+
+	// SeverityHint is the severity of a hint.
+	SeverityHint
+)
+
+// A DiagnosticSeverity is the severity of a Diagnostic.
+type DiagnosticSeverity int
+
+// A Diagnostic is a problem in a version string, like the diagnostics of
+// a language server.
+type Diagnostic struct {
+	// Start is the byte offset of the start of the problem.
+	Start int
+
+	// End is the byte offset of the end of the problem.
+	End int
+
+	// Severity is the severity of the problem.
+	Severity DiagnosticSeverity
+
+	// Message describes the problem.
+	Message string
+
+	// SuggestedFix is an edit that fixes the problem. It is nil if there is
+	// no fix.
+	SuggestedFix *TextEdit
+}
+
+// A TextEdit is a replacement of a byte range of a string.
+type TextEdit struct {
+	// Start is the byte offset of the start of the replaced range.
+	Start int
+
+	// End is the byte offset of the end of the replaced range.
+	End int
+
+	// NewText is the text that replaces the range.
+	NewText string
+}
+
+// Diagnose returns the problems in the version string s. A valid version has
+// no problems. For an invalid version, the diagnostic starts at the offset of
+// the error that [Tokenizer] reports and extends to the end of the invalid
+// component. If the version can be parsed by [Repair] with all of the fixes
+// enabled, including the partial versions that [ParseLax] accepts,
+// the diagnostic suggests replacing the whole string with the repaired
+// version, keeping the "v" prefix.
+func Diagnose(s string) []Diagnostic {
+	_, err := Spans(s)
+
+	var pe *ParseError
+	if !errors.As(err, &pe) {
+		return nil
+	}
+
+	// The error is at a separator or in a component that ends at the next
+	// separator.
+	end := pe.Offset
+
+	switch i := strings.IndexAny(s[end:], ".-+"); {
+	case i < 0:
+		end = len(s)
+	case i == 0:
+		end++
+	default:
+		end += i
+	}
+
+	d := Diagnostic{
+		Start:        pe.Offset,
+		End:          end,
+		Severity:     SeverityError,
+		Message:      pe.Message,
+		SuggestedFix: nil,
+	}
+
+	v, _, err := Repair(s, WithAcceptLeadingZeros(), WithCoerceSeparators(), WithNormalizeUnicode())
+	if err == nil {
+		repaired := v.String()
+		if strings.HasPrefix(strings.TrimSpace(s), "v") {
+			repaired = "v" + repaired
+		}
+
+		d.SuggestedFix = &TextEdit{Start: 0, End: len(s), NewText: repaired}
+	}
+
+	return []Diagnostic{d}
+}
+
+// String returns the name of the severity.
+func (s DiagnosticSeverity) String() string {
+	switch s {
+	case SeverityError:
+		return "error"
+	case SeverityWarning:
+		return "warning"
+	case SeverityInformation:
+		return "information"
+	case SeverityHint:
+		return "hint"
+	default:
+		return fmt.Sprintf("DiagnosticSeverity(%d)", int(s))
+	}
+}
@@ -0,0 +1,69 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver_test
+
+import (
+	"testing"
+
+	"github.com/anttikivi/semver"
+)
+
+func TestDiagnose(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		s          string
+		start, end int
+		fix        string
+	}{
+		{"1.02.3", 2, 4, "1.2.3"},
+		{"v1.2", 4, 4, "v1.2.0"},
+		{"1_2_3", 1, 5, "1.2.3"},
+		{"1.2.3-rc..1", 9, 10, ""},
+		{"1.2.x", 4, 5, ""},
+	}
+
+	for _, tt := range tests {
+		got := semver.Diagnose(tt.s)
+		if len(got) != 1 {
+			t.Errorf("Diagnose(%q) = %+v, want one diagnostic", tt.s, got)
+
+			continue
+		}
+
+		d := got[0]
+		if d.Start != tt.start || d.End != tt.end || d.Severity != semver.SeverityError ||
+			d.Message == "" {
+			t.Errorf("Diagnose(%q) = %+v, want the range [%d, %d)", tt.s, d, tt.start, tt.end)
+		}
+
+		switch {
+		case tt.fix == "" && d.SuggestedFix != nil:
+			t.Errorf("Diagnose(%q) suggested %+v, want no fix", tt.s, d.SuggestedFix)
+		case tt.fix != "" && (d.SuggestedFix == nil || d.SuggestedFix.NewText != tt.fix ||
+			d.SuggestedFix.Start != 0 || d.SuggestedFix.End != len(tt.s)):
+			t.Errorf("Diagnose(%q) suggested %+v, want %q", tt.s, d.SuggestedFix, tt.fix)
+		}
+	}
+
+	if got := semver.Diagnose("v1.2.3-rc.1"); got != nil {
+		t.Errorf("Diagnose() of a valid version = %+v, want nil", got)
+	}
+}
@@ -0,0 +1,201 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// Release levels, from the least significant to the most significant.
+const (
+	// LevelNone means that the versions are equal.
+	LevelNone ReleaseLevel = iota
+
+	// LevelBuild means that the versions differ only in build metadata.
+	LevelBuild
+
+	// LevelPrerelease means that the versions differ only in pre-release
+	// identifiers.
+	LevelPrerelease
+
+	// LevelPatch means that the most significant difference between
+	// the versions is in the patch version.
+	LevelPatch
+
+	// LevelMinor means that the most significant difference between
+	// the versions is in the minor version.
+	LevelMinor
+
+	// LevelMajor means that the most significant difference between
+	// the versions is in the major version.
+	LevelMajor
+)
+
+// A ReleaseLevel is the significance of a change between two versions.
+type ReleaseLevel int
+
+// A VersionDiff is the difference between two versions.
+type VersionDiff struct {
+	// From is the version the difference is computed from.
+	From *Version
+
+	// To is the version the difference is computed to.
+	To *Version
+
+	// Level is the most significant level where the versions differ.
+	Level ReleaseLevel
+
+	// Releases is the number of releases at Level between From and To,
+	// including To. It is zero for the levels below LevelPatch.
+	Releases uint64
+}
+
+// Diff returns the difference between the versions from and to. If releases is
+// given, Releases of the difference is the number of releases in it at
+// the level of the difference between from and to, including to: for a minor
+// upgrade, it is the number of different minor versions, and for a patch
+// upgrade, the number of patch versions. Pre-release versions in releases are
+// not counted. If releases is nil, Releases is computed from the version
+// numbers alone.
+func Diff(from, to *Version, releases Versions) VersionDiff {
+	d := VersionDiff{From: from, To: to, Level: diffLevel(from, to), Releases: 0}
+
+	if d.Level < LevelPatch {
+		return d
+	}
+
+	lo, hi := from, to
+	if lo.Compare(hi) > 0 {
+		lo, hi = hi, lo
+	}
+
+	if releases == nil {
+		switch d.Level {
+		case LevelMajor:
+			d.Releases = hi.Major - lo.Major
+		case LevelMinor:
+			d.Releases = hi.Minor - lo.Minor
+		default:
+			d.Releases = hi.Patch - lo.Patch
+		}
+
+		return d
+	}
+
+	seen := make(map[[3]uint64]struct{})
+	base := seriesKey(d.Level, lo)
+
+	for _, r := range releases {
+		if len(r.Prerelease) > 0 || r.Compare(lo) <= 0 || r.Compare(hi) > 0 {
+			continue
+		}
+
+		if key := seriesKey(d.Level, r); key != base {
+			seen[key] = struct{}{}
+		}
+	}
+
+	d.Releases = uint64(len(seen))
+
+	return d
+}
+
+// String returns a human-readable summary of the difference, for example
+// "minor upgrade from 1.4.2 to 1.6.0 (2 minor releases)".
+func (d VersionDiff) String() string {
+	if d.Level == LevelNone {
+		return "no change from " + d.From.String()
+	}
+
+	var kind string
+
+	switch c := d.To.Compare(d.From); {
+	case c > 0:
+		kind = "upgrade"
+	case c < 0:
+		kind = "downgrade"
+	default:
+		kind = "change"
+	}
+
+	s := fmt.Sprintf("%s %s from %s to %s", d.Level, kind, d.From, d.To)
+
+	if d.Level >= LevelPatch {
+		s += " (" + strconv.FormatUint(d.Releases, 10) + " " + d.Level.String() + " release"
+		if d.Releases != 1 {
+			s += "s"
+		}
+
+		s += ")"
+	}
+
+	return s
+}
+
+// String returns the string representation of the release level.
+func (l ReleaseLevel) String() string {
+	switch l {
+	case LevelNone:
+		return "none"
+	case LevelBuild:
+		return "build"
+	case LevelPrerelease:
+		return "pre-release"
+	case LevelPatch:
+		return "patch"
+	case LevelMinor:
+		return "minor"
+	case LevelMajor:
+		return "major"
+	default:
+		return fmt.Sprintf("ReleaseLevel(%d)", int(l))
+	}
+}
+
+// diffLevel returns the most significant level where the versions differ.
+func diffLevel(v, w *Version) ReleaseLevel {
+	switch {
+	case v.Major != w.Major:
+		return LevelMajor
+	case v.Minor != w.Minor:
+		return LevelMinor
+	case v.Patch != w.Patch:
+		return LevelPatch
+	case !v.Prerelease.equal(w.Prerelease):
+		return LevelPrerelease
+	case !v.Build.equal(w.Build):
+		return LevelBuild
+	default:
+		return LevelNone
+	}
+}
+
+// seriesKey returns the key of the release series of v at level l.
+func seriesKey(l ReleaseLevel, v *Version) [3]uint64 {
+	switch l {
+	case LevelMajor:
+		return [3]uint64{v.Major, 0, 0}
+	case LevelMinor:
+		return [3]uint64{v.Major, v.Minor, 0}
+	default:
+		return [3]uint64{v.Major, v.Minor, v.Patch}
+	}
+}
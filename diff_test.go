@@ -0,0 +1,66 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver_test
+
+import (
+	"testing"
+
+	"github.com/anttikivi/semver"
+)
+
+func TestDiff(t *testing.T) {
+	t.Parallel()
+
+	var releases semver.Versions
+	for _, s := range []string{"1.4.2", "1.4.3", "1.5.0", "1.5.1", "1.6.0-rc.1", "1.6.0", "2.0.0", "3.0.0"} {
+		releases = append(releases, semver.MustParse(s))
+	}
+
+	tests := []struct {
+		from      string
+		to        string
+		releases  semver.Versions
+		wantLevel semver.ReleaseLevel
+		want      string
+	}{
+		{"1.4.2", "1.6.0", nil, semver.LevelMinor, "minor upgrade from 1.4.2 to 1.6.0 (2 minor releases)"},
+		{"1.4.2", "1.6.0", releases, semver.LevelMinor, "minor upgrade from 1.4.2 to 1.6.0 (2 minor releases)"},
+		{"1.4.2", "1.5.1", releases, semver.LevelMinor, "minor upgrade from 1.4.2 to 1.5.1 (1 minor release)"},
+		{"1.4.2", "1.4.3", releases, semver.LevelPatch, "patch upgrade from 1.4.2 to 1.4.3 (1 patch release)"},
+		{"1.4.2", "1.4.9", releases, semver.LevelPatch, "patch upgrade from 1.4.2 to 1.4.9 (1 patch release)"},
+		{"1.4.2", "3.0.0", releases, semver.LevelMajor, "major upgrade from 1.4.2 to 3.0.0 (2 major releases)"},
+		{"3.0.0", "1.4.2", nil, semver.LevelMajor, "major downgrade from 3.0.0 to 1.4.2 (2 major releases)"},
+		{"1.6.0-rc.1", "1.6.0", nil, semver.LevelPrerelease, "pre-release upgrade from 1.6.0-rc.1 to 1.6.0"},
+		{"1.6.0+a", "1.6.0+b", nil, semver.LevelBuild, "build change from 1.6.0+a to 1.6.0+b"},
+		{"1.6.0", "1.6.0", nil, semver.LevelNone, "no change from 1.6.0"},
+	}
+
+	for _, tt := range tests {
+		d := semver.Diff(semver.MustParse(tt.from), semver.MustParse(tt.to), tt.releases)
+
+		if d.Level != tt.wantLevel {
+			t.Errorf("Diff(%q, %q).Level = %v, want %v", tt.from, tt.to, d.Level, tt.wantLevel)
+		}
+
+		if got := d.String(); got != tt.want {
+			t.Errorf("Diff(%q, %q).String() = %q, want %q", tt.from, tt.to, got, tt.want)
+		}
+	}
+}
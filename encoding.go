@@ -0,0 +1,280 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// The format version of the encoding of EncodeVersions.
+const encodingVersion = 1
+
+// The flags of an encoded version. The lowest two bits are the number of
+// the leading version numbers that are shared with the previous version.
+const (
+	encodedSharedMask  = 0x03
+	encodedPrerelease  = 0x04
+	encodedBuild       = 0x08
+	encodedUnknownMask = 0xf0
+)
+
+// ErrInvalidEncoding is returned when data given to DecodeVersions is not
+// a valid encoding of versions.
+var ErrInvalidEncoding = errors.New("invalid version encoding")
+
+// A versionDecoder decodes the versions encoded by EncodeVersions.
+type versionDecoder struct {
+	data []byte
+	pos  int
+}
+
+// EncodeVersions encodes the versions into a compact binary form for on-disk
+// indexes. Each version is encoded relative to the previous one: the leading
+// version numbers that are equal to the ones of the previous version are
+// omitted, the other numbers are written as varints, and the pre-release and
+// the build metadata are written as the length of the prefix shared with
+// the previous version followed by the rest of the string. The versions may be
+// in any order, but the encoding is the most compact when they are sorted.
+// Use [DecodeVersions] to decode the result.
+func EncodeVersions(x Versions) []byte {
+	b := make([]byte, 0, 1+binary.MaxVarintLen64+len(x)*4) //nolint:mnd // a guess
+	b = append(b, encodingVersion)
+	b = binary.AppendUvarint(b, uint64(len(x)))
+
+	var (
+		prev      *Version
+		prevPre   string
+		prevBuild string
+	)
+
+	for _, v := range x {
+		shared := 0
+
+		if prev != nil && v.Major == prev.Major {
+			shared++
+
+			if v.Minor == prev.Minor {
+				shared++
+
+				if v.Patch == prev.Patch {
+					shared++
+				}
+			}
+		}
+
+		flags := byte(shared)
+		pre, build := "", ""
+
+		if len(v.Prerelease) > 0 {
+			flags |= encodedPrerelease
+			pre = v.Prerelease.String()
+		}
+
+		if len(v.Build) > 0 {
+			flags |= encodedBuild
+			build = v.Build.String()
+		}
+
+		b = append(b, flags)
+
+		for _, n := range []uint64{v.Major, v.Minor, v.Patch}[shared:] {
+			b = binary.AppendUvarint(b, n)
+		}
+
+		if pre != "" {
+			b = appendFrontCoded(b, prevPre, pre)
+			prevPre = pre
+		}
+
+		if build != "" {
+			b = appendFrontCoded(b, prevBuild, build)
+			prevBuild = build
+		}
+
+		prev = v
+	}
+
+	return b
+}
+
+// DecodeVersions decodes the versions encoded by [EncodeVersions]. It returns
+// an error that wraps [ErrInvalidEncoding] if data is not a valid encoding,
+// and an error that wraps [ErrInvalidVersion] if an encoded version is not
+// valid.
+func DecodeVersions(data []byte) (Versions, error) {
+	if len(data) == 0 || data[0] != encodingVersion {
+		return nil, fmt.Errorf("%w: unknown format", ErrInvalidEncoding)
+	}
+
+	d := versionDecoder{data: data, pos: 1}
+
+	n, err := d.uvarint()
+	if err != nil {
+		return nil, err
+	}
+
+	// Every version takes at least one byte.
+	if n > uint64(len(data)) {
+		return nil, fmt.Errorf("%w: too many versions", ErrInvalidEncoding)
+	}
+
+	x := make(Versions, 0, n)
+
+	var (
+		prev      *Version
+		prevPre   string
+		prevBuild string
+	)
+
+	for range n {
+		v, err := d.version(prev, &prevPre, &prevBuild)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode version %d: %w", len(x), err)
+		}
+
+		x = append(x, v)
+		prev = v
+	}
+
+	if d.pos != len(data) {
+		return nil, fmt.Errorf("%w: trailing data", ErrInvalidEncoding)
+	}
+
+	return x, nil
+}
+
+// version decodes the next version. prevPre and prevBuild are the pre-release
+// and the build metadata of the previous versions that had them, and they are
+// updated.
+func (d *versionDecoder) version(prev *Version, prevPre, prevBuild *string) (*Version, error) {
+	if d.pos >= len(d.data) {
+		return nil, fmt.Errorf("%w: unexpected end of data", ErrInvalidEncoding)
+	}
+
+	flags := d.data[d.pos]
+	d.pos++
+
+	shared := int(flags & encodedSharedMask)
+	if flags&encodedUnknownMask != 0 || shared > 0 && prev == nil {
+		return nil, fmt.Errorf("%w: invalid flags %#x", ErrInvalidEncoding, flags)
+	}
+
+	var nums [3]uint64
+
+	if prev != nil {
+		nums = [3]uint64{prev.Major, prev.Minor, prev.Patch}
+	}
+
+	for i := shared; i < len(nums); i++ {
+		n, err := d.uvarint()
+		if err != nil {
+			return nil, err
+		}
+
+		nums[i] = n
+	}
+
+	v := &Version{Major: nums[0], Minor: nums[1], Patch: nums[2], Prerelease: nil, Build: nil}
+
+	if flags&encodedPrerelease != 0 {
+		s, err := d.frontCoded(*prevPre)
+		if err != nil {
+			return nil, err
+		}
+
+		for id := range strings.SplitSeq(s, ".") {
+			p, err := parsePrereleaseIdentifier(id)
+			if err != nil {
+				return nil, fmt.Errorf("invalid pre-release %q: %w", s, err)
+			}
+
+			v.Prerelease = append(v.Prerelease, p)
+		}
+
+		*prevPre = s
+	}
+
+	if flags&encodedBuild != 0 {
+		s, err := d.frontCoded(*prevBuild)
+		if err != nil {
+			return nil, err
+		}
+
+		if v.Build, err = parseBuild(s); err != nil {
+			return nil, fmt.Errorf("invalid build metadata %q: %w", s, err)
+		}
+
+		*prevBuild = s
+	}
+
+	return v, nil
+}
+
+// uvarint decodes the next varint.
+func (d *versionDecoder) uvarint() (uint64, error) {
+	n, size := binary.Uvarint(d.data[d.pos:])
+	if size <= 0 {
+		return 0, fmt.Errorf("%w: invalid varint at offset %d", ErrInvalidEncoding, d.pos)
+	}
+
+	d.pos += size
+
+	return n, nil
+}
+
+// frontCoded decodes the next string that was encoded relative to prev by
+// appendFrontCoded.
+func (d *versionDecoder) frontCoded(prev string) (string, error) {
+	shared, err := d.uvarint()
+	if err != nil {
+		return "", err
+	}
+
+	n, err := d.uvarint()
+	if err != nil {
+		return "", err
+	}
+
+	if shared > uint64(len(prev)) || n > uint64(len(d.data)-d.pos) || shared+n == 0 {
+		return "", fmt.Errorf("%w: invalid string at offset %d", ErrInvalidEncoding, d.pos)
+	}
+
+	s := prev[:shared] + string(d.data[d.pos:d.pos+int(n)]) //nolint:gosec // n is checked above
+	d.pos += int(n)                                         //nolint:gosec // n is checked above
+
+	return s, nil
+}
+
+// appendFrontCoded appends s to b as the length of the prefix that it shares
+// with prev followed by the length of the rest of s and the rest of s.
+func appendFrontCoded(b []byte, prev, s string) []byte {
+	shared := 0
+	for shared < len(prev) && shared < len(s) && prev[shared] == s[shared] {
+		shared++
+	}
+
+	b = binary.AppendUvarint(b, uint64(shared))
+	b = binary.AppendUvarint(b, uint64(len(s)-shared))
+
+	return append(b, s[shared:]...)
+}
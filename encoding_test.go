@@ -0,0 +1,181 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/anttikivi/semver"
+)
+
+func TestEncodeVersions(t *testing.T) {
+	t.Parallel()
+
+	tests := [][]string{
+		{},
+		{"1.0.0"},
+		{
+			"0.1.0",
+			"1.0.0-alpha.1",
+			"1.0.0-alpha.2",
+			"1.0.0-rc.1+build.1",
+			"1.0.0+build.2",
+			"1.0.0",
+			"1.0.1",
+			"1.2.0-x-y.0.a",
+			"2.0.0",
+			"0.0.1",
+			"18446744073709551615.0.0",
+		},
+	}
+
+	for _, tt := range tests {
+		x := make(semver.Versions, 0, len(tt))
+		for _, s := range tt {
+			x = append(x, semver.MustParse(s))
+		}
+
+		got, err := semver.DecodeVersions(semver.EncodeVersions(x))
+		if err != nil {
+			t.Errorf("DecodeVersions(EncodeVersions(%q)) error = %v", tt, err)
+
+			continue
+		}
+
+		if !slices.Equal(versionStrings(got), tt) {
+			t.Errorf("DecodeVersions(EncodeVersions(%q)) = %q", tt, versionStrings(got))
+		}
+	}
+}
+
+func TestDecodeVersionsInvalid(t *testing.T) {
+	t.Parallel()
+
+	valid := semver.EncodeVersions(semver.Versions{
+		semver.MustParse("1.0.0-rc.1"),
+		semver.MustParse("1.0.0-rc.2"),
+	})
+
+	tests := [][]byte{
+		nil,
+		{2, 0},
+		{1, 5, 0},
+		{1, 1, 1},
+		{1, 1, 0xf0, 1, 0, 0},
+		append(slices.Clone(valid), 0),
+		valid[:len(valid)-1],
+		{1, 1, 0x04, 1, 0, 0, 0, 0},
+	}
+
+	for _, data := range tests {
+		if _, err := semver.DecodeVersions(data); !errors.Is(err, semver.ErrInvalidEncoding) {
+			t.Errorf("DecodeVersions(%v) error = %v, want %v", data, err, semver.ErrInvalidEncoding)
+		}
+	}
+
+	_, err := semver.DecodeVersions([]byte{1, 1, 0x04, 1, 0, 0, 0, 2, '0', '1'})
+	if !errors.Is(err, semver.ErrInvalidVersion) {
+		t.Errorf("DecodeVersions() error = %v, want %v", err, semver.ErrInvalidVersion)
+	}
+}
+
+// registryVersions returns versions like the ones of a large registry in
+// increasing order.
+func registryVersions() semver.Versions {
+	var x semver.Versions
+
+	for major := range 10 {
+		for minor := range 30 {
+			for patch := range 10 {
+				x = append(
+					x,
+					semver.MustParse(fmt.Sprintf("%d.%d.%d-rc.1", major, minor, patch)),
+					semver.MustParse(fmt.Sprintf("%d.%d.%d", major, minor, patch)),
+				)
+			}
+		}
+	}
+
+	return x
+}
+
+func BenchmarkEncodeVersions(b *testing.B) {
+	x := registryVersions()
+
+	var n int
+
+	for b.Loop() {
+		n = len(semver.EncodeVersions(x))
+	}
+
+	b.ReportMetric(float64(n)/float64(len(x)), "bytes/version")
+}
+
+func BenchmarkEncodeVersionsJSON(b *testing.B) {
+	x := registryVersions()
+	s := versionStrings(x)
+
+	var n int
+
+	for b.Loop() {
+		data, err := json.Marshal(s)
+		if err != nil {
+			b.Fatal(err)
+		}
+
+		n = len(data)
+	}
+
+	b.ReportMetric(float64(n)/float64(len(x)), "bytes/version")
+}
+
+func BenchmarkDecodeVersions(b *testing.B) {
+	data := semver.EncodeVersions(registryVersions())
+
+	for b.Loop() {
+		if _, err := semver.DecodeVersions(data); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkDecodeVersionsJSON(b *testing.B) {
+	data, err := json.Marshal(versionStrings(registryVersions()))
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	for b.Loop() {
+		var s []string
+		if err := json.Unmarshal(data, &s); err != nil {
+			b.Fatal(err)
+		}
+
+		for _, v := range s {
+			if _, err := semver.Parse(v); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
@@ -0,0 +1,50 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver
+
+import "strconv"
+
+// Environ returns the version and its components as environment variables in
+// the "key=value" form of [os.Environ], for passing them to build scripts. The
+// variable named prefix holds the version string, and the variables named
+// prefix followed by "_MAJOR", "_MINOR", "_PATCH", "_PRERELEASE", and "_BUILD"
+// hold the components; the pre-release and build variables are empty if v has
+// none. If prefix is empty, it defaults to "VERSION":
+//
+//	VERSION=1.2.3-rc.1
+//	VERSION_MAJOR=1
+//	VERSION_MINOR=2
+//	VERSION_PATCH=3
+//	VERSION_PRERELEASE=rc.1
+//	VERSION_BUILD=
+func (v *Version) Environ(prefix string) []string {
+	if prefix == "" {
+		prefix = "VERSION"
+	}
+
+	return []string{
+		prefix + "=" + v.String(),
+		prefix + "_MAJOR=" + strconv.FormatUint(v.Major, 10),
+		prefix + "_MINOR=" + strconv.FormatUint(v.Minor, 10),
+		prefix + "_PATCH=" + strconv.FormatUint(v.Patch, 10),
+		prefix + "_PRERELEASE=" + v.Prerelease.String(),
+		prefix + "_BUILD=" + v.Build.String(),
+	}
+}
@@ -0,0 +1,57 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/anttikivi/semver"
+)
+
+func TestVersionEnviron(t *testing.T) {
+	t.Parallel()
+
+	v := semver.MustParse("1.2.3-rc.1+build.5")
+
+	want := []string{
+		"APP_VERSION=1.2.3-rc.1+build.5",
+		"APP_VERSION_MAJOR=1",
+		"APP_VERSION_MINOR=2",
+		"APP_VERSION_PATCH=3",
+		"APP_VERSION_PRERELEASE=rc.1",
+		"APP_VERSION_BUILD=build.5",
+	}
+	if got := v.Environ("APP_VERSION"); !slices.Equal(got, want) {
+		t.Errorf("Environ(\"APP_VERSION\") = %q, want %q", got, want)
+	}
+
+	want = []string{
+		"VERSION=2.0.0",
+		"VERSION_MAJOR=2",
+		"VERSION_MINOR=0",
+		"VERSION_PATCH=0",
+		"VERSION_PRERELEASE=",
+		"VERSION_BUILD=",
+	}
+	if got := semver.MustParse("2.0.0").Environ(""); !slices.Equal(got, want) {
+		t.Errorf("Environ(\"\") = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,116 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// An EpochVersion is a version with an epoch, written as "<epoch>:<version>",
+// for example "1:2.3.4". Epochs are used in the package versions of Linux
+// distributions for resetting the version ordering. The epoch takes precedence
+// over the version in comparisons, and a version without an explicit epoch has
+// the epoch 0.
+type EpochVersion struct {
+	*Version
+
+	Epoch uint64
+}
+
+// MustParseEpochVersion parses the given string into an EpochVersion and
+// panics if it encounters an error.
+func MustParseEpochVersion(s string) *EpochVersion {
+	v, err := ParseEpochVersion(s)
+	if err != nil {
+		panic(fmt.Sprintf("failed to parse the string %q into an epoch version: %v", s, err))
+	}
+
+	return v
+}
+
+// ParseEpochVersion parses the given string into an EpochVersion. The epoch is
+// optional, and the version after it is parsed using [Parse].
+func ParseEpochVersion(s string) (*EpochVersion, error) {
+	var epoch uint64
+
+	if e, rest, ok := strings.Cut(s, ":"); ok {
+		if e == "" || !isNumericIdentifier(e) {
+			return nil, fmt.Errorf("%w: invalid epoch %q", ErrInvalidVersion, e)
+		}
+
+		var err error
+
+		epoch, err = strconv.ParseUint(e, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert epoch to integer: %w", err)
+		}
+
+		s = rest
+	}
+
+	v, err := Parse(s)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse epoch version: %w", err)
+	}
+
+	return &EpochVersion{Version: v, Epoch: epoch}, nil
+}
+
+// Compare returns
+//
+//	-1 if v is less than w,
+//	 0 if v equals w,
+//	+1 if v is greater than w.
+//
+// The epochs are compared first, and the versions are compared only if
+// the epochs are equal.
+func (v *EpochVersion) Compare(w *EpochVersion) int {
+	switch {
+	case v.Epoch < w.Epoch:
+		return -1
+	case v.Epoch > w.Epoch:
+		return 1
+	default:
+		return v.Version.Compare(w.Version)
+	}
+}
+
+// Equal reports whether EpochVersion w is equal to v. The two versions are
+// equal if their epochs are equal and the versions are equal according to
+// [Version.Equal].
+func (v *EpochVersion) Equal(w *EpochVersion) bool {
+	if w == nil {
+		return v == nil
+	}
+
+	return v.Epoch == w.Epoch && v.Version.Equal(w.Version)
+}
+
+// String returns the string representation of v. The epoch is omitted if it
+// is 0.
+func (v *EpochVersion) String() string {
+	if v.Epoch == 0 {
+		return v.Version.String()
+	}
+
+	return strconv.FormatUint(v.Epoch, 10) + ":" + v.Version.String()
+}
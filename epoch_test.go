@@ -0,0 +1,90 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver_test
+
+import (
+	"testing"
+
+	"github.com/anttikivi/semver"
+)
+
+func TestParseEpochVersion(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		s         string
+		wantEpoch uint64
+		want      string
+		wantErr   bool
+	}{
+		{"1:2.3.4", 1, "1:2.3.4", false},
+		{"2.3.4", 0, "2.3.4", false},
+		{"0:2.3.4-rc.1", 0, "2.3.4-rc.1", false},
+		{"12:v1.0.0", 12, "12:1.0.0", false},
+		{":1.0.0", 0, "", true},
+		{"a:1.0.0", 0, "", true},
+		{"1:1.0", 0, "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := semver.ParseEpochVersion(tt.s)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseEpochVersion(%q) error = %v, wantErr %v", tt.s, err, tt.wantErr)
+			}
+
+			if err != nil {
+				return
+			}
+
+			if got.Epoch != tt.wantEpoch || got.String() != tt.want {
+				t.Errorf("ParseEpochVersion(%q) = %d, %q, want %d, %q", tt.s, got.Epoch, got, tt.wantEpoch, tt.want)
+			}
+		})
+	}
+}
+
+func TestEpochVersionCompare(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		v    string
+		w    string
+		want int
+	}{
+		{"1:1.0.0", "2.0.0", 1},
+		{"1:1.0.0", "1:2.0.0", -1},
+		{"0:1.0.0", "1.0.0", 0},
+		{"2:1.0.0", "3:0.1.0", -1},
+	}
+
+	for _, tt := range tests {
+		v, w := semver.MustParseEpochVersion(tt.v), semver.MustParseEpochVersion(tt.w)
+		if got := v.Compare(w); got != tt.want {
+			t.Errorf("EpochVersion{%q}.Compare(%q) = %d, want %d", tt.v, tt.w, got, tt.want)
+		}
+
+		if got := v.Equal(w); got != (tt.want == 0) {
+			t.Errorf("EpochVersion{%q}.Equal(%q) = %v, want %v", tt.v, tt.w, got, tt.want == 0)
+		}
+	}
+}
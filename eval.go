@@ -0,0 +1,261 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalidExpression is returned when an expression given to [Eval] is not
+// valid.
+var ErrInvalidExpression = errors.New("invalid expression")
+
+// An evaluator evaluates an expression for [Eval]. It evaluates the expression
+// while parsing it so that the whole expression is always checked.
+type evaluator struct {
+	// expr is the expression.
+	expr string
+
+	// pos is the current position in expr.
+	pos int
+
+	// v is the version that the identifier "v" refers to.
+	v *Version
+}
+
+// Eval evaluates the boolean expression expr for the version v. It is meant
+// for policies in configuration files, like `v >= "1.2.0" && v < "2.0.0"`.
+// The expression compares versions using the operators ==, !=, <, <=, >, and
+// >=, and combines the comparisons using &&, ||, !, and parentheses, with
+// the usual precedence. The operands of the comparisons are either
+// the identifier v or versions in double-quoted strings, which are parsed
+// using [ParseLax]. Eval returns an error that wraps [ErrInvalidExpression] if
+// the expression is not valid or if it refers to v and v is nil.
+func Eval(expr string, v *Version) (bool, error) {
+	e := evaluator{expr: expr, pos: 0, v: v}
+
+	result, err := e.or()
+	if err != nil {
+		return false, err
+	}
+
+	if e.skipSpace(); e.pos < len(e.expr) {
+		return false, e.errorf("unexpected %q", e.expr[e.pos:])
+	}
+
+	return result, nil
+}
+
+// or evaluates the operands joined by "||".
+func (e *evaluator) or() (bool, error) {
+	result, err := e.and()
+	if err != nil {
+		return false, err
+	}
+
+	for e.consume("||") {
+		b, err := e.and()
+		if err != nil {
+			return false, err
+		}
+
+		result = result || b
+	}
+
+	return result, nil
+}
+
+// and evaluates the operands joined by "&&".
+func (e *evaluator) and() (bool, error) {
+	result, err := e.unary()
+	if err != nil {
+		return false, err
+	}
+
+	for e.consume("&&") {
+		b, err := e.unary()
+		if err != nil {
+			return false, err
+		}
+
+		result = result && b
+	}
+
+	return result, nil
+}
+
+// unary evaluates a negation, a parenthesized expression, or a comparison.
+func (e *evaluator) unary() (bool, error) {
+	switch {
+	case e.consume("!"):
+		b, err := e.unary()
+
+		return !b, err
+	case e.consume("("):
+		b, err := e.or()
+		if err != nil {
+			return false, err
+		}
+
+		if !e.consume(")") {
+			return false, e.errorf("missing closing parenthesis")
+		}
+
+		return b, nil
+	default:
+		return e.comparison()
+	}
+}
+
+// comparison evaluates a comparison of two versions.
+func (e *evaluator) comparison() (bool, error) {
+	x, err := e.operand()
+	if err != nil {
+		return false, err
+	}
+
+	var op string
+
+	for _, o := range []string{"==", "!=", "<=", ">=", "<", ">"} {
+		if e.consume(o) {
+			op = o
+
+			break
+		}
+	}
+
+	if op == "" {
+		return false, e.errorf("expected a comparison operator")
+	}
+
+	y, err := e.operand()
+	if err != nil {
+		return false, err
+	}
+
+	c := x.Compare(y)
+
+	switch op {
+	case "==":
+		return c == 0, nil
+	case "!=":
+		return c != 0, nil
+	case "<=":
+		return c <= 0, nil
+	case ">=":
+		return c >= 0, nil
+	case "<":
+		return c < 0, nil
+	default:
+		return c > 0, nil
+	}
+}
+
+// operand evaluates the identifier v or a version string.
+func (e *evaluator) operand() (*Version, error) {
+	e.skipSpace()
+
+	if e.pos >= len(e.expr) {
+		return nil, e.errorf("expected an operand")
+	}
+
+	if e.expr[e.pos] == 'v' && (e.pos+1 == len(e.expr) || !isIdentifierCharacter(e.expr[e.pos+1])) {
+		if e.v == nil {
+			return nil, e.errorf("v is nil")
+		}
+
+		e.pos++
+
+		return e.v, nil
+	}
+
+	if e.expr[e.pos] != '"' {
+		return nil, e.errorf("expected v or a version string")
+	}
+
+	end := e.pos + 1
+	for end < len(e.expr) && e.expr[end] != '"' {
+		if e.expr[end] == '\\' {
+			end++
+		}
+
+		end++
+	}
+
+	if end >= len(e.expr) {
+		return nil, e.errorf("unterminated string")
+	}
+
+	s, err := strconv.Unquote(e.expr[e.pos : end+1])
+	if err != nil {
+		return nil, e.errorf("invalid string %s", e.expr[e.pos:end+1])
+	}
+
+	v, err := ParseLax(s)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidExpression, err)
+	}
+
+	e.pos = end + 1
+
+	return v, nil
+}
+
+// consume skips the whitespace and the given token if the expression continues
+// with it, and reports whether it did.
+func (e *evaluator) consume(token string) bool {
+	if !e.peek(token) {
+		return false
+	}
+
+	e.pos += len(token)
+
+	return true
+}
+
+// peek skips the whitespace and reports whether the expression continues with
+// the given token.
+func (e *evaluator) peek(token string) bool {
+	e.skipSpace()
+
+	return len(e.expr)-e.pos >= len(token) && e.expr[e.pos:e.pos+len(token)] == token
+}
+
+// skipSpace skips the whitespace at the current position.
+func (e *evaluator) skipSpace() {
+	for e.pos < len(e.expr) && strings.IndexByte(" \t\r\n", e.expr[e.pos]) >= 0 {
+		e.pos++
+	}
+}
+
+// errorf returns an error that wraps ErrInvalidExpression with the current
+// position.
+func (e *evaluator) errorf(format string, a ...any) error {
+	return fmt.Errorf(
+		"%w: %s at position %d in %q",
+		ErrInvalidExpression,
+		fmt.Sprintf(format, a...),
+		e.pos,
+		e.expr,
+	)
+}
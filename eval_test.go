@@ -0,0 +1,96 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/anttikivi/semver"
+)
+
+func TestEval(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		expr string
+		v    string
+		want bool
+	}{
+		{`v >= "1.2.0" && v < "2.0.0"`, "1.5.0", true},
+		{`v >= "1.2.0" && v < "2.0.0"`, "2.0.0", false},
+		{`v == "v1.4"`, "1.4.0", true},
+		{`"2.0.0" > v`, "1.9.9", true},
+		{`!(v < "1.0.0") && v != "1.3.0"`, "1.3.0", false},
+		{`v < "1.0.0" || v >= "2.0.0" && v < "3.0.0"`, "2.1.0", true},
+		{`v < "1.0.0" || v >= "2.0.0" && v < "3.0.0"`, "0.1.0", true},
+		{`(v < "1.0.0" || v >= "2.0.0") && v < "3.0.0"`, "3.1.0", false},
+	}
+
+	for _, tt := range tests {
+		got, err := semver.Eval(tt.expr, semver.MustParse(tt.v))
+		if err != nil {
+			t.Errorf("Eval(%q, %q) error = %v", tt.expr, tt.v, err)
+
+			continue
+		}
+
+		if got != tt.want {
+			t.Errorf("Eval(%q, %q) = %v, want %v", tt.expr, tt.v, got, tt.want)
+		}
+	}
+}
+
+func TestEvalInvalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []string{
+		``,
+		`v`,
+		`v >= `,
+		`v >= "1.2.0" &&`,
+		`(v >= "1.2.0"`,
+		`v >= "1.2.0")`,
+		`version >= "1.2.0"`,
+		`v >= "1.2.0`,
+		`v >= "not a version"`,
+		`v >= 1.2.0`,
+	}
+
+	for _, expr := range tests {
+		_, err := semver.Eval(expr, semver.MustParse("1.0.0"))
+		if !errors.Is(err, semver.ErrInvalidExpression) {
+			t.Errorf("Eval(%q) error = %v, want %v", expr, err, semver.ErrInvalidExpression)
+		}
+	}
+}
+
+func TestEvalNil(t *testing.T) {
+	t.Parallel()
+
+	if _, err := semver.Eval(`v >= "1.0.0"`, nil); !errors.Is(err, semver.ErrInvalidExpression) {
+		t.Errorf("Eval with nil v error = %v, want %v", err, semver.ErrInvalidExpression)
+	}
+
+	got, err := semver.Eval(`"1.0.0" < "2.0.0"`, nil)
+	if err != nil || !got {
+		t.Errorf("Eval with nil v = %v, %v, want true, nil", got, err)
+	}
+}
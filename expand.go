@@ -0,0 +1,144 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalidTemplate is returned when a version template given to
+// [ExpandTemplate] is not valid.
+var ErrInvalidTemplate = errors.New("invalid version template")
+
+// ExpandTemplate replaces the placeholders in tmpl with the values of
+// the variables in vars and checks that the result is a valid version, so
+// release configuration files can describe the next version declaratively,
+// for example "v{major}.{minor}.{patch+1}-rc.{n}". A placeholder is
+// the name of a variable in braces, and the name may be followed by "+" or "-"
+// and a number to add it to or subtract it from the value, which must then be
+// an unsigned integer. [VersionVars] returns the variables of a version.
+// The result is checked with [Parse], so it may have the "v" prefix.
+// ExpandTemplate returns an error that wraps [ErrInvalidTemplate] if
+// a placeholder is not valid or refers to an unknown variable, and an error
+// that wraps [ErrInvalidVersion] if the result is not a valid version.
+func ExpandTemplate(tmpl string, vars map[string]string) (string, error) {
+	var sb strings.Builder
+
+	rest := tmpl
+
+	for {
+		before, after, ok := strings.Cut(rest, "{")
+		if !ok {
+			if strings.Contains(rest, "}") {
+				return "", fmt.Errorf("%w: unexpected '}' in %q", ErrInvalidTemplate, tmpl)
+			}
+
+			sb.WriteString(rest)
+
+			break
+		}
+
+		if strings.Contains(before, "}") {
+			return "", fmt.Errorf("%w: unexpected '}' in %q", ErrInvalidTemplate, tmpl)
+		}
+
+		placeholder, tail, ok := strings.Cut(after, "}")
+		if !ok {
+			return "", fmt.Errorf("%w: unterminated placeholder in %q", ErrInvalidTemplate, tmpl)
+		}
+
+		value, err := expandPlaceholder(placeholder, vars)
+		if err != nil {
+			return "", err
+		}
+
+		sb.WriteString(before)
+		sb.WriteString(value)
+
+		rest = tail
+	}
+
+	s := sb.String()
+
+	if _, err := Parse(s); err != nil {
+		return "", fmt.Errorf("template %q expanded to an invalid version: %w", tmpl, err)
+	}
+
+	return s, nil
+}
+
+// VersionVars returns the variables of v for [ExpandTemplate]: "major",
+// "minor", and "patch" are the version numbers, "prerelease" is
+// the pre-release, and "build" is the build metadata.
+func VersionVars(v *Version) map[string]string {
+	return map[string]string{
+		"major":      strconv.FormatUint(v.Major, 10),
+		"minor":      strconv.FormatUint(v.Minor, 10),
+		"patch":      strconv.FormatUint(v.Patch, 10),
+		"prerelease": v.Prerelease.String(),
+		"build":      v.Build.String(),
+	}
+}
+
+// expandPlaceholder returns the value of the placeholder p without
+// the braces.
+func expandPlaceholder(p string, vars map[string]string) (string, error) {
+	name, op, delta := strings.TrimSpace(p), byte(0), ""
+
+	if i := strings.IndexAny(name, "+-"); i >= 0 {
+		name, op, delta = strings.TrimSpace(name[:i]), name[i], strings.TrimSpace(name[i+1:])
+	}
+
+	value, ok := vars[name]
+	if !ok {
+		return "", fmt.Errorf("%w: unknown variable %q", ErrInvalidTemplate, name)
+	}
+
+	if op == 0 {
+		return value, nil
+	}
+
+	n, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		return "", fmt.Errorf("%w: the value of %q is not a number", ErrInvalidTemplate, name)
+	}
+
+	d, err := strconv.ParseUint(delta, 10, 64)
+	if err != nil {
+		return "", fmt.Errorf("%w: invalid number in {%s}", ErrInvalidTemplate, p)
+	}
+
+	switch {
+	case op == '+' && n > math.MaxUint64-d:
+		return "", fmt.Errorf("%w: {%s} overflows", ErrInvalidTemplate, p)
+	case op == '+':
+		n += d
+	case d > n:
+		return "", fmt.Errorf("%w: {%s} is negative", ErrInvalidTemplate, p)
+	default:
+		n -= d
+	}
+
+	return strconv.FormatUint(n, 10), nil
+}
@@ -0,0 +1,83 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/anttikivi/semver"
+)
+
+func TestExpandTemplate(t *testing.T) {
+	t.Parallel()
+
+	vars := semver.VersionVars(semver.MustParse("1.4.2"))
+	vars["n"] = "3"
+
+	tests := []struct {
+		tmpl string
+		want string
+	}{
+		{"v{major}.{minor}.{patch+1}-rc.{n}", "v1.4.3-rc.3"},
+		{"{major}.{ minor + 1 }.0", "1.5.0"},
+		{"{major+1}.0.0-beta.{n-3}", "2.0.0-beta.0"},
+		{"1.0.0", "1.0.0"},
+	}
+
+	for _, tt := range tests {
+		got, err := semver.ExpandTemplate(tt.tmpl, vars)
+		if err != nil {
+			t.Errorf("ExpandTemplate(%q) error = %v", tt.tmpl, err)
+
+			continue
+		}
+
+		if got != tt.want {
+			t.Errorf("ExpandTemplate(%q) = %q, want %q", tt.tmpl, got, tt.want)
+		}
+	}
+}
+
+func TestExpandTemplateInvalid(t *testing.T) {
+	t.Parallel()
+
+	vars := map[string]string{"major": "1", "minor": "2", "patch": "3", "label": "rc"}
+
+	tests := []struct {
+		tmpl string
+		want error
+	}{
+		{"{major}.{minor}.{patch", semver.ErrInvalidTemplate},
+		{"{major}.{minor}}.0", semver.ErrInvalidTemplate},
+		{"{major}.{micro}.0", semver.ErrInvalidTemplate},
+		{"{major}.{minor}.{patch-4}", semver.ErrInvalidTemplate},
+		{"{major}.{minor}.{patch+x}", semver.ErrInvalidTemplate},
+		{"{major}.{minor}.0-{label+1}", semver.ErrInvalidTemplate},
+		{"{major}.{minor}", semver.ErrInvalidVersion},
+		{"{major}.{minor}.0-{label}.01", semver.ErrInvalidVersion},
+	}
+
+	for _, tt := range tests {
+		if _, err := semver.ExpandTemplate(tt.tmpl, vars); !errors.Is(err, tt.want) {
+			t.Errorf("ExpandTemplate(%q) error = %v, want %v", tt.tmpl, err, tt.want)
+		}
+	}
+}
@@ -0,0 +1,94 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver
+
+import "fmt"
+
+// CompareExplain compares v and w like [Version.Compare] and returns also
+// an explanation of which part of the versions decided the ordering, for
+// example "patch 3 < 4", "prerelease alpha < beta", or
+// "prerelease rc.2 < rc.11: 2 < 11". The explanation of equal versions is
+// "equal", or "equal: build metadata is ignored" if the versions have
+// different build identifiers.
+func CompareExplain(v, w *Version) (int, string) {
+	d := v.Compare(w)
+
+	for _, c := range []struct {
+		name string
+		x, y uint64
+	}{{"major", v.Major, w.Major}, {"minor", v.Minor, w.Minor}, {"patch", v.Patch, w.Patch}} {
+		if c.x != c.y {
+			return d, fmt.Sprintf("%s %d %s %d", c.name, c.x, compareOp(d), c.y)
+		}
+	}
+
+	switch {
+	case d == 0 && !v.Build.equal(w.Build):
+		return d, "equal: build metadata is ignored"
+	case d == 0:
+		return d, "equal"
+	case len(v.Prerelease) == 0:
+		return d, "no prerelease > prerelease " + w.Prerelease.String()
+	case len(w.Prerelease) == 0:
+		return d, "prerelease " + v.Prerelease.String() + " < no prerelease"
+	}
+
+	p, o := v.Prerelease, w.Prerelease
+	s := fmt.Sprintf("prerelease %s %s %s", p, compareOp(d), o)
+
+	for i := range max(len(p), len(o)) {
+		switch {
+		case i >= len(p) || i >= len(o):
+			return d, s + ": fewer identifiers"
+		case p[i].equal(o[i]):
+			continue
+		case len(p) == 1 && len(o) == 1:
+			return d, s
+		default:
+			return d, s + ": " + describeIdentifier(p[i], o[i]) + " " + compareOp(d) + " " +
+				describeIdentifier(o[i], p[i])
+		}
+	}
+
+	return d, s
+}
+
+// compareOp returns the operator for the result d of comparing two versions.
+func compareOp(d int) string {
+	if d < 0 {
+		return "<"
+	}
+
+	return ">"
+}
+
+// describeIdentifier describes the pre-release identifier x for comparing it
+// against y. The kind of the identifier is included if x and y are of
+// different kinds as the numeric identifiers are always lower.
+func describeIdentifier(x, y PrereleaseIdentifier) string {
+	switch {
+	case x.isNumeric() == y.isNumeric():
+		return x.String()
+	case x.isNumeric():
+		return "numeric " + x.String()
+	default:
+		return "alphanumeric " + x.String()
+	}
+}
@@ -0,0 +1,81 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver_test
+
+import (
+	"testing"
+
+	"github.com/anttikivi/semver"
+)
+
+func TestCompareExplain(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		v, w string
+		want int
+		why  string
+	}{
+		{"2.0.0", "1.9.9", 1, "major 2 > 1"},
+		{"1.2.0", "1.3.0", -1, "minor 2 < 3"},
+		{"1.2.3", "1.2.4", -1, "patch 3 < 4"},
+		{"1.0.0-alpha", "1.0.0-beta", -1, "prerelease alpha < beta"},
+		{"1.0.0-rc.11", "1.0.0-rc.2", 1, "prerelease rc.11 > rc.2: 11 > 2"},
+		{"1.0.0-alpha.1", "1.0.0-alpha.beta", -1, "prerelease alpha.1 < alpha.beta: " +
+			"numeric 1 < alphanumeric beta"},
+		{"1.0.0-alpha.1", "1.0.0-alpha", 1, "prerelease alpha.1 > alpha: fewer identifiers"},
+		{"1.0.0-rc.1", "1.0.0", -1, "prerelease rc.1 < no prerelease"},
+		{"1.0.0", "1.0.0-rc.1", 1, "no prerelease > prerelease rc.1"},
+		{"1.0.0-rc.1", "v1.0.0-rc.1", 0, "equal"},
+		{"1.0.0+a", "1.0.0+b", 0, "equal: build metadata is ignored"},
+	}
+
+	for _, tt := range tests {
+		got, why := semver.CompareExplain(semver.MustParse(tt.v), semver.MustParse(tt.w))
+		if got != tt.want || why != tt.why {
+			t.Errorf(
+				"CompareExplain(%s, %s) = %d, %q, want %d, %q",
+				tt.v,
+				tt.w,
+				got,
+				why,
+				tt.want,
+				tt.why,
+			)
+		}
+	}
+}
+
+func TestCompareExplainEmptyPrerelease(t *testing.T) {
+	t.Parallel()
+
+	v := &semver.Version{Major: 1, Prerelease: semver.Prerelease{}}
+	w := semver.MustParse("1.0.0-rc.1")
+
+	got, why := semver.CompareExplain(v, w)
+	if want := "no prerelease > prerelease rc.1"; got != 1 || why != want {
+		t.Errorf("CompareExplain(%s, %s) = %d, %q, want 1, %q", v, w, got, why, want)
+	}
+
+	got, why = semver.CompareExplain(w, v)
+	if want := "prerelease rc.1 < no prerelease"; got != -1 || why != want {
+		t.Errorf("CompareExplain(%s, %s) = %d, %q, want -1, %q", w, v, got, why, want)
+	}
+}
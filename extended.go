@@ -0,0 +1,142 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// An ExtendedVersion is a version with a revision number, written as
+// "<version>-<revision>", for example "1.2.3-2". Revisions are used by
+// the packagers of Linux distributions for numbering the packages of the same
+// upstream version. The revision is compared only if the versions are equal,
+// so it is kept separate from the pre-release identifiers.
+type ExtendedVersion struct {
+	*Version
+
+	Revision uint64
+}
+
+// MustParseExtendedVersion parses the given string into an ExtendedVersion and
+// panics if it encounters an error.
+func MustParseExtendedVersion(s string) *ExtendedVersion {
+	v, err := ParseExtendedVersion(s)
+	if err != nil {
+		panic(fmt.Sprintf("failed to parse the string %q into an extended version: %v", s, err))
+	}
+
+	return v
+}
+
+// ParseExtendedVersion parses the given string into an ExtendedVersion. If
+// the string, excluding the build metadata, ends with a hyphen followed by only
+// digits, the digits are parsed as the revision. The rest of the string is
+// parsed using [Parse]. For example, "1.2.3-2" is parsed as version "1.2.3"
+// with revision 2 and "1.2.3-rc.1-2+linux" as version "1.2.3-rc.1+linux" with
+// revision 2.
+func ParseExtendedVersion(s string) (*ExtendedVersion, error) {
+	var revision uint64
+
+	rest, build, hasBuild := strings.Cut(s, "+")
+
+	if i := strings.LastIndexByte(rest, '-'); i >= 0 && i+1 < len(rest) && isNumericIdentifier(rest[i+1:]) {
+		var err error
+
+		revision, err = strconv.ParseUint(rest[i+1:], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert revision to integer: %w", err)
+		}
+
+		s = rest[:i]
+		if hasBuild {
+			s += "+" + build
+		}
+	}
+
+	v, err := Parse(s)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse extended version: %w", err)
+	}
+
+	return &ExtendedVersion{Version: v, Revision: revision}, nil
+}
+
+// Compare returns
+//
+//	-1 if v is less than w,
+//	 0 if v equals w,
+//	+1 if v is greater than w.
+//
+// The versions are compared first, and the revisions are compared only if
+// the versions are equal.
+func (v *ExtendedVersion) Compare(w *ExtendedVersion) int {
+	if c := v.Version.Compare(w.Version); c != 0 {
+		return c
+	}
+
+	switch {
+	case v.Revision < w.Revision:
+		return -1
+	case v.Revision > w.Revision:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// Equal reports whether ExtendedVersion w is equal to v. The two versions are
+// equal if their revisions are equal and the versions are equal according to
+// [Version.Equal].
+func (v *ExtendedVersion) Equal(w *ExtendedVersion) bool {
+	if w == nil {
+		return v == nil
+	}
+
+	return v.Revision == w.Revision && v.Version.Equal(w.Version)
+}
+
+// String returns the string representation of v. The revision is omitted if
+// it is 0.
+func (v *ExtendedVersion) String() string {
+	if v.Revision == 0 {
+		return v.Version.String()
+	}
+
+	var sb strings.Builder
+
+	sb.WriteString(v.CoreString())
+
+	if len(v.Prerelease) > 0 {
+		sb.WriteByte('-')
+		sb.WriteString(v.Prerelease.String())
+	}
+
+	sb.WriteByte('-')
+	sb.WriteString(strconv.FormatUint(v.Revision, 10))
+
+	if len(v.Build) > 0 {
+		sb.WriteByte('+')
+		sb.WriteString(v.Build.String())
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,100 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver_test
+
+import (
+	"testing"
+
+	"github.com/anttikivi/semver"
+)
+
+func TestParseExtendedVersion(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		s            string
+		wantRevision uint64
+		wantVersion  string
+		want         string
+		wantErr      bool
+	}{
+		{"1.2.3-2", 2, "1.2.3", "1.2.3-2", false},
+		{"1.2.3", 0, "1.2.3", "1.2.3", false},
+		{"1.2.3-rc.1-2+linux", 2, "1.2.3-rc.1+linux", "1.2.3-rc.1-2+linux", false},
+		{"1.2.3-rc.1", 0, "1.2.3-rc.1", "1.2.3-rc.1", false},
+		{"1.2.3+build-5", 0, "1.2.3+build-5", "1.2.3+build-5", false},
+		{"1.2-2", 0, "", "", true},
+		{"1.2.3-", 0, "", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := semver.ParseExtendedVersion(tt.s)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseExtendedVersion(%q) error = %v, wantErr %v", tt.s, err, tt.wantErr)
+			}
+
+			if err != nil {
+				return
+			}
+
+			if got.Revision != tt.wantRevision || got.Version.String() != tt.wantVersion || got.String() != tt.want {
+				t.Errorf(
+					"ParseExtendedVersion(%q) = %q, %d, %q, want %q, %d, %q",
+					tt.s,
+					got.Version,
+					got.Revision,
+					got,
+					tt.wantVersion,
+					tt.wantRevision,
+					tt.want,
+				)
+			}
+		})
+	}
+}
+
+func TestExtendedVersionCompare(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		v    string
+		w    string
+		want int
+	}{
+		{"1.2.3-1", "1.2.3-2", -1},
+		{"1.2.3-10", "1.2.4-1", -1},
+		{"1.2.3-rc.1-3", "1.2.3-1", -1},
+		{"1.2.3", "1.2.3-0", 0},
+	}
+
+	for _, tt := range tests {
+		v, w := semver.MustParseExtendedVersion(tt.v), semver.MustParseExtendedVersion(tt.w)
+		if got := v.Compare(w); got != tt.want {
+			t.Errorf("ExtendedVersion{%q}.Compare(%q) = %d, want %d", tt.v, tt.w, got, tt.want)
+		}
+
+		if got := v.Equal(w); got != (tt.want == 0) {
+			t.Errorf("ExtendedVersion{%q}.Equal(%q) = %v, want %v", tt.v, tt.w, got, tt.want == 0)
+		}
+	}
+}
@@ -0,0 +1,105 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver
+
+import "fmt"
+
+// VersionFields is a plain representation of a [Version] that consists only of
+// integers and strings. It can be passed to serializers that don't support
+// the interface type of the pre-release identifiers, like the ones for
+// Cap'n Proto and FlatBuffers, without reflection.
+type VersionFields struct {
+	Major uint64
+	Minor uint64
+	Patch uint64
+
+	// Prerelease are the pre-release identifiers in order. The identifiers
+	// that consist only of digits are numeric identifiers.
+	Prerelease []string
+
+	// Build are the build identifiers in order.
+	Build []string
+}
+
+// FromFields returns the version with the given fields. The returned error
+// wraps [ErrInvalidVersion] if the identifiers in f are not valid.
+func FromFields(f VersionFields) (*Version, error) {
+	v := &Version{
+		Major:      f.Major,
+		Minor:      f.Minor,
+		Patch:      f.Patch,
+		Prerelease: nil,
+		Build:      nil,
+	}
+
+	if len(f.Prerelease) > 0 {
+		v.Prerelease = make(Prerelease, 0, len(f.Prerelease))
+
+		for _, s := range f.Prerelease {
+			id, err := parsePrereleaseIdentifier(s)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create version from fields: %w", err)
+			}
+
+			v.Prerelease = append(v.Prerelease, id)
+		}
+	}
+
+	for _, s := range f.Build {
+		if s == "" || !isAlphanumericIdentifier(s) {
+			return nil, fmt.Errorf(
+				"failed to create version from fields: %w: invalid build identifier %q",
+				ErrInvalidVersion,
+				s,
+			)
+		}
+	}
+
+	if len(f.Build) > 0 {
+		v.Build = newBuild(f.Build...)
+	}
+
+	return v, nil
+}
+
+// Fields returns the fields of v. The returned slices are not shared with v,
+// and they are nil if v has no pre-release or build identifiers.
+func (v *Version) Fields() VersionFields {
+	f := VersionFields{
+		Major:      v.Major,
+		Minor:      v.Minor,
+		Patch:      v.Patch,
+		Prerelease: nil,
+		Build:      nil,
+	}
+
+	if len(v.Prerelease) > 0 {
+		f.Prerelease = make([]string, len(v.Prerelease))
+		for i, id := range v.Prerelease {
+			f.Prerelease[i] = id.String()
+		}
+	}
+
+	if len(v.Build) > 0 {
+		f.Build = append([]string(nil), v.Build...)
+	}
+
+	return f
+}
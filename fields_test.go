@@ -0,0 +1,87 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/anttikivi/semver"
+)
+
+func TestVersionFields(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		v    string
+		want semver.VersionFields
+	}{
+		{"1.2.3", semver.VersionFields{Major: 1, Minor: 2, Patch: 3}},
+		{
+			"1.0.0-alpha.1+sha.0a1",
+			semver.VersionFields{
+				Major:      1,
+				Prerelease: []string{"alpha", "1"},
+				Build:      []string{"sha", "0a1"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		v := semver.MustParse(tt.v)
+
+		got := v.Fields()
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Version{%q}.Fields() = %+v, want %+v", tt.v, got, tt.want)
+		}
+
+		w, err := semver.FromFields(got)
+		if err != nil || !w.StrictEqual(v) || !reflect.DeepEqual(w, v) {
+			t.Errorf("FromFields(%+v) = %#v, %v, want %#v", got, w, err, v)
+		}
+
+		if got.Build != nil {
+			got.Build[0] = "changed"
+			if v.Build[0] == "changed" {
+				t.Errorf("modifying Version{%q}.Fields() changed the version", tt.v)
+			}
+		}
+	}
+}
+
+func TestFromFieldsInvalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []semver.VersionFields{
+		{Major: 1, Prerelease: []string{""}},
+		{Major: 1, Prerelease: []string{"01"}},
+		{Major: 1, Prerelease: []string{"a.b"}},
+		{Major: 1, Build: []string{""}},
+		{Major: 1, Build: []string{"a+b"}},
+	}
+
+	for _, f := range tests {
+		_, err := semver.FromFields(f)
+		if !errors.Is(err, semver.ErrInvalidVersion) {
+			t.Errorf("FromFields(%+v) error = %v, want %v", f, err, semver.ErrInvalidVersion)
+		}
+	}
+}
@@ -0,0 +1,204 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrInvalidFileName is returned when a file name doesn't contain a valid
+// version.
+var ErrInvalidFileName = errors.New("invalid versioned file name")
+
+// fileExtensions are the file extensions recognized by [ParseFileName]. The
+// extensions that end with another extension in the list must come before it.
+//
+//nolint:gochecknoglobals // read-only lookup table
+var fileExtensions = []string{
+	".tar.gz", ".tar.bz2", ".tar.xz", ".tar.zst", ".tgz", ".tbz2", ".txz",
+	".zip", ".7z", ".gz", ".bz2", ".xz", ".zst", ".exe", ".msi", ".deb",
+	".rpm", ".apk", ".dmg", ".pkg", ".jar", ".whl", ".AppImage",
+}
+
+// A FileName is a file name of a versioned artifact, such as
+// "tool-1.2.3-rc.1-linux-amd64.tar.gz", split into its fields.
+type FileName struct {
+	// Base is the name of the artifact before the version, for example "tool".
+	Base string
+
+	// Version is the version of the artifact.
+	Version *Version
+
+	// OS is the operating system of the artifact, for example "linux". It is
+	// empty if the file name has no platform.
+	OS string
+
+	// Arch is the architecture of the artifact, for example "amd64". It is
+	// empty if the file name has no architecture.
+	Arch string
+
+	// Ext is the file extension including the leading dot, for example
+	// ".tar.gz". It is empty if the file name has no extension.
+	Ext string
+}
+
+// BuildFileName returns the file name for the artifact base at version v with
+// the given file extension, for example "tool-1.2.3.tar.gz". A leading dot is
+// added to ext if it doesn't have one. Use [FileName.String] for file names
+// that include a platform.
+func BuildFileName(base string, v *Version, ext string) string {
+	f := FileName{Base: base, Version: v, OS: "", Arch: "", Ext: ext}
+
+	return f.String()
+}
+
+// ParseFileName parses a file name of a versioned artifact. The file name must
+// have the format "<base>-<version>[-<os>[-<arch>]][<ext>]", where the version
+// may have the "v" prefix and the hyphens may also be underscores. The version
+// is parsed using [Parse]. Because pre-release identifiers may contain
+// hyphens, only the common operating system and architecture names, like
+// the values of GOOS and GOARCH, are recognized as the platform, and only
+// the common archive and package extensions are recognized as the extension.
+// Any directory before the file name is ignored.
+//
+// For example, "tool-1.2.3-rc.1-linux-amd64.tar.gz" is parsed as the base
+// "tool", the version "1.2.3-rc.1", the operating system "linux",
+// the architecture "amd64", and the extension ".tar.gz".
+func ParseFileName(name string) (*FileName, error) {
+	if i := strings.LastIndexByte(name, '/'); i >= 0 {
+		name = name[i+1:]
+	}
+
+	stem, ext := cutFileExtension(name)
+
+	for i := 1; i < len(stem)-1; i++ {
+		if stem[i] != '-' && stem[i] != '_' {
+			continue
+		}
+
+		if !isDigit(stem[i+1]) && (stem[i+1] != 'v' || i+2 >= len(stem) || !isDigit(stem[i+2])) {
+			continue
+		}
+
+		s, goos, goarch := cutPlatform(stem[i+1:], stem[i])
+
+		v, err := Parse(s)
+		if err != nil {
+			continue
+		}
+
+		return &FileName{Base: stem[:i], Version: v, OS: goos, Arch: goarch, Ext: ext}, nil
+	}
+
+	return nil, fmt.Errorf("%w: no version in %q", ErrInvalidFileName, name)
+}
+
+// String returns the file name in the format
+// "<base>-<version>[-<os>[-<arch>]][<ext>]". A leading dot is added to
+// the extension if it doesn't have one.
+func (f *FileName) String() string {
+	var sb strings.Builder
+
+	sb.WriteString(f.Base)
+	sb.WriteByte('-')
+	sb.WriteString(f.Version.String())
+
+	if f.OS != "" {
+		sb.WriteByte('-')
+		sb.WriteString(f.OS)
+	}
+
+	if f.Arch != "" {
+		sb.WriteByte('-')
+		sb.WriteString(f.Arch)
+	}
+
+	if f.Ext != "" && f.Ext[0] != '.' {
+		sb.WriteByte('.')
+	}
+
+	sb.WriteString(f.Ext)
+
+	return sb.String()
+}
+
+// cutFileExtension splits the recognized file extension from the end of name.
+func cutFileExtension(name string) (string, string) {
+	lower := strings.ToLower(name)
+
+	for _, ext := range fileExtensions {
+		if strings.HasSuffix(lower, strings.ToLower(ext)) && len(name) > len(ext) {
+			return name[:len(name)-len(ext)], name[len(name)-len(ext):]
+		}
+	}
+
+	return name, ""
+}
+
+// cutPlatform splits the recognized operating system and architecture, that
+// are separated by sep, from the end of s. The architecture may itself contain
+// sep, like "x86_64" does.
+func cutPlatform(s string, sep byte) (string, string, string) {
+	i := strings.LastIndexByte(s, sep)
+	if i < 0 {
+		return s, "", ""
+	}
+
+	for a := i; a >= 0; a = strings.LastIndexByte(s[:a], sep) {
+		if !isArch(s[a+1:]) {
+			continue
+		}
+
+		if o := strings.LastIndexByte(s[:a], sep); o >= 0 && isOS(s[o+1:a]) {
+			return s[:o], s[o+1 : a], s[a+1:]
+		}
+	}
+
+	if isOS(s[i+1:]) {
+		return s[:i], s[i+1:], ""
+	}
+
+	return s, "", ""
+}
+
+// isOS reports whether s is a common name of an operating system.
+func isOS(s string) bool {
+	switch strings.ToLower(s) {
+	case "aix", "android", "darwin", "dragonfly", "freebsd", "illumos", "ios", "js", "linux", "macos",
+		"netbsd", "openbsd", "osx", "plan9", "solaris", "wasip1", "windows":
+		return true
+	default:
+		return false
+	}
+}
+
+// isArch reports whether s is a common name of an architecture.
+func isArch(s string) bool {
+	switch strings.ToLower(s) {
+	case "386", "aarch64", "all", "amd64", "arm", "arm64", "armv6", "armv7", "i386", "i686", "loong64",
+		"mips", "mips64", "mips64le", "mipsle", "ppc64", "ppc64le", "riscv64", "s390x", "universal",
+		"wasm", "x64", "x86", "x86_64":
+		return true
+	default:
+		return false
+	}
+}
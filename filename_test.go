@@ -0,0 +1,107 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/anttikivi/semver"
+)
+
+func TestParseFileName(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		base    string
+		version string
+		os      string
+		arch    string
+		ext     string
+		wantErr error
+	}{
+		{"tool-1.2.3-rc.1-linux-amd64.tar.gz", "tool", "1.2.3-rc.1", "linux", "amd64", ".tar.gz", nil},
+		{"tool-1.2.3.tar.gz", "tool", "1.2.3", "", "", ".tar.gz", nil},
+		{"tool-v1.2.3-windows-arm64.zip", "tool", "1.2.3", "windows", "arm64", ".zip", nil},
+		{"my-tool-2.0.0-beta-1-darwin.dmg", "my-tool", "2.0.0-beta-1", "darwin", "", ".dmg", nil},
+		{"tool_1.2.3_Linux_x86_64.tar.gz", "tool", "1.2.3", "Linux", "x86_64", ".tar.gz", nil},
+		{"releases/tool-1.2.3+build.5", "tool", "1.2.3+build.5", "", "", "", nil},
+		{"k3s-2go-1.0.0-linux-386", "k3s-2go", "1.0.0", "linux", "386", "", nil},
+		{"tool-1.2.3-amd64", "tool", "1.2.3-amd64", "", "", "", nil},
+		{"tool.tar.gz", "", "", "", "", "", semver.ErrInvalidFileName},
+		{"tool-1.2-linux", "", "", "", "", "", semver.ErrInvalidFileName},
+		{"1.2.3.tar.gz", "", "", "", "", "", semver.ErrInvalidFileName},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := semver.ParseFileName(tt.name)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ParseFileName(%q) error = %v, want %v", tt.name, err, tt.wantErr)
+			}
+
+			if err != nil {
+				return
+			}
+
+			if got.Base != tt.base || got.Version.String() != tt.version || got.OS != tt.os ||
+				got.Arch != tt.arch || got.Ext != tt.ext {
+				t.Errorf(
+					"ParseFileName(%q) = %q %q %q %q %q, want %q %q %q %q %q",
+					tt.name, got.Base, got.Version, got.OS, got.Arch, got.Ext,
+					tt.base, tt.version, tt.os, tt.arch, tt.ext,
+				)
+			}
+		})
+	}
+}
+
+func TestBuildFileName(t *testing.T) {
+	t.Parallel()
+
+	v := semver.MustParse("1.2.3-rc.1")
+
+	if got, want := semver.BuildFileName("tool", v, "tar.gz"), "tool-1.2.3-rc.1.tar.gz"; got != want {
+		t.Errorf("BuildFileName() = %q, want %q", got, want)
+	}
+
+	if got, want := semver.BuildFileName("tool", v, ""), "tool-1.2.3-rc.1"; got != want {
+		t.Errorf("BuildFileName() = %q, want %q", got, want)
+	}
+
+	f := semver.FileName{Base: "tool", Version: v, OS: "linux", Arch: "amd64", Ext: ".zip"}
+	name := f.String()
+
+	if want := "tool-1.2.3-rc.1-linux-amd64.zip"; name != want {
+		t.Errorf("FileName.String() = %q, want %q", name, want)
+	}
+
+	got, err := semver.ParseFileName(name)
+	if err != nil {
+		t.Fatalf("ParseFileName(%q) error = %v", name, err)
+	}
+
+	if got.String() != name {
+		t.Errorf("ParseFileName(%q).String() = %q", name, got.String())
+	}
+}
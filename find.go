@@ -0,0 +1,153 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver
+
+import (
+	"fmt"
+	"strings"
+)
+
+// A Match is an occurrence of a version in a text.
+type Match struct {
+	// Version is the version found in the text.
+	Version *Version
+
+	// Start is the byte offset of the start of the occurrence in the text,
+	// including the possible "v" prefix.
+	Start int
+
+	// End is the byte offset of the end of the occurrence in the text.
+	End int
+}
+
+// FindAll returns the occurrences of valid versions in text, in order.
+// A version may have the "v" prefix, but it must not be directly preceded by
+// a letter, a digit, or a dot, so "go1.2.3" and "1.1.2.3" contain no versions.
+// The longest valid version is matched at each position, and the trailing
+// characters that would make the version invalid, like the period at the end
+// of a sentence, are left out. Because pre-release identifiers may contain
+// hyphens, "1.2.3-linux" is matched as a single pre-release version.
+func FindAll(text string) []Match {
+	var matches []Match
+
+	for i := 0; i < len(text); i++ {
+		if !isMatchStart(text, i) {
+			continue
+		}
+
+		j := i
+		if text[j] == 'v' {
+			j++
+		}
+
+		for j < len(text) && (isIdentifierCharacter(text[j]) || text[j] == '.' || text[j] == '+') {
+			j++
+		}
+
+		for end := j; end > i; end-- {
+			v, err := Parse(text[i:end])
+			if err != nil {
+				continue
+			}
+
+			// A version with more than three core numbers is not a version.
+			if end+1 < len(text) && text[end] == '.' && isDigit(text[end+1]) &&
+				len(v.Prerelease) == 0 && len(v.Build) == 0 {
+				break
+			}
+
+			matches = append(matches, Match{Version: v, Start: i, End: end})
+			j = end
+
+			break
+		}
+
+		i = j - 1
+	}
+
+	return matches
+}
+
+// ReplaceAll replaces the versions in text with the versions returned by fn.
+// The versions are found using [FindAll], and fn is called for each one of
+// them. If fn returns nil, the occurrence is left as is. The "v" prefix of
+// the occurrence is kept in the replacement. ReplaceAll returns the new text
+// and the number of occurrences that changed. It returns an error if fn
+// returns a version that is not valid.
+func ReplaceAll(text string, fn func(*Version) *Version) (string, int, error) {
+	var sb strings.Builder
+
+	n := 0
+	last := 0
+
+	for _, m := range FindAll(text) {
+		w := fn(m.Version)
+		if w == nil {
+			continue
+		}
+
+		s := w.String()
+		if _, err := Parse(s); err != nil {
+			return "", 0, fmt.Errorf("invalid replacement for %q: %w", text[m.Start:m.End], err)
+		}
+
+		if text[m.Start] == 'v' {
+			s = "v" + s
+		}
+
+		if s == text[m.Start:m.End] {
+			continue
+		}
+
+		sb.WriteString(text[last:m.Start])
+		sb.WriteString(s)
+
+		last = m.End
+		n++
+	}
+
+	if n == 0 {
+		return text, 0, nil
+	}
+
+	sb.WriteString(text[last:])
+
+	return sb.String(), n, nil
+}
+
+// isMatchStart reports whether a version may start at text[i].
+func isMatchStart(text string, i int) bool {
+	c := text[i]
+
+	switch {
+	case isDigit(c):
+	case c == 'v' && i+1 < len(text) && isDigit(text[i+1]):
+	default:
+		return false
+	}
+
+	if i == 0 {
+		return true
+	}
+
+	p := text[i-1]
+
+	return !isDigit(p) && p != '.' && ('A' > p || p > 'Z') && ('a' > p || p > 'z')
+}
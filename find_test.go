@@ -0,0 +1,112 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/anttikivi/semver"
+)
+
+func TestFindAll(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		text string
+		want []string
+	}{
+		{"", nil},
+		{"no versions here", nil},
+		{"Released in 1.2.3.", []string{"1.2.3"}},
+		{"tool@v2.0.0-rc.1 and lib 0.1.0+build.5", []string{"v2.0.0-rc.1", "0.1.0+build.5"}},
+		{"go1.22.0 and 1.2.3.4 and 01.2.3", nil},
+		{`"version": "1.0.0",`, []string{"1.0.0"}},
+		{"1.2 and 1.2.3-", []string{"1.2.3"}},
+		{"v1.0.0\nv1.1.0", []string{"v1.0.0", "v1.1.0"}},
+	}
+
+	for _, tt := range tests {
+		matches := semver.FindAll(tt.text)
+
+		got := make([]string, 0, len(matches))
+		for _, m := range matches {
+			got = append(got, tt.text[m.Start:m.End])
+		}
+
+		if len(got) != len(tt.want) {
+			t.Errorf("FindAll(%q) = %q, want %q", tt.text, got, tt.want)
+
+			continue
+		}
+
+		for i := range got {
+			if got[i] != tt.want[i] {
+				t.Errorf("FindAll(%q) = %q, want %q", tt.text, got, tt.want)
+
+				break
+			}
+		}
+	}
+}
+
+func TestReplaceAll(t *testing.T) {
+	t.Parallel()
+
+	text := "image: tool:v1.2.3\nversion = \"1.2.3\"\nmin = 0.9.0\n"
+
+	got, n, err := semver.ReplaceAll(text, func(v *semver.Version) *semver.Version {
+		if v.Major != 1 {
+			return nil
+		}
+
+		return semver.MustParse("1.3.0")
+	})
+	if err != nil {
+		t.Fatalf("ReplaceAll() error = %v", err)
+	}
+
+	want := "image: tool:v1.3.0\nversion = \"1.3.0\"\nmin = 0.9.0\n"
+	if got != want || n != 2 {
+		t.Errorf("ReplaceAll() = %q, %d, want %q, 2", got, n, want)
+	}
+
+	got, n, err = semver.ReplaceAll(text, func(v *semver.Version) *semver.Version { return v })
+	if err != nil || got != text || n != 0 {
+		t.Errorf("ReplaceAll() with identity = %q, %d, %v, want %q, 0, nil", got, n, err, text)
+	}
+
+	_, _, err = semver.ReplaceAll(text, func(*semver.Version) *semver.Version {
+		return &semver.Version{
+			Major:      1,
+			Minor:      0,
+			Patch:      0,
+			Prerelease: nil,
+			Build:      semver.Build{""},
+		}
+	})
+	if !errors.Is(err, semver.ErrInvalidVersion) {
+		t.Errorf(
+			"ReplaceAll() with an invalid version error = %v, want %v",
+			err,
+			semver.ErrInvalidVersion,
+		)
+	}
+}
@@ -0,0 +1,90 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver
+
+import (
+	"cmp"
+	"maps"
+	"slices"
+	"strconv"
+)
+
+// A MinorSeries identifies the versions with the same major and minor
+// versions.
+type MinorSeries struct {
+	Major uint64
+	Minor uint64
+}
+
+// GroupByMajor groups the versions by their major version. The versions in
+// each group are sorted in increasing order.
+func GroupByMajor(versions Versions) map[uint64]Versions {
+	groups := make(map[uint64]Versions)
+
+	for _, v := range versions {
+		groups[v.Major] = append(groups[v.Major], v)
+	}
+
+	for _, g := range groups {
+		slices.SortStableFunc(g, Compare)
+	}
+
+	return groups
+}
+
+// GroupByMinor groups the versions by their major and minor versions.
+// The versions in each group are sorted in increasing order.
+func GroupByMinor(versions Versions) map[MinorSeries]Versions {
+	groups := make(map[MinorSeries]Versions)
+
+	for _, v := range versions {
+		s := MinorSeries{Major: v.Major, Minor: v.Minor}
+		groups[s] = append(groups[s], v)
+	}
+
+	for _, g := range groups {
+		slices.SortStableFunc(g, Compare)
+	}
+
+	return groups
+}
+
+// SortedMajors returns the keys of groups returned by [GroupByMajor] in
+// increasing order.
+func SortedMajors(groups map[uint64]Versions) []uint64 {
+	return slices.Sorted(maps.Keys(groups))
+}
+
+// SortedMinors returns the keys of groups returned by [GroupByMinor] in
+// increasing order.
+func SortedMinors(groups map[MinorSeries]Versions) []MinorSeries {
+	return slices.SortedFunc(maps.Keys(groups), func(a, b MinorSeries) int {
+		if d := cmp.Compare(a.Major, b.Major); d != 0 {
+			return d
+		}
+
+		return cmp.Compare(a.Minor, b.Minor)
+	})
+}
+
+// String returns the string representation of the series, for example "1.2".
+func (s MinorSeries) String() string {
+	return strconv.FormatUint(s.Major, 10) + "." + strconv.FormatUint(s.Minor, 10)
+}
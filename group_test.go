@@ -0,0 +1,72 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/anttikivi/semver"
+)
+
+func TestGroupByMajor(t *testing.T) {
+	t.Parallel()
+
+	var vs semver.Versions
+	for _, s := range []string{"2.1.0", "1.0.0", "2.0.0", "1.2.0-rc.1", "10.0.0", "1.1.5"} {
+		vs = append(vs, semver.MustParse(s))
+	}
+
+	groups := semver.GroupByMajor(vs)
+
+	if got, want := semver.SortedMajors(groups), []uint64{1, 2, 10}; !reflect.DeepEqual(got, want) {
+		t.Errorf("SortedMajors() = %v, want %v", got, want)
+	}
+
+	if got, want := versionStrings(groups[1]), []string{"1.0.0", "1.1.5", "1.2.0-rc.1"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("GroupByMajor()[1] = %v, want %v", got, want)
+	}
+}
+
+func TestGroupByMinor(t *testing.T) {
+	t.Parallel()
+
+	var vs semver.Versions
+	for _, s := range []string{"1.2.1", "2.0.0", "1.10.0", "1.2.0", "1.3.0"} {
+		vs = append(vs, semver.MustParse(s))
+	}
+
+	groups := semver.GroupByMinor(vs)
+
+	keys := semver.SortedMinors(groups)
+
+	got := make([]string, len(keys))
+	for i, k := range keys {
+		got[i] = k.String()
+	}
+
+	if want := []string{"1.2", "1.3", "1.10", "2.0"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("SortedMinors() = %v, want %v", got, want)
+	}
+
+	if got, want := versionStrings(groups[semver.MinorSeries{Major: 1, Minor: 2}]), []string{"1.2.0", "1.2.1"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("GroupByMinor()[1.2] = %v, want %v", got, want)
+	}
+}
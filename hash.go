@@ -0,0 +1,68 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver
+
+import (
+	"encoding/binary"
+	"hash/fnv"
+)
+
+// Hash returns a 64-bit hash of v. It is the same as Hash64 with the seed 0.
+func (v *Version) Hash() uint64 {
+	return v.Hash64(0)
+}
+
+// Hash64 returns a 64-bit hash of v with the given seed. The hash is
+// deterministic: it doesn't depend on the process, the platform, or the order
+// of the runs, so it can be used for consistent sharding in distributed
+// systems. The hash is consistent with [Version.Equal], i.e. the build
+// metadata doesn't affect it. Different seeds give independent hashes for
+// the same version.
+func (v *Version) Hash64(seed uint64) uint64 {
+	h := fnv.New64a()
+
+	var b [8]byte
+
+	binary.LittleEndian.PutUint64(b[:], seed)
+	_, _ = h.Write(b[:])
+	_, _ = h.Write([]byte(v.ComparableString()))
+
+	return mix64(h.Sum64())
+}
+
+// Hash32 returns a 32-bit hash of v with the given seed. It has the same
+// properties as [Version.Hash64].
+func (v *Version) Hash32(seed uint32) uint32 {
+	h := v.Hash64(uint64(seed))
+
+	return uint32(h ^ h>>32) //nolint:gosec,mnd // folding the halves of the hash
+}
+
+// mix64 is the finalizer of SplitMix64. It spreads the bits of the FNV hash,
+// which has weak avalanche for short inputs that differ only at the end.
+func mix64(h uint64) uint64 {
+	h ^= h >> 30 //nolint:mnd // SplitMix64 constants
+	h *= 0xbf58476d1ce4e5b9
+	h ^= h >> 27 //nolint:mnd // SplitMix64 constants
+	h *= 0x94d049bb133111eb
+	h ^= h >> 31 //nolint:mnd // SplitMix64 constants
+
+	return h
+}
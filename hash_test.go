@@ -0,0 +1,61 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver_test
+
+import (
+	"testing"
+
+	"github.com/anttikivi/semver"
+)
+
+func TestVersionHash(t *testing.T) {
+	t.Parallel()
+
+	v := semver.MustParse("1.2.3-rc.1+build.5")
+
+	// The hashes must stay the same across processes and releases because
+	// they may be used for sharding.
+	if got, want := v.Hash(), uint64(0x6d2db67aedac4b74); got != want {
+		t.Errorf("Hash() = %#x, want %#x", got, want)
+	}
+
+	if v.Hash() != semver.MustParse("1.2.3-rc.1").Hash() {
+		t.Error("build metadata changed the hash")
+	}
+
+	if v.Hash64(1) == v.Hash64(2) {
+		t.Error("Hash64() returned the same hash for different seeds")
+	}
+
+	if v.Hash32(7) != semver.MustParse("1.2.3-rc.1").Hash32(7) {
+		t.Error("Hash32() is not consistent with Equal")
+	}
+
+	seen := make(map[uint32]string)
+
+	for _, s := range []string{"1.0.0", "1.0.1", "1.1.0", "2.0.0", "1.0.0-0", "1.0.0-1"} {
+		h := semver.MustParse(s).Hash32(0)
+		if prev, ok := seen[h]; ok {
+			t.Errorf("Hash32() of %s collides with %s", s, prev)
+		}
+
+		seen[h] = s
+	}
+}
@@ -0,0 +1,123 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver
+
+import (
+	"container/heap"
+	"slices"
+)
+
+// Orders of a VersionHeap.
+const (
+	// MinFirst makes the version with the lowest precedence the top of
+	// a VersionHeap.
+	MinFirst HeapOrder = iota
+
+	// MaxFirst makes the version with the highest precedence the top of
+	// a VersionHeap.
+	MaxFirst
+)
+
+var _ heap.Interface = (*VersionHeap)(nil)
+
+// A HeapOrder is the order in which a VersionHeap returns its versions.
+type HeapOrder int
+
+// A VersionHeap is a priority queue of versions ordered by precedence. It
+// implements [heap.Interface], so it can be used with the functions of
+// container/heap, and the methods PushVersion, PopVersion, and Peek wrap them.
+// The order of equal versions is unspecified. The zero value is an empty
+// heap in the order MinFirst.
+type VersionHeap struct {
+	x     Versions
+	order HeapOrder
+}
+
+// NewVersionHeap returns a VersionHeap in the given order with the given
+// versions. The slice of the versions is copied, but the versions are shared
+// with the caller and must not be modified while they are in the heap.
+func NewVersionHeap(order HeapOrder, versions ...*Version) *VersionHeap {
+	h := &VersionHeap{x: slices.Clone(versions), order: order}
+	heap.Init(h)
+
+	return h
+}
+
+// Len is the number of versions in h.
+func (h *VersionHeap) Len() int {
+	return len(h.x)
+}
+
+// Less reports whether the version with index i must be closer to the top of
+// the heap than the one with index j.
+func (h *VersionHeap) Less(i, j int) bool {
+	if h.order == MaxFirst {
+		return h.x[i].Compare(h.x[j]) > 0
+	}
+
+	return h.x[i].Compare(h.x[j]) < 0
+}
+
+// Swap swaps the versions with indexes i and j.
+func (h *VersionHeap) Swap(i, j int) {
+	h.x[i], h.x[j] = h.x[j], h.x[i]
+}
+
+// Push adds x, which must be a *Version, to the end of h. It is meant to be
+// called by [heap.Push]; use [VersionHeap.PushVersion] instead.
+func (h *VersionHeap) Push(x any) {
+	h.x = append(h.x, x.(*Version)) //nolint:forcetypeassert // required by heap.Interface
+}
+
+// Pop removes and returns the last version in h. It is meant to be called by
+// [heap.Pop]; use [VersionHeap.PopVersion] instead.
+func (h *VersionHeap) Pop() any {
+	n := len(h.x)
+	v := h.x[n-1]
+	h.x[n-1] = nil
+	h.x = h.x[:n-1]
+
+	return v
+}
+
+// PushVersion adds v to h.
+func (h *VersionHeap) PushVersion(v *Version) {
+	heap.Push(h, v)
+}
+
+// PopVersion removes and returns the version at the top of h. It returns nil
+// if h is empty.
+func (h *VersionHeap) PopVersion() *Version {
+	if len(h.x) == 0 {
+		return nil
+	}
+
+	return heap.Pop(h).(*Version) //nolint:forcetypeassert // the heap has only versions
+}
+
+// Peek returns the version at the top of h without removing it. It returns
+// nil if h is empty.
+func (h *VersionHeap) Peek() *Version {
+	if len(h.x) == 0 {
+		return nil
+	}
+
+	return h.x[0]
+}
@@ -0,0 +1,82 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver_test
+
+import (
+	"container/heap"
+	"slices"
+	"testing"
+
+	"github.com/anttikivi/semver"
+)
+
+func TestVersionHeap(t *testing.T) {
+	t.Parallel()
+
+	input := []string{"1.2.0", "0.9.0", "2.0.0-rc.1", "2.0.0", "1.10.0"}
+
+	tests := []struct {
+		order semver.HeapOrder
+		want  []string
+	}{
+		{semver.MinFirst, []string{"0.1.0", "0.9.0", "1.2.0", "1.10.0", "2.0.0-rc.1", "2.0.0"}},
+		{semver.MaxFirst, []string{"2.0.0", "2.0.0-rc.1", "1.10.0", "1.2.0", "0.9.0", "0.1.0"}},
+	}
+
+	for _, tt := range tests {
+		versions := make(semver.Versions, 0, len(input))
+		for _, s := range input {
+			versions = append(versions, semver.MustParse(s))
+		}
+
+		h := semver.NewVersionHeap(tt.order, versions...)
+		h.PushVersion(semver.MustParse("0.1.0"))
+
+		if top := h.Peek(); top.String() != tt.want[0] {
+			t.Errorf("Peek() = %s, want %s", top, tt.want[0])
+		}
+
+		var got []string
+		for h.Len() > 0 {
+			got = append(got, h.PopVersion().String())
+		}
+
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("popped %q, want %q", got, tt.want)
+		}
+
+		if h.PopVersion() != nil || h.Peek() != nil {
+			t.Error("empty heap returned a version")
+		}
+	}
+}
+
+func TestVersionHeapContainerHeap(t *testing.T) {
+	t.Parallel()
+
+	var h semver.VersionHeap
+
+	heap.Push(&h, semver.MustParse("1.1.0"))
+	heap.Push(&h, semver.MustParse("1.0.0"))
+
+	if v, ok := heap.Pop(&h).(*semver.Version); !ok || v.String() != "1.0.0" {
+		t.Errorf("heap.Pop() = %v, want 1.0.0", v)
+	}
+}
@@ -0,0 +1,120 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver
+
+import "sync"
+
+// An Interner parses versions and shares the parsed versions between equal
+// version strings, which reduces the memory use of programs that store
+// large numbers of versions with the same few values. The versions returned by
+// an Interner are shared and must not be modified; use [Version.Clone] for
+// getting a copy that can be modified. An Interner is safe for concurrent use
+// by multiple goroutines.
+type Interner struct {
+	versions  map[internKey]*Version
+	canonical map[string]*Version
+	max       int
+	mu        sync.RWMutex
+}
+
+// internKey is the key of a parsed version string in an Interner.
+type internKey struct {
+	s   string
+	lax bool
+}
+
+// NewInterner returns a new Interner that stores at most maxSize different
+// versions. After the limit is reached, new versions are parsed without
+// interning them. If maxSize is not positive, the number of versions is not
+// limited.
+func NewInterner(maxSize int) *Interner {
+	return &Interner{
+		versions:  make(map[internKey]*Version),
+		canonical: make(map[string]*Version),
+		max:       maxSize,
+		mu:        sync.RWMutex{},
+	}
+}
+
+// Parse parses the given string into a Version like [Parse]. If an equal
+// version has been parsed before, the shared version is returned.
+func (in *Interner) Parse(s string) (*Version, error) {
+	return in.parse(s, false)
+}
+
+// ParseLax parses the given string into a Version like [ParseLax]. If an equal
+// version has been parsed before, the shared version is returned.
+func (in *Interner) ParseLax(s string) (*Version, error) {
+	return in.parse(s, true)
+}
+
+// Len returns the number of different versions in the Interner.
+func (in *Interner) Len() int {
+	in.mu.RLock()
+	defer in.mu.RUnlock()
+
+	return len(in.canonical)
+}
+
+func (in *Interner) parse(s string, lax bool) (*Version, error) {
+	key := internKey{s: s, lax: lax}
+
+	in.mu.RLock()
+	v, ok := in.versions[key]
+	in.mu.RUnlock()
+
+	if ok {
+		return v, nil
+	}
+
+	var err error
+
+	if lax {
+		v, err = ParseLax(s)
+	} else {
+		v, err = Parse(s)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	in.mu.Lock()
+	defer in.mu.Unlock()
+
+	// The build metadata is part of the canonical form so that the shared
+	// version is strictly equal to the parsed one.
+	c := v.String()
+
+	if shared, ok := in.canonical[c]; ok {
+		in.versions[key] = shared
+
+		return shared, nil
+	}
+
+	if in.max > 0 && len(in.canonical) >= in.max {
+		return v, nil
+	}
+
+	in.canonical[c] = v
+	in.versions[key] = v
+
+	return v, nil
+}
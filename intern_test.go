@@ -0,0 +1,104 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/anttikivi/semver"
+)
+
+func TestInterner(t *testing.T) {
+	t.Parallel()
+
+	in := semver.NewInterner(0)
+
+	a, err := in.Parse("1.0.0")
+	if err != nil {
+		t.Fatalf("Interner.Parse(\"1.0.0\") failed unexpectedly: %v", err)
+	}
+
+	b, _ := in.Parse("v1.0.0")
+	c, _ := in.ParseLax("1")
+	d, _ := in.Parse("1.0.0+build")
+
+	if a != b || a != c {
+		t.Error("Interner returned different versions for equal version strings")
+	}
+
+	if a == d || d.String() != "1.0.0+build" {
+		t.Errorf("Interner.Parse(\"1.0.0+build\") = %q, want a separate version", d)
+	}
+
+	if _, err := in.Parse("1"); err == nil {
+		t.Error("Interner.Parse(\"1\") succeeded unexpectedly")
+	}
+
+	if got := in.Len(); got != 2 {
+		t.Errorf("Interner.Len() = %d, want 2", got)
+	}
+}
+
+func TestInternerLimit(t *testing.T) {
+	t.Parallel()
+
+	in := semver.NewInterner(1)
+
+	a, _ := in.Parse("1.0.0")
+	b, _ := in.Parse("2.0.0")
+	c, _ := in.Parse("2.0.0")
+
+	if b == c || b.String() != "2.0.0" {
+		t.Error("Interner interned a version after reaching the limit")
+	}
+
+	if d, _ := in.Parse("1.0.0"); a != d {
+		t.Error("Interner didn't share the interned version")
+	}
+}
+
+func TestInternerConcurrent(t *testing.T) {
+	t.Parallel()
+
+	in := semver.NewInterner(0)
+
+	var wg sync.WaitGroup
+
+	results := make([]*semver.Version, 16)
+
+	for i := range results {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			results[i], _ = in.Parse("1.2.3")
+		}()
+	}
+
+	wg.Wait()
+
+	for _, v := range results {
+		if v != results[0] {
+			t.Fatal("Interner returned different versions for concurrent calls")
+		}
+	}
+}
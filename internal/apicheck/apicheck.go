@@ -0,0 +1,527 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+// Package apicheck lists the exported API of the packages of the module as
+// a manifest and compares the manifests. It is used by the apicheck command
+// and by the test that checks that the exported API doesn't change
+// incompatibly.
+//
+// A manifest has one line for each exported symbol, for example:
+//
+//	pkg github.com/anttikivi/semver, func Parse(string) (*Version, error)
+//	pkg github.com/anttikivi/semver, method (*Version) String() string
+//	pkg github.com/anttikivi/semver, type Version struct, Major uint64
+//
+// The lines are sorted, so the manifest is deterministic.
+package apicheck
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+	"unicode"
+)
+
+// Header is the first line of a manifest file.
+const Header = "# Code generated by cmd/apicheck; DO NOT EDIT."
+
+// ErrNoModule is returned when the root directory has no go.mod file.
+var ErrNoModule = errors.New("no module in directory")
+
+// Generate returns the manifest of the exported API of the packages of
+// the module in the directory root. The command packages, the internal
+// packages, the test files, and the nested modules are skipped.
+func Generate(root string) ([]string, error) {
+	module, err := modulePath(root)
+	if err != nil {
+		return nil, err
+	}
+
+	var lines []string
+
+	err = filepath.WalkDir(root, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if !d.IsDir() {
+			return nil
+		}
+
+		if p != root {
+			name := d.Name()
+			if name == "testdata" || name == "internal" || name == "cmd" ||
+				strings.HasPrefix(name, ".") || strings.HasPrefix(name, "_") {
+				return filepath.SkipDir
+			}
+
+			if _, err := os.Stat(filepath.Join(p, "go.mod")); err == nil {
+				return filepath.SkipDir
+			}
+		}
+
+		rel, err := filepath.Rel(root, p)
+		if err != nil {
+			return fmt.Errorf("failed to resolve package path: %w", err)
+		}
+
+		pkgLines, err := packageAPI(p, path.Join(module, filepath.ToSlash(rel)))
+		if err != nil {
+			return err
+		}
+
+		lines = append(lines, pkgLines...)
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate API manifest: %w", err)
+	}
+
+	slices.Sort(lines)
+
+	return slices.Compact(lines), nil
+}
+
+// Read reads the manifest file at p.
+func Read(p string) ([]string, error) {
+	data, err := os.ReadFile(p)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read API manifest: %w", err)
+	}
+
+	var lines []string
+
+	for line := range strings.SplitSeq(string(data), "\n") {
+		if line != "" && !strings.HasPrefix(line, "#") {
+			lines = append(lines, line)
+		}
+	}
+
+	return lines, nil
+}
+
+// Write writes the manifest lines to the file at p.
+func Write(p string, lines []string) error {
+	var buf bytes.Buffer
+
+	buf.WriteString(Header + "\n")
+
+	for _, line := range lines {
+		buf.WriteString(line + "\n")
+	}
+
+	if err := os.WriteFile(p, buf.Bytes(), 0o644); err != nil { //nolint:gosec // not a secret
+		return fmt.Errorf("failed to write API manifest: %w", err)
+	}
+
+	return nil
+}
+
+// Compare compares the manifest want to got. The removed lines are the
+// incompatible changes: they are the symbols that were removed or whose
+// signatures changed. The added lines are the new symbols and the new
+// signatures of the changed ones.
+func Compare(want, got []string) ([]string, []string) {
+	var removed, added []string
+
+	for _, line := range want {
+		if _, ok := slices.BinarySearch(got, line); !ok {
+			removed = append(removed, line)
+		}
+	}
+
+	for _, line := range got {
+		if _, ok := slices.BinarySearch(want, line); !ok {
+			added = append(added, line)
+		}
+	}
+
+	return removed, added
+}
+
+// Symbol returns the import path of the package and the name of the symbol
+// that the manifest line describes. The names of the methods, the fields, and
+// the embedded types are qualified by the name of their type, like
+// "Version.String". The returned bool is false if the line doesn't describe
+// a symbol, like the lines for the unexported methods of interfaces.
+func Symbol(line string) (string, string, bool) {
+	rest, ok := strings.CutPrefix(line, "pkg ")
+	if !ok {
+		return "", "", false
+	}
+
+	pkg, decl, ok := strings.Cut(rest, ", ")
+	if !ok {
+		return "", "", false
+	}
+
+	kind, decl, _ := strings.Cut(decl, " ")
+
+	switch kind {
+	case "const", "var", "func":
+		return pkg, identifier(decl), true
+	case "method":
+		recv, rest, ok := strings.Cut(strings.TrimPrefix(decl, "("), ") ")
+		if !ok {
+			return "", "", false
+		}
+
+		return pkg, identifier(strings.TrimPrefix(recv, "*")) + "." + identifier(rest), true
+	case "type":
+		name := identifier(decl)
+		rest := skipTypeParams(decl[len(name):])
+
+		_, member, ok := strings.Cut(rest, ", ")
+		if !ok {
+			return pkg, name, true
+		}
+
+		if member == "unexported methods" {
+			return "", "", false
+		}
+
+		if embedded, ok := strings.CutPrefix(member, "embedded "); ok {
+			embedded = strings.TrimPrefix(embedded, "*")
+			member = embedded[strings.LastIndexByte(embedded, '.')+1:]
+		}
+
+		return pkg, name + "." + identifier(member), true
+	default:
+		return "", "", false
+	}
+}
+
+// modulePath reads the module path from the go.mod file in root.
+func modulePath(root string) (string, error) {
+	f, err := os.Open(filepath.Join(root, "go.mod"))
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", ErrNoModule, err)
+	}
+	defer f.Close()
+
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		if p, ok := strings.CutPrefix(strings.TrimSpace(sc.Text()), "module "); ok {
+			return strings.Trim(strings.TrimSpace(p), `"`), nil
+		}
+	}
+
+	if err := sc.Err(); err != nil {
+		return "", fmt.Errorf("failed to read go.mod: %w", err)
+	}
+
+	return "", fmt.Errorf("%w: no module directive in %s", ErrNoModule, root)
+}
+
+// packageAPI returns the manifest lines of the package in dir.
+func packageAPI(dir, importPath string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read package directory: %w", err)
+	}
+
+	fset := token.NewFileSet()
+	w := &writer{fset: fset, prefix: "pkg " + importPath + ", ", lines: nil}
+
+	for _, e := range entries {
+		name := e.Name()
+		if e.IsDir() || !strings.HasSuffix(name, ".go") || strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+
+		f, err := parser.ParseFile(fset, filepath.Join(dir, name), nil, parser.SkipObjectResolution)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", name, err)
+		}
+
+		if f.Name.Name == "main" {
+			return nil, nil
+		}
+
+		for _, decl := range f.Decls {
+			w.decl(decl)
+		}
+	}
+
+	return w.lines, nil
+}
+
+// writer collects the manifest lines of a package.
+type writer struct {
+	fset   *token.FileSet
+	prefix string
+	lines  []string
+}
+
+// emit adds a manifest line.
+func (w *writer) emit(format string, args ...any) {
+	w.lines = append(w.lines, w.prefix+fmt.Sprintf(format, args...))
+}
+
+// decl adds the manifest lines of the exported symbols in d.
+func (w *writer) decl(d ast.Decl) {
+	switch d := d.(type) {
+	case *ast.FuncDecl:
+		if !d.Name.IsExported() {
+			return
+		}
+
+		if d.Recv == nil {
+			w.emit("func %s%s%s", d.Name.Name, w.typeParams(d.Type.TypeParams), w.signature(d.Type))
+
+			return
+		}
+
+		recv := d.Recv.List[0].Type
+		if !ast.IsExported(receiverName(recv)) {
+			return
+		}
+
+		w.emit("method (%s) %s%s", w.expr(recv), d.Name.Name, w.signature(d.Type))
+	case *ast.GenDecl:
+		var lastType ast.Expr
+
+		for _, spec := range d.Specs {
+			switch s := spec.(type) {
+			case *ast.TypeSpec:
+				w.typeSpec(s)
+			case *ast.ValueSpec:
+				// The constants without a type and values repeat the previous
+				// specification, like the ones using iota.
+				if s.Type != nil || len(s.Values) > 0 {
+					lastType = s.Type
+				}
+
+				w.valueSpec(d.Tok, s, lastType)
+			}
+		}
+	}
+}
+
+// typeSpec adds the manifest lines of the exported type s.
+func (w *writer) typeSpec(s *ast.TypeSpec) {
+	if !s.Name.IsExported() {
+		return
+	}
+
+	name := s.Name.Name + w.typeParams(s.TypeParams)
+
+	switch t := s.Type.(type) {
+	case *ast.StructType:
+		w.emit("type %s struct", name)
+
+		for _, f := range t.Fields.List {
+			if len(f.Names) == 0 {
+				if ast.IsExported(receiverName(f.Type)) {
+					w.emit("type %s struct, embedded %s", name, w.expr(f.Type))
+				}
+
+				continue
+			}
+
+			for _, n := range f.Names {
+				if n.IsExported() {
+					w.emit("type %s struct, %s %s", name, n.Name, w.expr(f.Type))
+				}
+			}
+		}
+	case *ast.InterfaceType:
+		w.emit("type %s interface", name)
+
+		for _, m := range t.Methods.List {
+			if len(m.Names) == 0 {
+				w.emit("type %s interface, embedded %s", name, w.expr(m.Type))
+
+				continue
+			}
+
+			for _, n := range m.Names {
+				ft, ok := m.Type.(*ast.FuncType)
+				if !ok {
+					continue
+				}
+
+				if n.IsExported() {
+					w.emit("type %s interface, %s%s", name, n.Name, w.signature(ft))
+				} else {
+					w.emit("type %s interface, unexported methods", name)
+				}
+			}
+		}
+	default:
+		if s.Assign.IsValid() {
+			w.emit("type %s = %s", name, w.expr(s.Type))
+		} else {
+			w.emit("type %s %s", name, w.expr(s.Type))
+		}
+	}
+}
+
+// valueSpec adds the manifest lines of the exported constants or variables in
+// s. The type is typ if it is not nil.
+func (w *writer) valueSpec(tok token.Token, s *ast.ValueSpec, typ ast.Expr) {
+	for _, n := range s.Names {
+		if !n.IsExported() {
+			continue
+		}
+
+		if typ == nil {
+			w.emit("%s %s", tok, n.Name)
+		} else {
+			w.emit("%s %s %s", tok, n.Name, w.expr(typ))
+		}
+	}
+}
+
+// signature returns the parameters and the results of t without their names.
+func (w *writer) signature(t *ast.FuncType) string {
+	s := w.expr(&ast.FuncType{
+		Func:       token.NoPos,
+		TypeParams: nil,
+		Params:     stripNames(t.Params),
+		Results:    stripNames(t.Results),
+	})
+
+	return strings.TrimPrefix(s, "func")
+}
+
+// typeParams returns the type parameters in list, including their names, or
+// an empty string if there are none.
+func (w *writer) typeParams(list *ast.FieldList) string {
+	if list == nil || len(list.List) == 0 {
+		return ""
+	}
+
+	params := make([]string, 0, len(list.List))
+
+	for _, f := range list.List {
+		names := make([]string, len(f.Names))
+		for i, n := range f.Names {
+			names[i] = n.Name
+		}
+
+		params = append(params, strings.Join(names, ", ")+" "+w.expr(f.Type))
+	}
+
+	return "[" + strings.Join(params, ", ") + "]"
+}
+
+// expr returns the source code of e on a single line.
+func (w *writer) expr(e ast.Expr) string {
+	var buf bytes.Buffer
+
+	if err := printer.Fprint(&buf, w.fset, e); err != nil {
+		return fmt.Sprintf("<%v>", err)
+	}
+
+	// The multiline parameter lists are joined to a single line.
+	s := strings.Join(strings.Fields(buf.String()), " ")
+
+	return strings.ReplaceAll(s, ", )", ")")
+}
+
+// stripNames returns list with one field for each name and without the names.
+func stripNames(list *ast.FieldList) *ast.FieldList {
+	if list == nil {
+		return nil
+	}
+
+	stripped := &ast.FieldList{Opening: token.NoPos, List: nil, Closing: token.NoPos}
+
+	for _, f := range list.List {
+		n := max(len(f.Names), 1)
+		for range n {
+			stripped.List = append(stripped.List, &ast.Field{
+				Doc:     nil,
+				Names:   nil,
+				Type:    f.Type,
+				Tag:     nil,
+				Comment: nil,
+			})
+		}
+	}
+
+	return stripped
+}
+
+// receiverName returns the name of the type in the receiver or embedded field
+// type e.
+func receiverName(e ast.Expr) string {
+	switch t := e.(type) {
+	case *ast.StarExpr:
+		return receiverName(t.X)
+	case *ast.IndexExpr:
+		return receiverName(t.X)
+	case *ast.IndexListExpr:
+		return receiverName(t.X)
+	case *ast.SelectorExpr:
+		return t.Sel.Name
+	case *ast.Ident:
+		return t.Name
+	default:
+		return ""
+	}
+}
+
+// identifier returns the identifier at the start of s.
+func identifier(s string) string {
+	i := strings.IndexFunc(s, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_'
+	})
+	if i < 0 {
+		return s
+	}
+
+	return s[:i]
+}
+
+// skipTypeParams returns s without the type parameter list at its start.
+func skipTypeParams(s string) string {
+	if !strings.HasPrefix(s, "[") {
+		return s
+	}
+
+	depth := 0
+
+	for i := range len(s) {
+		switch s[i] {
+		case '[':
+			depth++
+		case ']':
+			depth--
+			if depth == 0 {
+				return s[i+1:]
+			}
+		}
+	}
+
+	return ""
+}
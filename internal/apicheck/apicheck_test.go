@@ -0,0 +1,116 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package apicheck_test
+
+import (
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/anttikivi/semver/internal/apicheck"
+)
+
+func TestGenerate(t *testing.T) {
+	t.Parallel()
+
+	got, err := apicheck.Generate(filepath.Join("testdata", "example"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{
+		"pkg example.com/m, const A Kind",
+		"pkg example.com/m, const B Kind",
+		"pkg example.com/m, const D",
+		"pkg example.com/m, func New[K comparable, V any](K, V) *Pair[K, V]",
+		"pkg example.com/m, method (*Pair[K, V]) Swap(int, int) (int, int)",
+		"pkg example.com/m, method (Kind) String() string",
+		"pkg example.com/m, type Kind int",
+		"pkg example.com/m, type Pair[K comparable, V any] struct",
+		"pkg example.com/m, type Pair[K comparable, V any] struct, Key K",
+		"pkg example.com/m, type Pair[K comparable, V any] struct, Value V",
+		"pkg example.com/m, type Reader interface",
+		"pkg example.com/m, type Reader interface, Peek(int) ([]byte, error)",
+		"pkg example.com/m, type Reader interface, embedded io.Reader",
+		"pkg example.com/m, var ErrX",
+		"pkg example.com/m/sub, type Alias = map[string]int",
+	}
+
+	if !slices.Equal(got, want) {
+		t.Errorf("Generate() = %q, want %q", got, want)
+	}
+}
+
+func TestCompare(t *testing.T) {
+	t.Parallel()
+
+	want := []string{"pkg m, func A()", "pkg m, func B() int"}
+	got := []string{"pkg m, func A()", "pkg m, func B() string", "pkg m, func C()"}
+
+	removed, added := apicheck.Compare(want, got)
+	if !slices.Equal(removed, []string{"pkg m, func B() int"}) {
+		t.Errorf("Compare() removed = %q", removed)
+	}
+
+	if !slices.Equal(added, []string{"pkg m, func B() string", "pkg m, func C()"}) {
+		t.Errorf("Compare() added = %q", added)
+	}
+}
+
+func TestSymbol(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		line string
+		pkg  string
+		name string
+		ok   bool
+	}{
+		{"pkg m, const A Kind", "m", "A", true},
+		{"pkg m, var ErrX", "m", "ErrX", true},
+		{"pkg m, func New[K comparable, V any](K, V) *Pair[K, V]", "m", "New", true},
+		{"pkg m, method (*Pair[K, V]) Swap(int, int) (int, int)", "m", "Pair.Swap", true},
+		{"pkg m, method (Kind) String() string", "m", "Kind.String", true},
+		{"pkg m, type Pair[K comparable, V any] struct", "m", "Pair", true},
+		{"pkg m, type Pair[K comparable, V any] struct, Key K", "m", "Pair.Key", true},
+		{"pkg m, type Reader interface, Peek(int) ([]byte, error)", "m", "Reader.Peek", true},
+		{"pkg m, type Reader interface, embedded io.Reader", "m", "Reader.Reader", true},
+		{"pkg m, type V struct, embedded *Version", "m", "V.Version", true},
+		{"pkg m/sub, type Alias = map[string]int", "m/sub", "Alias", true},
+		{"pkg m, type Reader interface, unexported methods", "", "", false},
+		{apicheck.Header, "", "", false},
+	}
+
+	for _, tt := range tests {
+		pkg, name, ok := apicheck.Symbol(tt.line)
+		if pkg != tt.pkg || name != tt.name || ok != tt.ok {
+			t.Errorf(
+				"Symbol(%q) = %q, %q, %v, want %q, %q, %v",
+				tt.line,
+				pkg,
+				name,
+				ok,
+				tt.pkg,
+				tt.name,
+				tt.ok,
+			)
+		}
+	}
+}
@@ -0,0 +1,57 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package m
+
+import "io"
+
+const (
+	A Kind = iota
+	B
+	c
+)
+
+const D = "d"
+
+var ErrX = io.EOF
+
+type Kind int
+
+type Pair[K comparable, V any] struct {
+	Key   K
+	Value V
+	n     int
+}
+
+type Reader interface {
+	io.Reader
+	Peek(n int) ([]byte, error)
+}
+
+func New[K comparable, V any](k K, v V) *Pair[K, V] { return nil }
+
+func (p *Pair[K, V]) Swap(
+	a, b int,
+) (x, y int) {
+	return b, a
+}
+
+func (k Kind) String() string { return "" }
+
+func hidden() {}
@@ -0,0 +1,205 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Kinds of warnings that [LintConstraint] reports.
+const (
+	// LintInvalid is a warning for a constraint that cannot be parsed.
+	LintInvalid WarningKind = iota + 1
+
+	// LintEmptyRange is a warning for a range that no version satisfies, like
+	// ">2.0.0 <1.0.0".
+	LintEmptyRange
+
+	// LintExactCaret is a warning for a caret range that allows only a single
+	// version, like "^0.0.3".
+	LintExactCaret
+
+	// LintPrerelease is a warning for a comparator with a pre-release version,
+	// as the pre-release versions are usually not meant for production.
+	LintPrerelease
+
+	// LintRedundantRange is a warning for a range that allows only versions
+	// that another range of the constraint already allows.
+	LintRedundantRange
+
+	// LintUnbounded is a warning for a range without an upper bound that
+	// allows any future major version, and thus breaking changes.
+	LintUnbounded
+)
+
+// A WarningKind is the kind of a warning reported by [LintConstraint].
+type WarningKind int
+
+// A Warning is a suspicious pattern in a version constraint.
+type Warning struct {
+	// Kind is the kind of the warning.
+	Kind WarningKind
+
+	// Range is the range of the constraint the warning is about, or the whole
+	// constraint if the warning is not about a single range.
+	Range string
+
+	// Message is the description of the warning.
+	Message string
+}
+
+// LintConstraint checks the constraint expression expr for suspicious
+// patterns, for example, ranges that no version satisfies or caret ranges that
+// allow only a single version. It returns the warnings for the ranges of
+// the constraint in the order of the ranges. If expr cannot be parsed,
+// the only warning is a LintInvalid warning. A constraint without warnings
+// returns nil.
+func LintConstraint(expr string) []Warning {
+	if _, err := ParseConstraint(expr); err != nil {
+		return []Warning{{Kind: LintInvalid, Range: strings.TrimSpace(expr), Message: err.Error()}}
+	}
+
+	parts := strings.Split(expr, "||")
+	ranges := make([]*Constraint, len(parts))
+
+	for i, part := range parts {
+		parts[i] = strings.TrimSpace(part)
+		ranges[i] = MustParseConstraint(parts[i])
+	}
+
+	var warnings []Warning
+
+	for i, r := range ranges {
+		warnings = append(warnings, lintTokens(parts[i])...)
+
+		intervals := r.Intervals()
+		if len(intervals) == 0 {
+			warnings = append(warnings, Warning{
+				Kind:    LintEmptyRange,
+				Range:   parts[i],
+				Message: fmt.Sprintf("range %q is never satisfied", parts[i]),
+			})
+
+			continue
+		}
+
+		if intervals[len(intervals)-1].Upper.Version == nil {
+			warnings = append(warnings, Warning{
+				Kind:  LintUnbounded,
+				Range: parts[i],
+				Message: fmt.Sprintf(
+					"range %q has no upper bound and allows any future major version",
+					parts[i],
+				),
+			})
+		}
+
+		for j, o := range ranges {
+			// Of the ranges that are equal, only the later ones are redundant.
+			if j == i || !o.Subsumes(r) || (j > i && r.Subsumes(o)) || len(o.Intervals()) == 0 {
+				continue
+			}
+
+			warnings = append(warnings, Warning{
+				Kind:  LintRedundantRange,
+				Range: parts[i],
+				Message: fmt.Sprintf(
+					"range %q is redundant because %q includes it",
+					parts[i],
+					parts[j],
+				),
+			})
+
+			break
+		}
+	}
+
+	return warnings
+}
+
+// String returns the message of the warning.
+func (w Warning) String() string {
+	return w.Message
+}
+
+// String returns the name of the warning kind.
+func (k WarningKind) String() string {
+	switch k {
+	case LintInvalid:
+		return "invalid"
+	case LintEmptyRange:
+		return "empty range"
+	case LintExactCaret:
+		return "exact caret"
+	case LintPrerelease:
+		return "pre-release"
+	case LintRedundantRange:
+		return "redundant range"
+	case LintUnbounded:
+		return "unbounded"
+	default:
+		return fmt.Sprintf("WarningKind(%d)", int(k))
+	}
+}
+
+// lintTokens returns the warnings for the comparators in the range r.
+func lintTokens(r string) []Warning {
+	var warnings []Warning
+
+	fields := strings.FieldsFunc(r, func(c rune) bool {
+		return c == ' ' || c == '\t' || c == ','
+	})
+
+	for i := 0; i < len(fields); i++ {
+		tok := fields[i]
+		if isOperator(tok) && i+1 < len(fields) {
+			tok += fields[i+1]
+			i++
+		}
+
+		p, err := parsePartialVersion(strings.TrimLeft(tok, "<>=!~^"))
+		if err != nil {
+			continue
+		}
+
+		if strings.HasPrefix(tok, "^") && p.n == 3 && p.major == 0 && p.minor == 0 {
+			warnings = append(warnings, Warning{
+				Kind:    LintExactCaret,
+				Range:   r,
+				Message: fmt.Sprintf("%s allows nothing but %s", tok, p.full()),
+			})
+		}
+
+		if len(p.prerelease) > 0 {
+			warnings = append(warnings, Warning{
+				Kind:  LintPrerelease,
+				Range: r,
+				Message: fmt.Sprintf(
+					"%s refers to the pre-release %s that is not meant for production",
+					tok,
+					p.full(),
+				),
+			})
+		}
+	}
+
+	return warnings
+}
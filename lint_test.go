@@ -0,0 +1,87 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/anttikivi/semver"
+)
+
+func TestLintConstraint(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		expr string
+		want []semver.WarningKind
+	}{
+		{"^1.2.3", nil},
+		{"~1.2.3 || ^2.0.0", nil},
+		{"^0.0.3", []semver.WarningKind{semver.LintExactCaret}},
+		{">2.0.0 <1.0.0", []semver.WarningKind{semver.LintEmptyRange}},
+		{"^1.2.3-beta.1", []semver.WarningKind{semver.LintPrerelease}},
+		{">=1.2.0", []semver.WarningKind{semver.LintUnbounded}},
+		{"^1.0.0 || ~1.2.0", []semver.WarningKind{semver.LintRedundantRange}},
+		{"^1.0.0 || ^1.0.0", []semver.WarningKind{semver.LintRedundantRange}},
+		{"1.2.3 - 2.0.0", nil},
+		{"^1.0.0 ||", []semver.WarningKind{semver.LintInvalid}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			t.Parallel()
+
+			warnings := semver.LintConstraint(tt.expr)
+
+			var got []semver.WarningKind
+			for _, w := range warnings {
+				got = append(got, w.Kind)
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("LintConstraint(%q) = %v, want %v", tt.expr, warnings, tt.want)
+			}
+		})
+	}
+}
+
+func TestLintConstraintMessages(t *testing.T) {
+	t.Parallel()
+
+	warnings := semver.LintConstraint("^0.0.3 || ^1.0.0 || ~1.2.0")
+	want := []string{
+		"^0.0.3 allows nothing but 0.0.3",
+		`range "~1.2.0" is redundant because "^1.0.0" includes it`,
+	}
+
+	got := make([]string, len(warnings))
+	for i, w := range warnings {
+		got[i] = w.String()
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("LintConstraint() = %q, want %q", got, want)
+	}
+
+	if warnings[1].Range != "~1.2.0" || warnings[1].Kind.String() != "redundant range" {
+		t.Errorf("LintConstraint()[1] = %+v", warnings[1])
+	}
+}
@@ -0,0 +1,397 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+// Package lockfile reads and writes "versions.lock" files that pin names, like
+// the tools or the dependencies of a project, to versions. The format is
+// a small subset of TOML with a table for each name:
+//
+//	["github.com/anttikivi/semver"]
+//	version = "1.2.3"
+//	constraint = "^1.2.0"
+//
+// The version is required and the constraint, that the version must satisfy,
+// is optional. Comments start with "#" and continue to the end of the line.
+package lockfile
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/anttikivi/semver"
+)
+
+// FileName is the conventional name of the lock files.
+const FileName = "versions.lock"
+
+var (
+	// ErrSyntax is returned when a lock file is not valid.
+	ErrSyntax = errors.New("invalid lock file syntax")
+
+	// ErrConflict is returned when the pins in a lock file conflict.
+	ErrConflict = errors.New("conflicting pins")
+)
+
+// A Lock is the contents of a lock file.
+type Lock struct {
+	// Entries are the pins in the lock file by their names.
+	Entries map[string]Entry
+}
+
+// An Entry is a name pinned to a version.
+type Entry struct {
+	// Version is the pinned version.
+	Version *semver.Version
+
+	// Constraint is the constraint the version must satisfy. It may be nil.
+	Constraint *semver.Constraint
+}
+
+// A Conflict is an error that describes a conflicting pin.
+type Conflict struct {
+	// Name is the name of the pin.
+	Name string
+
+	// Version is the pinned version.
+	Version *semver.Version
+
+	// Other is the other version the name is pinned to. It is nil if
+	// the conflict is between Version and Constraint.
+	Other *semver.Version
+
+	// Constraint is the constraint Version doesn't satisfy. It is nil if
+	// the conflict is between Version and Other.
+	Constraint *semver.Constraint
+}
+
+// New returns a new empty Lock.
+func New() *Lock {
+	return &Lock{Entries: make(map[string]Entry)}
+}
+
+// Load reads the lock file at the given path.
+func Load(path string) (*Lock, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open lock file: %w", err)
+	}
+	defer f.Close()
+
+	l, err := Read(f)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+
+	return l, nil
+}
+
+// Read reads a lock file from r. It returns an error if the file is not valid
+// or if a name is pinned more than once. It doesn't check whether the versions
+// satisfy their constraints, use [Lock.Check] for that.
+func Read(r io.Reader) (*Lock, error) {
+	l := New()
+
+	var (
+		name    string
+		entry   Entry
+		started bool
+		line    int
+	)
+
+	flush := func() error {
+		if !started {
+			return nil
+		}
+
+		if entry.Version == nil {
+			return fmt.Errorf("%w: no version for %q", ErrSyntax, name)
+		}
+
+		l.Entries[name] = entry
+
+		return nil
+	}
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line++
+
+		s := strings.TrimSpace(stripComment(scanner.Text()))
+		if s == "" {
+			continue
+		}
+
+		if strings.HasPrefix(s, "[") {
+			if err := flush(); err != nil {
+				return nil, err
+			}
+
+			n, err := parseTableHeader(s)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", line, err)
+			}
+
+			if _, ok := l.Entries[n]; ok {
+				return nil, fmt.Errorf(
+					"line %d: %w: %q is pinned more than once",
+					line,
+					ErrConflict,
+					n,
+				)
+			}
+
+			name, entry, started = n, Entry{Version: nil, Constraint: nil}, true
+
+			continue
+		}
+
+		if !started {
+			return nil, fmt.Errorf("line %d: %w: key outside of a table", line, ErrSyntax)
+		}
+
+		if err := entry.set(s); err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read lock file: %w", err)
+	}
+
+	if err := flush(); err != nil {
+		return nil, err
+	}
+
+	return l, nil
+}
+
+// Check reports the pins that don't satisfy their constraints. The returned
+// error joins a [*Conflict] for each of them, and it is nil if there are no
+// conflicts.
+func (l *Lock) Check() error {
+	var errs []error
+
+	for _, name := range l.Names() {
+		e := l.Entries[name]
+		if e.Constraint != nil && !e.Constraint.Check(e.Version) {
+			errs = append(errs, &Conflict{
+				Name:       name,
+				Version:    e.Version,
+				Other:      nil,
+				Constraint: e.Constraint,
+			})
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// Merge adds the pins from other to l. The names that are pinned to different
+// versions in l and other are left unchanged in l, and the returned error
+// joins a [*Conflict] for each of them.
+func (l *Lock) Merge(other *Lock) error {
+	var errs []error
+
+	for _, name := range other.Names() {
+		e := other.Entries[name]
+
+		if old, ok := l.Entries[name]; ok && !old.Version.StrictEqual(e.Version) {
+			errs = append(errs, &Conflict{
+				Name:       name,
+				Version:    old.Version,
+				Other:      e.Version,
+				Constraint: nil,
+			})
+
+			continue
+		}
+
+		l.Entries[name] = e
+	}
+
+	return errors.Join(errs...)
+}
+
+// Names returns the names pinned in l in sorted order.
+func (l *Lock) Names() []string {
+	return slices.Sorted(maps.Keys(l.Entries))
+}
+
+// Pin pins name to version v with the optional constraint c. It returns
+// a [*Conflict] if v doesn't satisfy c.
+func (l *Lock) Pin(name string, v *semver.Version, c *semver.Constraint) error {
+	if c != nil && !c.Check(v) {
+		return &Conflict{Name: name, Version: v, Other: nil, Constraint: c}
+	}
+
+	if l.Entries == nil {
+		l.Entries = make(map[string]Entry)
+	}
+
+	l.Entries[name] = Entry{Version: v, Constraint: c}
+
+	return nil
+}
+
+// Save writes l to the file at the given path.
+func (l *Lock) Save(path string) error {
+	//nolint:gosec // lock files are committed to version control
+	if err := os.WriteFile(path, []byte(l.String()), 0o644); err != nil {
+		return fmt.Errorf("failed to write lock file: %w", err)
+	}
+
+	return nil
+}
+
+// String returns l in the lock file format. The names are written in sorted
+// order.
+func (l *Lock) String() string {
+	var sb strings.Builder
+
+	for i, name := range l.Names() {
+		e := l.Entries[name]
+
+		if i > 0 {
+			sb.WriteByte('\n')
+		}
+
+		sb.WriteString("[" + strconv.Quote(name) + "]\n")
+		sb.WriteString("version = " + strconv.Quote(e.Version.String()) + "\n")
+
+		if e.Constraint != nil {
+			sb.WriteString("constraint = " + strconv.Quote(e.Constraint.String()) + "\n")
+		}
+	}
+
+	return sb.String()
+}
+
+// Write writes l to w in the lock file format.
+func (l *Lock) Write(w io.Writer) error {
+	if _, err := io.WriteString(w, l.String()); err != nil {
+		return fmt.Errorf("failed to write lock file: %w", err)
+	}
+
+	return nil
+}
+
+// Error returns the description of the conflict.
+func (c *Conflict) Error() string {
+	if c.Other != nil {
+		return fmt.Sprintf(
+			"%s: %q is pinned to both %s and %s",
+			ErrConflict,
+			c.Name,
+			c.Version,
+			c.Other,
+		)
+	}
+
+	return fmt.Sprintf(
+		"%s: %q is pinned to %s that doesn't satisfy %q",
+		ErrConflict,
+		c.Name,
+		c.Version,
+		c.Constraint,
+	)
+}
+
+// Is reports whether target is ErrConflict.
+func (c *Conflict) Is(target error) bool {
+	return target == ErrConflict
+}
+
+// set sets the value of the key-value pair in line s.
+func (e *Entry) set(s string) error {
+	key, value, ok := strings.Cut(s, "=")
+	if !ok {
+		return fmt.Errorf("%w: expected a key-value pair", ErrSyntax)
+	}
+
+	key = strings.TrimSpace(key)
+
+	value, err := strconv.Unquote(strings.TrimSpace(value))
+	if err != nil {
+		return fmt.Errorf("%w: invalid string for %q", ErrSyntax, key)
+	}
+
+	switch key {
+	case "version":
+		e.Version, err = semver.Parse(value)
+	case "constraint":
+		e.Constraint, err = semver.ParseConstraint(value)
+	default:
+		return fmt.Errorf("%w: unknown key %q", ErrSyntax, key)
+	}
+
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrSyntax, err)
+	}
+
+	return nil
+}
+
+// parseTableHeader returns the name in the table header s, like `["name"]` or
+// `[name]`.
+func parseTableHeader(s string) (string, error) {
+	if !strings.HasSuffix(s, "]") || len(s) < len("[x]") {
+		return "", fmt.Errorf("%w: invalid table header %q", ErrSyntax, s)
+	}
+
+	name := strings.TrimSpace(s[1 : len(s)-1])
+	if strings.HasPrefix(name, `"`) {
+		var err error
+
+		name, err = strconv.Unquote(name)
+		if err != nil {
+			return "", fmt.Errorf("%w: invalid table header %q", ErrSyntax, s)
+		}
+	}
+
+	if name == "" {
+		return "", fmt.Errorf("%w: empty name", ErrSyntax)
+	}
+
+	return name, nil
+}
+
+// stripComment removes the comment from line s. A "#" inside a string doesn't
+// start a comment.
+func stripComment(s string) string {
+	quoted := false
+
+	for i := 0; i < len(s); i++ {
+		switch {
+		case s[i] == '\\' && quoted:
+			i++
+		case s[i] == '"':
+			quoted = !quoted
+		case s[i] == '#' && !quoted:
+			return s[:i]
+		}
+	}
+
+	return s
+}
@@ -0,0 +1,157 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package lockfile_test
+
+import (
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/anttikivi/semver"
+	"github.com/anttikivi/semver/lockfile"
+)
+
+const lockContents = `# Pinned tools.
+["github.com/anttikivi/semver"]
+version = "1.2.3"
+constraint = "^1.2.0" # keep on v1
+
+[golangci-lint]
+version = "2.1.0-rc.1"
+`
+
+func TestRead(t *testing.T) {
+	t.Parallel()
+
+	l, err := lockfile.Read(strings.NewReader(lockContents))
+	if err != nil {
+		t.Fatalf("Read() error = %v", err)
+	}
+
+	if got := l.Names(); len(got) != 2 || got[0] != "github.com/anttikivi/semver" || got[1] != "golangci-lint" {
+		t.Fatalf("Read() names = %v", got)
+	}
+
+	e := l.Entries["github.com/anttikivi/semver"]
+	if e.Version.String() != "1.2.3" || e.Constraint.String() != "^1.2.0" {
+		t.Errorf("Read() entry = %v %v", e.Version, e.Constraint)
+	}
+
+	if e := l.Entries["golangci-lint"]; e.Version.String() != "2.1.0-rc.1" || e.Constraint != nil {
+		t.Errorf("Read() entry = %v %v", e.Version, e.Constraint)
+	}
+
+	if err := l.Check(); err != nil {
+		t.Errorf("Check() error = %v", err)
+	}
+}
+
+func TestReadErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		s       string
+		wantErr error
+	}{
+		{"no table", "version = \"1.2.3\"\n", lockfile.ErrSyntax},
+		{"no version", "[a]\nconstraint = \"^1\"\n", lockfile.ErrSyntax},
+		{"unknown key", "[a]\nversion = \"1.2.3\"\nchecksum = \"x\"\n", lockfile.ErrSyntax},
+		{"unquoted", "[a]\nversion = 1.2.3\n", lockfile.ErrSyntax},
+		{"invalid version", "[a]\nversion = \"1.2\"\n", lockfile.ErrSyntax},
+		{"header", "[a\nversion = \"1.2.3\"\n", lockfile.ErrSyntax},
+		{"duplicate", "[a]\nversion = \"1.2.3\"\n[\"a\"]\nversion = \"1.2.4\"\n", lockfile.ErrConflict},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if _, err := lockfile.Read(strings.NewReader(tt.s)); !errors.Is(err, tt.wantErr) {
+				t.Errorf("Read() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSaveLoad(t *testing.T) {
+	t.Parallel()
+
+	l := lockfile.New()
+	if err := l.Pin("b", semver.MustParse("1.0.0"), semver.MustParseConstraint(">=1.0.0")); err != nil {
+		t.Fatalf("Pin() error = %v", err)
+	}
+
+	if err := l.Pin("a \"quoted\"", semver.MustParse("0.1.0"), nil); err != nil {
+		t.Fatalf("Pin() error = %v", err)
+	}
+
+	path := filepath.Join(t.TempDir(), lockfile.FileName)
+	if err := l.Save(path); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	loaded, err := lockfile.Load(path)
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+
+	if got, want := loaded.String(), l.String(); got != want {
+		t.Errorf("Load() = %q, want %q", got, want)
+	}
+
+	if !strings.HasPrefix(l.String(), `["a \"quoted\""]`) {
+		t.Errorf("String() = %q, want the names in sorted order", l.String())
+	}
+}
+
+func TestConflicts(t *testing.T) {
+	t.Parallel()
+
+	l := lockfile.New()
+
+	err := l.Pin("a", semver.MustParse("2.0.0"), semver.MustParseConstraint("^1.0.0"))
+
+	var conflict *lockfile.Conflict
+	if !errors.As(err, &conflict) || conflict.Name != "a" || !errors.Is(err, lockfile.ErrConflict) {
+		t.Errorf("Pin() error = %v, want a conflict", err)
+	}
+
+	l.Entries["a"] = lockfile.Entry{Version: semver.MustParse("2.0.0"), Constraint: semver.MustParseConstraint("^1.0.0")}
+	l.Entries["b"] = lockfile.Entry{Version: semver.MustParse("1.0.0")}
+
+	if err := l.Check(); !errors.Is(err, lockfile.ErrConflict) {
+		t.Errorf("Check() error = %v, want %v", err, lockfile.ErrConflict)
+	}
+
+	other := lockfile.New()
+	other.Entries["b"] = lockfile.Entry{Version: semver.MustParse("1.1.0")}
+	other.Entries["c"] = lockfile.Entry{Version: semver.MustParse("3.0.0")}
+
+	err = l.Merge(other)
+	if !errors.As(err, &conflict) || conflict.Name != "b" || conflict.Other.String() != "1.1.0" {
+		t.Errorf("Merge() error = %v, want a conflict for b", err)
+	}
+
+	if l.Entries["b"].Version.String() != "1.0.0" || l.Entries["c"].Version.String() != "3.0.0" {
+		t.Errorf("Merge() = %v", l.String())
+	}
+}
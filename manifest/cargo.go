@@ -0,0 +1,318 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package manifest
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/anttikivi/semver"
+)
+
+// CargoTOML extracts the dependencies from a Cargo.toml file. The dependencies
+// are read from the [dependencies], [dev-dependencies], and
+// [build-dependencies] tables, including their platform-specific and
+// workspace variants, and from the tables of single dependencies like
+// [dependencies.serde]. A requirement without an operator is a caret
+// requirement in Cargo, so "1.2" is converted into "^1.2". The dependencies
+// without a version, like the ones from Git repositories, have an empty
+// requirement and no constraint. The multi-line arrays and strings of the
+// other keys, like "authors" and "description", are skipped.
+func CargoTOML(data []byte) ([]Dependency, error) {
+	var (
+		deps []Dependency
+
+		// table is the kind of the current table: "deps" for a table of
+		// dependencies, "dep" for the table of a single dependency, and empty
+		// for other tables.
+		table string
+
+		// current is the index of the dependency of the current "dep" table.
+		current int
+
+		// depth is the number of the open brackets of a multi-line array,
+		// and closing is the delimiter of a multi-line string. The lines of
+		// the multi-line values are skipped.
+		depth   int
+		closing string
+	)
+
+	for i, line := range strings.Split(string(data), "\n") {
+		if closing != "" {
+			if strings.Contains(line, closing) {
+				closing = ""
+			}
+
+			continue
+		}
+
+		line = strings.TrimSpace(stripTOMLComment(line))
+		if line == "" {
+			continue
+		}
+
+		if depth > 0 {
+			depth += bracketDepth(line)
+
+			continue
+		}
+
+		if strings.HasPrefix(line, "[") {
+			if !strings.HasSuffix(line, "]") || strings.HasPrefix(line, "[[") {
+				table = ""
+
+				continue
+			}
+
+			kind, name := cargoTable(strings.TrimSpace(line[1 : len(line)-1]))
+
+			table = kind
+			if kind == "dep" {
+				deps = append(deps, Dependency{
+					Name:        name,
+					Requirement: "",
+					Constraint:  nil,
+					Line:        i + 1,
+				})
+				current = len(deps) - 1
+			}
+
+			continue
+		}
+
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			return nil, fmt.Errorf("%w: Cargo.toml:%d: expected a key-value pair", ErrSyntax, i+1)
+		}
+
+		key, _ = unquote(strings.TrimSpace(key))
+		value = strings.TrimSpace(value)
+
+		if d := bracketDepth(value); d > 0 {
+			depth = d
+
+			continue
+		}
+
+		if delim := multilineString(value); delim != "" {
+			closing = delim
+
+			continue
+		}
+
+		switch table {
+		case "deps":
+			if strings.Contains(key, ".") {
+				// Dotted keys, like "serde.workspace = true", don't have
+				// a version.
+				continue
+			}
+
+			req := value
+			if strings.HasPrefix(value, "{") {
+				req = inlineTableValue(value, "version")
+			} else {
+				req, _ = unquote(value)
+			}
+
+			deps = append(deps, Dependency{
+				Name:        key,
+				Requirement: req,
+				Constraint:  cargoConstraint(req),
+				Line:        i + 1,
+			})
+		case "dep":
+			if key == "version" {
+				req, _ := unquote(value)
+				deps[current].Requirement = req
+				deps[current].Constraint = cargoConstraint(req)
+				deps[current].Line = i + 1
+			}
+		}
+	}
+
+	return deps, nil
+}
+
+// bracketDepth returns the number of the brackets that the TOML value s opens
+// but doesn't close. The brackets in strings are not counted.
+func bracketDepth(s string) int {
+	depth := 0
+	quote := byte(0)
+
+	for i := range len(s) {
+		switch c := s[i]; {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == '[':
+			depth++
+		case c == ']':
+			depth--
+		}
+	}
+
+	return depth
+}
+
+// cargoTable returns the kind of the table with the given header and the name
+// of the dependency if the table is the table of a single dependency.
+func cargoTable(header string) (string, string) {
+	parts := strings.Split(header, ".")
+	for i := range parts {
+		parts[i], _ = unquote(strings.TrimSpace(parts[i]))
+	}
+
+	// The tables of the dependencies of a target, like
+	// [target.'cfg(unix)'.dependencies], may have dots in the target name.
+	if parts[0] == "target" && len(parts) > 2 {
+		for i := len(parts) - 1; i > 1; i-- {
+			if isCargoDependencyTable(parts[i]) {
+				parts = parts[i:]
+
+				break
+			}
+		}
+	} else if parts[0] == "workspace" && len(parts) > 1 {
+		parts = parts[1:]
+	}
+
+	switch {
+	case len(parts) == 1 && isCargoDependencyTable(parts[0]):
+		return "deps", ""
+	//nolint:mnd // the table and the name of the dependency
+	case len(parts) == 2 && isCargoDependencyTable(parts[0]):
+		return "dep", parts[1]
+	default:
+		return "", ""
+	}
+}
+
+// cargoConstraint converts a Cargo version requirement into a constraint.
+func cargoConstraint(req string) *semver.Constraint {
+	if req == "" {
+		return nil
+	}
+
+	parts := strings.Split(req, ",")
+	for i, p := range parts {
+		p = strings.TrimSpace(p)
+		if p != "" && p[0] >= '0' && p[0] <= '9' {
+			p = "^" + p
+		}
+
+		parts[i] = p
+	}
+
+	return constraint(strings.Join(parts, " "))
+}
+
+// inlineTableValue returns the string value of key in the TOML inline table s,
+// or an empty string if the table doesn't have it.
+func inlineTableValue(s, key string) string {
+	s = strings.TrimSuffix(strings.TrimPrefix(s, "{"), "}")
+
+	depth := 0
+	quote := byte(0)
+	start := 0
+
+	for i := 0; i <= len(s); i++ {
+		if i < len(s) {
+			c := s[i]
+
+			switch {
+			case quote != 0:
+				if c == quote {
+					quote = 0
+				}
+
+				continue
+			case c == '"' || c == '\'':
+				quote = c
+
+				continue
+			case c == '[' || c == '{':
+				depth++
+
+				continue
+			case c == ']' || c == '}':
+				depth--
+
+				continue
+			case c != ',' || depth > 0:
+				continue
+			}
+		}
+
+		k, v, ok := strings.Cut(s[start:i], "=")
+		if k, _ = unquote(strings.TrimSpace(k)); ok && k == key {
+			v, _ = unquote(strings.TrimSpace(v))
+
+			return v
+		}
+
+		start = i + 1
+	}
+
+	return ""
+}
+
+// isCargoDependencyTable reports whether name is the name of a table of
+// dependencies in Cargo.toml.
+func isCargoDependencyTable(name string) bool {
+	return name == "dependencies" || name == "dev-dependencies" || name == "build-dependencies"
+}
+
+// multilineString returns the delimiter of the multi-line string that
+// the TOML value s starts but doesn't end, or an empty string if s doesn't
+// start one.
+func multilineString(s string) string {
+	for _, delim := range []string{`"""`, "'''"} {
+		if rest, ok := strings.CutPrefix(s, delim); ok && !strings.Contains(rest, delim) {
+			return delim
+		}
+	}
+
+	return ""
+}
+
+// stripTOMLComment removes the comment from the TOML line s. A "#" inside
+// a string doesn't start a comment.
+func stripTOMLComment(s string) string {
+	quote := byte(0)
+
+	for i := range len(s) {
+		switch c := s[i]; {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == '#':
+			return s[:i]
+		}
+	}
+
+	return s
+}
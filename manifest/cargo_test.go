@@ -0,0 +1,121 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package manifest_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/anttikivi/semver/manifest"
+)
+
+func TestCargoTOML(t *testing.T) {
+	t.Parallel()
+
+	data := `[package]
+name = "app"
+version = "0.1.0" # not a dependency
+
+[dependencies]
+serde = { version = "1.0", features = ["derive", "rc"] }
+regex = "1.10.2"
+exact = "=0.4.1"
+range = ">= 1.2, < 1.5"
+local = { path = "../local" }
+shared.workspace = true
+
+[dev-dependencies.criterion]
+features = ["html_reports"]
+version = "0.5"
+
+[target.'cfg(unix)'.dependencies]
+libc = "0.2"
+
+[[bin]]
+name = "tool"
+`
+
+	deps, err := manifest.CargoTOML([]byte(data))
+	if err != nil {
+		t.Fatalf("CargoTOML() error = %v", err)
+	}
+
+	want := []string{
+		"6 serde 1.0 => ^1.0",
+		"7 regex 1.10.2 => ^1.10.2",
+		"8 exact =0.4.1 => =0.4.1",
+		"9 range >= 1.2, < 1.5 => >= 1.2 < 1.5",
+		"10 local  => <nil>",
+		"15 criterion 0.5 => ^0.5",
+		"18 libc 0.2 => ^0.2",
+	}
+
+	if got := dependencyStrings(deps); !reflect.DeepEqual(got, want) {
+		t.Errorf("CargoTOML() = %q, want %q", got, want)
+	}
+}
+
+func TestCargoTOMLMultilineValues(t *testing.T) {
+	t.Parallel()
+
+	data := `[package]
+name = "app"
+version = "0.1.0"
+authors = [
+  "A <a@b>",
+  "B <b@c>", # the maintainer
+]
+keywords = ["semver",
+  "version"]
+description = """
+A tool.
+key = "not a dependency"
+"""
+
+[features]
+default = [
+  "std",
+  "serde/derive",
+]
+
+[dependencies]
+serde = { version = "1.0", optional = true }
+regex = "1.10.2"
+
+[badges]
+note = '''
+[dependencies]
+'''
+`
+
+	deps, err := manifest.CargoTOML([]byte(data))
+	if err != nil {
+		t.Fatalf("CargoTOML() error = %v", err)
+	}
+
+	want := []string{
+		"22 serde 1.0 => ^1.0",
+		"23 regex 1.10.2 => ^1.10.2",
+	}
+
+	if got := dependencyStrings(deps); !reflect.DeepEqual(got, want) {
+		t.Errorf("CargoTOML() = %q, want %q", got, want)
+	}
+}
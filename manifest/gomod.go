@@ -0,0 +1,105 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package manifest
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/anttikivi/semver"
+)
+
+// GoMod extracts the required modules from a go.mod file. The requirement is
+// the version in the require directive, and as Go uses minimal version
+// selection, the constraint allows the version and every version after it
+// with the same major version, for example ">=1.2.3 <2.0.0-0" for "v1.2.3".
+// The major versions 0 and 1 share the module path, so a "v0" requirement
+// allows the versions up to but excluding "v2". The replace and exclude
+// directives are ignored.
+func GoMod(data []byte) ([]Dependency, error) {
+	var (
+		deps  []Dependency
+		block bool
+	)
+
+	for i, line := range strings.Split(string(data), "\n") {
+		if j := strings.Index(line, "//"); j >= 0 {
+			line = line[:j]
+		}
+
+		fields := strings.Fields(line)
+
+		switch {
+		case len(fields) == 0:
+			continue
+		case block && fields[0] == ")":
+			block = false
+
+			continue
+		case !block && fields[0] == "require" && len(fields) == 2 && fields[1] == "(":
+			block = true
+
+			continue
+		case !block && fields[0] == "require":
+			fields = fields[1:]
+		case !block:
+			continue
+		}
+
+		if len(fields) != 2 { //nolint:mnd // module path and version
+			return nil, fmt.Errorf("%w: go.mod:%d: invalid require directive", ErrSyntax, i+1)
+		}
+
+		name, _ := unquote(fields[0])
+		deps = append(deps, Dependency{
+			Name:        name,
+			Requirement: fields[1],
+			Constraint:  goConstraint(fields[1]),
+			Line:        i + 1,
+		})
+	}
+
+	if block {
+		return nil, fmt.Errorf("%w: go.mod: unterminated require block", ErrSyntax)
+	}
+
+	return deps, nil
+}
+
+// goConstraint returns the constraint for the version v in a require
+// directive.
+func goConstraint(v string) *semver.Constraint {
+	parsed, err := semver.Parse(v)
+	if err != nil {
+		return nil
+	}
+
+	if parsed.Major == math.MaxUint64 {
+		return constraint(">=" + parsed.String())
+	}
+
+	next := parsed.Major + 1
+	if parsed.Major == 0 {
+		next = 2
+	}
+
+	return constraint(fmt.Sprintf(">=%s <%d.0.0-0", parsed, next))
+}
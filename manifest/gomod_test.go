@@ -0,0 +1,70 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package manifest_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/anttikivi/semver/manifest"
+)
+
+func TestGoMod(t *testing.T) {
+	t.Parallel()
+
+	data := `module example.com/app
+
+go 1.24
+
+require github.com/anttikivi/semver v1.2.3
+
+require (
+	golang.org/x/mod v0.20.0 // indirect
+	github.com/docker/docker v24.0.0+incompatible
+	example.com/pseudo v0.0.0-20191109021931-daa7c04131f5
+)
+
+replace example.com/pseudo => ../pseudo
+`
+
+	deps, err := manifest.GoMod([]byte(data))
+	if err != nil {
+		t.Fatalf("GoMod() error = %v", err)
+	}
+
+	want := []string{
+		"5 github.com/anttikivi/semver v1.2.3 => >=1.2.3 <2.0.0-0",
+		"8 golang.org/x/mod v0.20.0 => >=0.20.0 <2.0.0-0",
+		"9 github.com/docker/docker v24.0.0+incompatible => >=24.0.0+incompatible <25.0.0-0",
+		"10 example.com/pseudo v0.0.0-20191109021931-daa7c04131f5 => " +
+			">=0.0.0-20191109021931-daa7c04131f5 <2.0.0-0",
+	}
+
+	if got := dependencyStrings(deps); !reflect.DeepEqual(got, want) {
+		t.Errorf("GoMod() = %q, want %q", got, want)
+	}
+
+	for _, s := range []string{"require (\n\ta v1.0.0\n", "require a\n"} {
+		if _, err := manifest.GoMod([]byte(s)); !errors.Is(err, manifest.ErrSyntax) {
+			t.Errorf("GoMod(%q) error = %v, want %v", s, err, manifest.ErrSyntax)
+		}
+	}
+}
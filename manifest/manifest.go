@@ -0,0 +1,105 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+// Package manifest extracts the dependencies and their version requirements
+// from the manifest files of common ecosystems: package.json of npm, go.mod of
+// Go modules, Cargo.toml of Cargo, and requirements.txt of pip. The files are
+// parsed as text with only the syntax needed for finding the requirements, so
+// the semantics of the ecosystems, like workspaces or environment markers, are
+// not applied.
+//
+// The requirements are converted into [semver.Constraint] values where
+// the ecosystem's syntax has an equivalent, so they can be checked and
+// resolved with the semver package.
+package manifest
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/anttikivi/semver"
+)
+
+var (
+	// ErrSyntax is returned when a manifest file has invalid syntax.
+	ErrSyntax = errors.New("invalid manifest syntax")
+
+	// ErrUnknownManifest is returned by [Scan] for a file name it doesn't
+	// recognize.
+	ErrUnknownManifest = errors.New("unknown manifest file")
+)
+
+// A Dependency is a dependency and its version requirement in a manifest file.
+type Dependency struct {
+	// Name is the name of the dependency.
+	Name string
+
+	// Requirement is the version requirement as it is written in the manifest.
+	Requirement string
+
+	// Constraint is the requirement converted into a constraint. It is nil if
+	// the requirement has no equivalent constraint, for example because it
+	// refers to a Git repository instead of a version.
+	Constraint *semver.Constraint
+
+	// Line is the line number of the dependency in the manifest, starting from
+	// 1.
+	Line int
+}
+
+// Scan extracts the dependencies from the manifest file with the given name
+// and contents. The format is selected by the base name of the file:
+// "package.json", "go.mod", "Cargo.toml", or any name that ends in ".txt" for
+// the requirements files of pip.
+func Scan(name string, data []byte) ([]Dependency, error) {
+	switch base := path.Base(strings.ReplaceAll(name, "\\", "/")); {
+	case base == "package.json":
+		return PackageJSON(data)
+	case base == "go.mod":
+		return GoMod(data)
+	case base == "Cargo.toml":
+		return CargoTOML(data)
+	case strings.HasSuffix(base, ".txt"):
+		return RequirementsTXT(data)
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnknownManifest, name)
+	}
+}
+
+// constraint parses s into a constraint or returns nil if it is not valid.
+func constraint(s string) *semver.Constraint {
+	c, err := semver.ParseConstraint(s)
+	if err != nil {
+		return nil
+	}
+
+	return c
+}
+
+// unquote removes the double or single quotes around s. It reports whether
+// s was quoted.
+func unquote(s string) (string, bool) {
+	if len(s) >= 2 && (s[0] == '"' || s[0] == '\'') && s[len(s)-1] == s[0] {
+		return s[1 : len(s)-1], true
+	}
+
+	return s, false
+}
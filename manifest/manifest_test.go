@@ -0,0 +1,78 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package manifest_test
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/anttikivi/semver/manifest"
+)
+
+func TestScan(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		data string
+		want []string
+	}{
+		{"web/package.json", `{"dependencies": {"a": "^1.0.0"}}`, []string{"1 a ^1.0.0 => ^1.0.0"}},
+		{"go.mod", "module m\n\nrequire b v1.2.3\n", []string{"3 b v1.2.3 => >=1.2.3 <2.0.0-0"}},
+		{`crates\Cargo.toml`, "[dependencies]\nc = \"1.2\"\n", []string{"2 c 1.2 => ^1.2"}},
+		{"requirements-dev.txt", "d==1.0.0\n", []string{"1 d ==1.0.0 => =1.0.0"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			deps, err := manifest.Scan(tt.name, []byte(tt.data))
+			if err != nil {
+				t.Fatalf("Scan() error = %v", err)
+			}
+
+			if got := dependencyStrings(deps); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Scan() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := manifest.Scan("pom.xml", nil); !errors.Is(err, manifest.ErrUnknownManifest) {
+		t.Errorf("Scan() error = %v, want %v", err, manifest.ErrUnknownManifest)
+	}
+}
+
+func dependencyStrings(deps []manifest.Dependency) []string {
+	s := make([]string, len(deps))
+
+	for i, d := range deps {
+		c := "<nil>"
+		if d.Constraint != nil {
+			c = d.Constraint.String()
+		}
+
+		s[i] = fmt.Sprintf("%d %s %s => %s", d.Line, d.Name, d.Requirement, c)
+	}
+
+	return s
+}
@@ -0,0 +1,108 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package manifest
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+)
+
+// packageJSONSections are the sections of package.json that contain
+// dependencies, in the order they are reported.
+//
+//nolint:gochecknoglobals // read-only lookup table
+var packageJSONSections = []string{
+	"dependencies",
+	"devDependencies",
+	"peerDependencies",
+	"optionalDependencies",
+}
+
+// PackageJSON extracts the dependencies from a package.json file of npm. The
+// dependencies are reported section by section, and sorted by name within
+// a section. The requirements use the same syntax as [semver.Constraint], and
+// the ones that refer to tags, URLs, or local paths have no constraint.
+func PackageJSON(data []byte) ([]Dependency, error) {
+	var pkg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &pkg); err != nil {
+		return nil, fmt.Errorf("failed to parse package.json: %w", err)
+	}
+
+	lines := strings.Split(string(data), "\n")
+
+	var deps []Dependency
+
+	for _, section := range packageJSONSections {
+		raw, ok := pkg[section]
+		if !ok {
+			continue
+		}
+
+		var m map[string]string
+		if err := json.Unmarshal(raw, &m); err != nil {
+			return nil, fmt.Errorf("failed to parse %q in package.json: %w", section, err)
+		}
+
+		for _, name := range slices.Sorted(maps.Keys(m)) {
+			deps = append(deps, Dependency{
+				Name:        name,
+				Requirement: m[name],
+				Constraint:  constraint(m[name]),
+				Line:        jsonLine(lines, name, m[name]),
+			})
+		}
+	}
+
+	return deps, nil
+}
+
+// jsonLine returns the number of the first line that has the JSON member with
+// the given name and string value, or 0 if there is none.
+func jsonLine(lines []string, name, value string) int {
+	key, val := jsonString(name), jsonString(value)
+
+	for i, line := range lines {
+		k := strings.Index(line, key)
+		if k < 0 {
+			continue
+		}
+
+		if rest := strings.TrimSpace(line[k+len(key):]); strings.HasPrefix(rest, ":") &&
+			strings.HasPrefix(strings.TrimSpace(rest[1:]), val) {
+			return i + 1
+		}
+	}
+
+	return 0
+}
+
+// jsonString returns s as a JSON string without escaping the HTML characters.
+func jsonString(s string) string {
+	var sb strings.Builder
+
+	enc := json.NewEncoder(&sb)
+	enc.SetEscapeHTML(false)
+	_ = enc.Encode(s) // encoding a string cannot fail
+
+	return strings.TrimSuffix(sb.String(), "\n")
+}
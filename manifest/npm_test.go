@@ -0,0 +1,66 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package manifest_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/anttikivi/semver/manifest"
+)
+
+func TestPackageJSON(t *testing.T) {
+	t.Parallel()
+
+	data := `{
+  "name": "app",
+  "version": "1.0.0",
+  "dependencies": {
+    "react": "^18.2.0",
+    "left-pad": "latest",
+    "lodash": ">=4.17.0 <5"
+  },
+  "devDependencies": {
+    "typescript": "~5.4.0",
+    "local": "file:../local"
+  }
+}`
+
+	deps, err := manifest.PackageJSON([]byte(data))
+	if err != nil {
+		t.Fatalf("PackageJSON() error = %v", err)
+	}
+
+	want := []string{
+		"6 left-pad latest => <nil>",
+		"7 lodash >=4.17.0 <5 => >=4.17.0 <5",
+		"5 react ^18.2.0 => ^18.2.0",
+		"11 local file:../local => <nil>",
+		"10 typescript ~5.4.0 => ~5.4.0",
+	}
+
+	if got := dependencyStrings(deps); !reflect.DeepEqual(got, want) {
+		t.Errorf("PackageJSON() = %q, want %q", got, want)
+	}
+
+	if _, err := manifest.PackageJSON([]byte(`{"dependencies": []}`)); err == nil {
+		t.Error("PackageJSON() with invalid dependencies succeeded")
+	}
+}
@@ -0,0 +1,160 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package manifest
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/anttikivi/semver"
+)
+
+// RequirementsTXT extracts the requirements from a requirements file of pip.
+// The options, like "-r other.txt", are skipped, and the environment markers
+// after ";" are ignored. The version specifiers are converted into
+// constraints: "==" and "===" are exact matches, "~=1.2.3" is converted into
+// ">=1.2.3 <1.3", and the other operators are kept. The requirements that
+// refer to URLs or use version formats that are not semantic versions, like
+// "1.0rc1", have no constraint.
+func RequirementsTXT(data []byte) ([]Dependency, error) {
+	var (
+		deps    []Dependency
+		pending string
+		start   int
+	)
+
+	for i, line := range strings.Split(string(data), "\n") {
+		// A "#" starts a comment only at the start of the line or after
+		// whitespace, so URL fragments are kept.
+		j := strings.Index(line, "#")
+		if j == 0 || j > 0 && (line[j-1] == ' ' || line[j-1] == '\t') {
+			line = line[:j]
+		}
+
+		if pending == "" {
+			start = i + 1
+		}
+
+		if trimmed := strings.TrimSpace(line); strings.HasSuffix(trimmed, "\\") {
+			pending += strings.TrimSpace(strings.TrimSuffix(trimmed, "\\")) + " "
+
+			continue
+		}
+
+		line = pending + strings.TrimSpace(line)
+		pending = ""
+
+		if line == "" || strings.HasPrefix(line, "-") {
+			continue
+		}
+
+		if dep, ok := parseRequirement(line); ok {
+			dep.Line = start
+			deps = append(deps, dep)
+		}
+	}
+
+	return deps, nil
+}
+
+// parseRequirement parses a single requirement line.
+func parseRequirement(line string) (Dependency, bool) {
+	if j := strings.IndexByte(line, ';'); j >= 0 {
+		line = strings.TrimSpace(line[:j])
+	}
+
+	end := strings.IndexAny(line, "[<>=!~@ (")
+	if end < 0 {
+		end = len(line)
+	}
+
+	name := line[:end]
+	if name == "" || strings.Contains(name, "/") || strings.Contains(name, ":") {
+		return Dependency{}, false
+	}
+
+	rest := strings.TrimSpace(line[end:])
+	if strings.HasPrefix(rest, "[") {
+		if k := strings.IndexByte(rest, ']'); k >= 0 {
+			rest = strings.TrimSpace(rest[k+1:])
+		}
+	}
+
+	rest = strings.TrimSpace(strings.TrimSuffix(strings.TrimPrefix(rest, "("), ")"))
+
+	if strings.HasPrefix(rest, "@") {
+		return Dependency{Name: name, Requirement: rest, Constraint: nil, Line: 0}, true
+	}
+
+	return Dependency{Name: name, Requirement: rest, Constraint: pipConstraint(rest), Line: 0}, true
+}
+
+// pipConstraint converts the version specifiers of a requirement into
+// a constraint.
+func pipConstraint(spec string) *semver.Constraint {
+	if spec == "" {
+		return constraint("*")
+	}
+
+	parts := strings.Split(spec, ",")
+
+	for i, p := range parts {
+		p = strings.ReplaceAll(strings.TrimSpace(p), " ", "")
+
+		switch {
+		case strings.HasPrefix(p, "==="):
+			p = "=" + p[3:]
+		case strings.HasPrefix(p, "=="):
+			p = p[2:]
+			if !strings.HasSuffix(p, ".*") {
+				p = "=" + p
+			}
+		case strings.HasPrefix(p, "~="):
+			p = compatibleRelease(p[2:])
+			if p == "" {
+				return nil
+			}
+		case strings.HasPrefix(p, "!=") && strings.HasSuffix(p, ".*"):
+			return nil
+		}
+
+		parts[i] = p
+	}
+
+	return constraint(strings.Join(parts, " "))
+}
+
+// compatibleRelease returns the constraint for the compatible release
+// specifier "~=v", or an empty string if v is not valid.
+func compatibleRelease(v string) string {
+	nums := strings.Split(v, ".")
+	if len(nums) < 2 || len(nums) > 3 {
+		return ""
+	}
+
+	n, err := strconv.ParseUint(nums[len(nums)-2], 10, 64)
+	if err != nil {
+		return ""
+	}
+
+	upper := append(nums[:len(nums)-2:len(nums)-2], strconv.FormatUint(n+1, 10))
+
+	return ">=" + v + " <" + strings.Join(upper, ".")
+}
@@ -0,0 +1,64 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package manifest_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/anttikivi/semver/manifest"
+)
+
+func TestRequirementsTXT(t *testing.T) {
+	t.Parallel()
+
+	data := `# Production requirements.
+-r base.txt
+requests==2.31.0
+Django>=4.2,<5.0  # LTS
+numpy~=1.26.0
+urllib3[socks] ~= 2.0 ; python_version >= "3.8"
+flask
+pkg @ https://example.com/pkg.tar.gz
+legacy==1.0rc1
+long>=1.0.0, \
+    <2.0.0
+`
+
+	deps, err := manifest.RequirementsTXT([]byte(data))
+	if err != nil {
+		t.Fatalf("RequirementsTXT() error = %v", err)
+	}
+
+	want := []string{
+		"3 requests ==2.31.0 => =2.31.0",
+		"4 Django >=4.2,<5.0 => >=4.2 <5.0",
+		"5 numpy ~=1.26.0 => >=1.26.0 <1.27",
+		"6 urllib3 ~= 2.0 => >=2.0 <3",
+		"7 flask  => *",
+		"8 pkg @ https://example.com/pkg.tar.gz => <nil>",
+		"9 legacy ==1.0rc1 => <nil>",
+		"10 long >=1.0.0, <2.0.0 => >=1.0.0 <2.0.0",
+	}
+
+	if got := dependencyStrings(deps); !reflect.DeepEqual(got, want) {
+		t.Errorf("RequirementsTXT() = %q, want %q", got, want)
+	}
+}
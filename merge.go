@@ -0,0 +1,85 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver
+
+import "fmt"
+
+// Conflicts that [MergeChoice] can report.
+const (
+	// NoConflict means that the versions were merged.
+	NoConflict Conflict = iota
+
+	// ConflictDowngrade means that one side changed the version to a lower
+	// version than the base version, so the intent of the change can't be
+	// merged with a bump.
+	ConflictDowngrade
+
+	// ConflictBuild means that the sides changed the version to versions that
+	// have the same precedence but different build identifiers, so neither is
+	// higher.
+	ConflictBuild
+)
+
+// A Conflict is the reason why [MergeChoice] couldn't merge two versions.
+type Conflict int
+
+// MergeChoice merges the versions ours and theirs that were both changed from
+// the version base, as in a three-way merge of a file that contains a version.
+// If only one side changed the version, the changed version is chosen. If both
+// sides changed it, the higher version is chosen, which is the version with
+// the higher bump from base; for example, merging 1.2.1 and 1.3.0 from 1.2.0
+// chooses 1.3.0. The base may be nil if the versions have no common ancestor.
+//
+// MergeChoice returns nil and the conflict if it can't choose the version.
+func MergeChoice(base, ours, theirs *Version) (*Version, Conflict) {
+	switch {
+	case ours.StrictEqual(theirs):
+		return ours.Clone(), NoConflict
+	case base != nil && ours.StrictEqual(base):
+		return theirs.Clone(), NoConflict
+	case base != nil && theirs.StrictEqual(base):
+		return ours.Clone(), NoConflict
+	case base != nil && (ours.Compare(base) < 0 || theirs.Compare(base) < 0):
+		return nil, ConflictDowngrade
+	}
+
+	switch d := ours.Compare(theirs); {
+	case d > 0:
+		return ours.Clone(), NoConflict
+	case d < 0:
+		return theirs.Clone(), NoConflict
+	default:
+		return nil, ConflictBuild
+	}
+}
+
+// String returns a description of the conflict.
+func (c Conflict) String() string {
+	switch c {
+	case NoConflict:
+		return "no conflict"
+	case ConflictDowngrade:
+		return "version lowered below the base version"
+	case ConflictBuild:
+		return "versions differ only in build metadata"
+	default:
+		return fmt.Sprintf("Conflict(%d)", int(c))
+	}
+}
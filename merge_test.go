@@ -0,0 +1,71 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver_test
+
+import (
+	"testing"
+
+	"github.com/anttikivi/semver"
+)
+
+func TestMergeChoice(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		base, ours, theirs string
+		want               string
+		conflict           semver.Conflict
+	}{
+		{"1.2.0", "1.2.1", "1.3.0", "1.3.0", semver.NoConflict},
+		{"1.2.0", "2.0.0", "1.2.5", "2.0.0", semver.NoConflict},
+		{"1.2.0", "1.2.1", "1.2.2", "1.2.2", semver.NoConflict},
+		{"1.2.0", "1.3.0-rc.1", "1.3.0", "1.3.0", semver.NoConflict},
+		{"1.2.0", "1.2.0", "1.1.0", "1.1.0", semver.NoConflict},
+		{"1.2.0", "1.2.0+b", "1.2.0", "1.2.0+b", semver.NoConflict},
+		{"1.2.0", "1.3.0", "1.3.0", "1.3.0", semver.NoConflict},
+		{"", "1.3.0", "1.4.0", "1.4.0", semver.NoConflict},
+		{"1.2.0", "1.1.0", "1.3.0", "", semver.ConflictDowngrade},
+		{"1.2.0", "1.3.0+a", "1.3.0+b", "", semver.ConflictBuild},
+	}
+
+	for _, tt := range tests {
+		var base *semver.Version
+		if tt.base != "" {
+			base = semver.MustParse(tt.base)
+		}
+
+		ours, theirs := semver.MustParse(tt.ours), semver.MustParse(tt.theirs)
+		got, conflict := semver.MergeChoice(base, ours, theirs)
+
+		if conflict != tt.conflict || (got == nil) != (tt.want == "") ||
+			got != nil && got.String() != tt.want {
+			t.Errorf(
+				"MergeChoice(%s, %s, %s) = %v, %v, want %s, %v",
+				tt.base,
+				tt.ours,
+				tt.theirs,
+				got,
+				conflict,
+				tt.want,
+				tt.conflict,
+			)
+		}
+	}
+}
@@ -0,0 +1,56 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver
+
+import (
+	"fmt"
+	"strings"
+)
+
+// MetricLabel returns a representation of v that is safe to use as a label
+// value in monitoring systems like Prometheus, where inconsistent formatting
+// of the same version would split its time series. The label contains only
+// ASCII letters, digits, dots, and underscores: the hyphens, both
+// the separator of the pre-release and the ones in the pre-release
+// identifiers, are replaced with underscores, and the build metadata with its
+// "+" separator is dropped because it doesn't affect the precedence of
+// the version and would only increase the cardinality of the metrics. For
+// example, the label of "1.2.3-rc-1.2+build.5" is "1.2.3_rc_1.2". Use
+// [ParseMetricLabel] to parse the label back into a version.
+func (v *Version) MetricLabel() string {
+	return strings.ReplaceAll(v.ComparableString(), "-", "_")
+}
+
+// ParseMetricLabel parses a label returned by [Version.MetricLabel] into
+// a version. The version doesn't have build metadata as the label doesn't
+// include it. It returns an error that wraps [ErrInvalidVersion] if s is not
+// a valid label.
+func ParseMetricLabel(s string) (*Version, error) {
+	if strings.ContainsAny(s, "-+") || strings.HasPrefix(s, "v") {
+		return nil, fmt.Errorf("%w: invalid metric label %q", ErrInvalidVersion, s)
+	}
+
+	v, err := Parse(strings.ReplaceAll(s, "_", "-"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse metric label: %w", err)
+	}
+
+	return v, nil
+}
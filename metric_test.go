@@ -0,0 +1,73 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/anttikivi/semver"
+)
+
+func TestMetricLabel(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		v    string
+		want string
+	}{
+		{"1.2.3", "1.2.3"},
+		{"v1.2.3+build.5", "1.2.3"},
+		{"1.2.3-rc-1.2+build.5", "1.2.3_rc_1.2"},
+		{"2.0.0-alpha.-x", "2.0.0_alpha._x"},
+	}
+
+	for _, tt := range tests {
+		v := semver.MustParse(tt.v)
+
+		got := v.MetricLabel()
+		if got != tt.want {
+			t.Errorf("MetricLabel(%q) = %q, want %q", tt.v, got, tt.want)
+
+			continue
+		}
+
+		w, err := semver.ParseMetricLabel(got)
+		if err != nil {
+			t.Errorf("ParseMetricLabel(%q) error = %v", got, err)
+
+			continue
+		}
+
+		if !w.Equal(v) || len(w.Build) > 0 {
+			t.Errorf("ParseMetricLabel(%q) = %q, want %q", got, w, v.ComparableString())
+		}
+	}
+}
+
+func TestParseMetricLabelInvalid(t *testing.T) {
+	t.Parallel()
+
+	for _, s := range []string{"", "v1.2.3", "1.2.3-rc", "1.2.3+build", "1.2", "1.2.3_"} {
+		if _, err := semver.ParseMetricLabel(s); !errors.Is(err, semver.ErrInvalidVersion) {
+			t.Errorf("ParseMetricLabel(%q) error = %v, want %v", s, err, semver.ErrInvalidVersion)
+		}
+	}
+}
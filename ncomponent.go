@@ -0,0 +1,94 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver
+
+import (
+	"fmt"
+	"strings"
+)
+
+// MustParseNComponent parses the given string into a Version like
+// [ParseNComponent] and panics if it encounters an error.
+func MustParseNComponent(s string) *Version {
+	v, err := ParseNComponent(s)
+	if err != nil {
+		panic(fmt.Sprintf("failed to parse the string %q into a version: %v", s, err))
+	}
+
+	return v
+}
+
+// ParseNComponent parses the given string into a Version like [Parse] but it
+// also accepts versions with more than three version numbers, like "1.2.3.4".
+// The extra version numbers are folded into the beginning of the build
+// metadata, so "1.2.3.4-beta+linux" is parsed as "1.2.3-beta+4.linux". As the
+// build metadata doesn't affect precedence, the extra version numbers are not
+// considered when comparing the versions.
+func ParseNComponent(s string) (*Version, error) {
+	if s == "" {
+		return Parse(s)
+	}
+
+	pos, err := stripPrefix(s)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse version: %w", err)
+	}
+
+	i := len(s)
+
+	for j := range s[pos:] {
+		c := s[pos+j]
+		if !isDigit(c) && c != '.' {
+			i = pos + j
+
+			break
+		}
+	}
+
+	nums := strings.Split(s[pos:i], ".")
+	if len(nums) <= 3 { //nolint:mnd // <major>.<minor>.<patch>
+		return Parse(s)
+	}
+
+	extra := nums[3:]
+
+	for _, n := range extra {
+		if n == "" {
+			return nil, fmt.Errorf("failed to parse version: %w: empty version number in %q", ErrInvalidVersion, s)
+		}
+	}
+
+	rest, build, hasBuild := strings.Cut(s[i:], "+")
+
+	var sb strings.Builder
+
+	sb.WriteString(s[:pos])
+	sb.WriteString(strings.Join(nums[:3], "."))
+	sb.WriteString(rest)
+	sb.WriteByte('+')
+	sb.WriteString(strings.Join(extra, "."))
+
+	if hasBuild {
+		sb.WriteByte('.')
+		sb.WriteString(build)
+	}
+
+	return Parse(sb.String())
+}
@@ -0,0 +1,62 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver_test
+
+import (
+	"testing"
+
+	"github.com/anttikivi/semver"
+)
+
+func TestParseNComponent(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		s       string
+		want    string
+		wantErr bool
+	}{
+		{"1.2.3", "1.2.3", false},
+		{"1.2.3.4", "1.2.3+4", false},
+		{"v10.0.19041.1110", "10.0.19041+1110", false},
+		{"1.2.3.4.5-beta+linux", "1.2.3-beta+4.5.linux", false},
+		{"1.2.3.04", "1.2.3+04", false},
+		{"1.2", "", true},
+		{"1.2.3..4", "", true},
+		{"1.2.3.4.", "", true},
+		{"1.2.3.4+", "", true},
+		{"", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := semver.ParseNComponent(tt.s)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseNComponent(%q) error = %v, wantErr %v", tt.s, err, tt.wantErr)
+			}
+
+			if err == nil && got.String() != tt.want {
+				t.Errorf("ParseNComponent(%q) = %q, want %q", tt.s, got, tt.want)
+			}
+		})
+	}
+}
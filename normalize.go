@@ -0,0 +1,116 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver
+
+import (
+	"slices"
+	"strings"
+)
+
+// A NormalizationReport describes the entries that did not conform to
+// semantic versioning in [NormalizeVersions].
+type NormalizationReport struct {
+	// Changed are the entries that were not in the canonical form but could be
+	// converted into a canonical version string. For example, entries with
+	// a "v" prefix or partial versions like "1.2" are reported here.
+	Changed []NormalizationEntry
+
+	// Invalid are the entries that could not be converted into a version
+	// string. They are not included in the normalized versions.
+	Invalid []NormalizationEntry
+}
+
+// A NormalizationEntry is a single entry reported by [NormalizeVersions].
+type NormalizationEntry struct {
+	// Err is the error from parsing the raw value. It is nil for changed
+	// entries that were valid versions but not in the canonical form.
+	Err error
+
+	// Key is the key of the entry in the input map.
+	Key string
+
+	// Raw is the original value of the entry.
+	Raw string
+
+	// Canonical is the canonical version string for the entry. It is empty for
+	// invalid entries.
+	Canonical string
+}
+
+// NormalizeVersions converts the raw version strings in versions into
+// canonical version strings where possible. The keys of the map are opaque
+// identifiers of the entries, for example, package names qualified with their
+// ecosystem as in a software bill of materials. The returned map contains
+// the canonical version strings for the keys whose values could be converted,
+// and the report lists the entries that were changed or could not be
+// converted. The entries in the report are sorted by their keys.
+//
+// A value that is a valid version string is converted using [Parse] and
+// a value that is a partial version is converted using [ParseLax]. The
+// surrounding white space is removed from the values before parsing.
+func NormalizeVersions(versions map[string]string) (map[string]string, NormalizationReport) {
+	normalized := make(map[string]string, len(versions))
+	report := NormalizationReport{Changed: nil, Invalid: nil}
+
+	for key, raw := range versions {
+		s := strings.TrimSpace(raw)
+
+		v, err := Parse(s)
+		if err != nil {
+			var laxErr error
+			if v, laxErr = ParseLax(s); laxErr != nil {
+				report.Invalid = append(report.Invalid, NormalizationEntry{
+					Err:       err,
+					Key:       key,
+					Raw:       raw,
+					Canonical: "",
+				})
+
+				continue
+			}
+		}
+
+		canonical := v.String()
+		normalized[key] = canonical
+
+		if canonical != raw {
+			report.Changed = append(report.Changed, NormalizationEntry{
+				Err:       err,
+				Key:       key,
+				Raw:       raw,
+				Canonical: canonical,
+			})
+		}
+	}
+
+	byKey := func(a, b NormalizationEntry) int {
+		return strings.Compare(a.Key, b.Key)
+	}
+
+	slices.SortFunc(report.Changed, byKey)
+	slices.SortFunc(report.Invalid, byKey)
+
+	return normalized, report
+}
+
+// OK reports whether every entry was already a canonical version string.
+func (r NormalizationReport) OK() bool {
+	return len(r.Changed) == 0 && len(r.Invalid) == 0
+}
@@ -0,0 +1,91 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/anttikivi/semver"
+)
+
+func TestNormalizeVersions(t *testing.T) {
+	t.Parallel()
+
+	input := map[string]string{
+		"npm:a":    "1.2.3",
+		"npm:b":    "v1.2.3-beta.1",
+		"golang:c": "v1.2",
+		"pypi:d":   " 2.0.0 ",
+		"pypi:e":   "2.0.0.post1",
+		"maven:f":  "",
+		"cargo:g":  "1.0.0+build.1",
+	}
+
+	got, report := semver.NormalizeVersions(input)
+
+	want := map[string]string{
+		"npm:a":    "1.2.3",
+		"npm:b":    "1.2.3-beta.1",
+		"golang:c": "1.2.0",
+		"pypi:d":   "2.0.0",
+		"cargo:g":  "1.0.0+build.1",
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("NormalizeVersions() = %v, want %v", got, want)
+	}
+
+	if report.OK() {
+		t.Error("NormalizationReport.OK() = true, want false")
+	}
+
+	changed := make([]string, len(report.Changed))
+	for i, e := range report.Changed {
+		changed[i] = e.Key + "=" + e.Canonical
+	}
+
+	wantChanged := []string{"golang:c=1.2.0", "npm:b=1.2.3-beta.1", "pypi:d=2.0.0"}
+	if !reflect.DeepEqual(changed, wantChanged) {
+		t.Errorf("NormalizeVersions() changed = %v, want %v", changed, wantChanged)
+	}
+
+	if report.Changed[0].Err == nil || report.Changed[1].Err != nil {
+		t.Errorf("NormalizeVersions() changed errors = %v, %v, want error and nil", report.Changed[0].Err, report.Changed[1].Err)
+	}
+
+	invalid := make([]string, len(report.Invalid))
+	for i, e := range report.Invalid {
+		invalid[i] = e.Key
+
+		if !errors.Is(e.Err, semver.ErrInvalidVersion) {
+			t.Errorf("NormalizeVersions() error for %q = %v, want ErrInvalidVersion", e.Key, e.Err)
+		}
+	}
+
+	if wantInvalid := []string{"maven:f", "pypi:e"}; !reflect.DeepEqual(invalid, wantInvalid) {
+		t.Errorf("NormalizeVersions() invalid = %v, want %v", invalid, wantInvalid)
+	}
+
+	if _, report = semver.NormalizeVersions(map[string]string{"a": "1.0.0"}); !report.OK() {
+		t.Errorf("NormalizeVersions() report = %+v for canonical input, want OK", report)
+	}
+}
@@ -0,0 +1,73 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrCannotPromote is returned when a version cannot be promoted according to
+// the promotion rules.
+var ErrCannotPromote = errors.New("cannot promote version")
+
+// PromotionRules are the rules that a pre-release version must follow for it
+// to be promoted to a release. The zero value only requires the version to be
+// a pre-release.
+type PromotionRules struct {
+	// Label is the required label of the pre-release, i.e. its first
+	// identifier, for example "rc". The comparison is case-sensitive. If
+	// Label is empty, a pre-release with any label can be promoted.
+	Label string
+
+	// Released are the versions that have already been released. A version
+	// can't be promoted if its release version is in Released.
+	Released Versions
+}
+
+// Promote returns the release version of v, i.e. v without the pre-release
+// identifiers and the build metadata. For example, "1.2.3-rc.3" is promoted to
+// "1.2.3".
+func Promote(v *Version) *Version {
+	return coreVersion(v.Major, v.Minor, v.Patch)
+}
+
+// CanPromote checks whether v can be promoted to a release according to
+// rules. It returns nil if v can be promoted, and otherwise an error that wraps
+// ErrCannotPromote.
+func CanPromote(v *Version, rules PromotionRules) error {
+	if len(v.Prerelease) == 0 {
+		return fmt.Errorf("%w: %s is not a pre-release", ErrCannotPromote, v)
+	}
+
+	if rules.Label != "" && v.Prerelease[0].String() != rules.Label {
+		return fmt.Errorf("%w: %s does not have the pre-release label %q", ErrCannotPromote, v, rules.Label)
+	}
+
+	p := Promote(v)
+
+	for _, r := range rules.Released {
+		if r.Equal(p) {
+			return fmt.Errorf("%w: %s has already been released", ErrCannotPromote, p)
+		}
+	}
+
+	return nil
+}
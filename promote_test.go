@@ -0,0 +1,77 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/anttikivi/semver"
+)
+
+func TestPromote(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		v    string
+		want string
+	}{
+		{"1.2.3-rc.3", "1.2.3"},
+		{"1.2.3-beta+build.5", "1.2.3"},
+		{"1.2.3", "1.2.3"},
+	}
+
+	for _, tt := range tests {
+		if got := semver.Promote(semver.MustParse(tt.v)); got.String() != tt.want {
+			t.Errorf("Promote(%q) = %q, want %q", tt.v, got, tt.want)
+		}
+	}
+}
+
+func TestCanPromote(t *testing.T) {
+	t.Parallel()
+
+	rules := semver.PromotionRules{
+		Label:    "rc",
+		Released: semver.Versions{semver.MustParse("1.0.0")},
+	}
+
+	tests := []struct {
+		v    string
+		want bool
+	}{
+		{"1.2.3-rc.3", true},
+		{"1.2.3-rc", true},
+		{"1.2.3-beta.1", false},
+		{"1.2.3", false},
+		{"1.0.0-rc.1", false},
+	}
+
+	for _, tt := range tests {
+		err := semver.CanPromote(semver.MustParse(tt.v), rules)
+
+		switch {
+		case tt.want && err != nil:
+			t.Errorf("CanPromote(%q) failed unexpectedly: %v", tt.v, err)
+		case !tt.want && !errors.Is(err, semver.ErrCannotPromote):
+			t.Errorf("CanPromote(%q) error = %v, want ErrCannotPromote", tt.v, err)
+		}
+	}
+}
@@ -0,0 +1,190 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver
+
+import (
+	"slices"
+	"sync"
+)
+
+// A RangeIndex indexes constraints with payloads, like the affected ranges of
+// security advisories, for finding the constraints that a version satisfies
+// without checking all of them. The index is an interval tree over
+// the intervals of the constraints: a query takes O(log n + k) time for n
+// intervals and k matching ones. The zero value is an empty index.
+//
+// The index is built on the first call to [RangeIndex.Match] after
+// [RangeIndex.Add]. Match is safe for concurrent use, but Add must not be
+// called concurrently with other methods.
+type RangeIndex[T any] struct {
+	constraints []*Constraint
+	payloads    []T
+
+	// mu guards building the tree.
+	mu sync.Mutex
+
+	// entries are the intervals of the constraints sorted by their lower
+	// bounds. They form an implicit balanced binary search tree where
+	// the root of entries[lo:hi] is at the index (lo+hi)/2.
+	entries []rangeEntry
+
+	// built reports whether entries are up to date.
+	built bool
+}
+
+// A rangeEntry is an interval of a constraint in a RangeIndex.
+type rangeEntry struct {
+	interval Interval
+
+	// maxUpper is the greatest upper bound in the subtree of the entry.
+	maxUpper Bound
+
+	// id is the index of the constraint.
+	id int
+}
+
+// Add adds the constraint c with the payload to the index. A constraint that
+// no version satisfies is never matched.
+func (x *RangeIndex[T]) Add(c *Constraint, payload T) {
+	x.constraints = append(x.constraints, c)
+	x.payloads = append(x.payloads, payload)
+	x.built = false
+}
+
+// Len returns the number of constraints in the index.
+func (x *RangeIndex[T]) Len() int {
+	return len(x.constraints)
+}
+
+// Match returns the payloads of the constraints that v satisfies in the order
+// the constraints were added. The options are passed to [Constraint.Check], so
+// the result is the same as checking every constraint.
+func (x *RangeIndex[T]) Match(v *Version, opts ...SatisfyOption) []T {
+	x.build()
+
+	var ids []int
+
+	x.search(v, 0, len(x.entries), &ids)
+
+	if len(ids) == 0 {
+		return nil
+	}
+
+	slices.Sort(ids)
+
+	result := make([]T, 0, len(ids))
+
+	for _, id := range ids {
+		if x.constraints[id].Check(v, opts...) {
+			result = append(result, x.payloads[id])
+		}
+	}
+
+	return result
+}
+
+// build builds the interval tree if it is not up to date.
+func (x *RangeIndex[T]) build() {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+
+	if x.built {
+		return
+	}
+
+	x.entries = x.entries[:0]
+
+	for id, c := range x.constraints {
+		var all []Interval
+		for _, set := range c.sets {
+			all = append(all, rangeIntervals(set)...)
+		}
+
+		for _, i := range mergeIntervals(all) {
+			x.entries = append(x.entries, rangeEntry{interval: i, maxUpper: i.Upper, id: id})
+		}
+	}
+
+	slices.SortFunc(x.entries, func(a, b rangeEntry) int {
+		return compareLowerBounds(a.interval.Lower, b.interval.Lower)
+	})
+
+	// The index is empty if it has no constraints or if no version
+	// satisfies them.
+	if len(x.entries) > 0 {
+		x.fillMaxUpper(0, len(x.entries))
+	}
+
+	x.built = true
+}
+
+// fillMaxUpper computes the greatest upper bounds of the subtree
+// entries[lo:hi] and returns it. The subtree must not be empty.
+func (x *RangeIndex[T]) fillMaxUpper(lo, hi int) Bound {
+	mid := (lo + hi) / 2 //nolint:mnd // the middle of the range
+	e := &x.entries[mid]
+
+	e.maxUpper = e.interval.Upper
+
+	if lo < mid {
+		if b := x.fillMaxUpper(lo, mid); compareUpperBounds(b, e.maxUpper) > 0 {
+			e.maxUpper = b
+		}
+	}
+
+	if mid+1 < hi {
+		if b := x.fillMaxUpper(mid+1, hi); compareUpperBounds(b, e.maxUpper) > 0 {
+			e.maxUpper = b
+		}
+	}
+
+	return e.maxUpper
+}
+
+// search adds the ids of the constraints of the intervals in the subtree
+// entries[lo:hi] that contain v to ids.
+func (x *RangeIndex[T]) search(v *Version, lo, hi int, ids *[]int) {
+	if lo >= hi {
+		return
+	}
+
+	mid := (lo + hi) / 2 //nolint:mnd // the middle of the range
+	e := &x.entries[mid]
+
+	unbounded := Bound{Version: nil, Inclusive: false}
+
+	// No interval in the subtree reaches v.
+	if !(Interval{Lower: unbounded, Upper: e.maxUpper}).Contains(v) {
+		return
+	}
+
+	x.search(v, lo, mid, ids)
+
+	// The intervals in the right subtree start at or after this one.
+	if !(Interval{Lower: e.interval.Lower, Upper: unbounded}).Contains(v) {
+		return
+	}
+
+	if e.interval.Contains(v) {
+		*ids = append(*ids, e.id)
+	}
+
+	x.search(v, mid+1, hi, ids)
+}
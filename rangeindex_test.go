@@ -0,0 +1,142 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver_test
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/anttikivi/semver"
+)
+
+func TestRangeIndex(t *testing.T) {
+	t.Parallel()
+
+	var x semver.RangeIndex[string]
+
+	x.Add(semver.MustParseConstraint("<1.2.5 || >=2.0.0 <2.0.3"), "GHSA-1")
+	x.Add(semver.MustParseConstraint("^1.2.0"), "GHSA-2")
+	x.Add(semver.MustParseConstraint(">=3.0.0-0 <3.0.1"), "GHSA-3")
+	x.Add(semver.MustParseConstraint(">2.0.0 <2.0.0"), "never")
+
+	tests := []struct {
+		v    string
+		want []string
+	}{
+		{"0.1.0", []string{"GHSA-1"}},
+		{"1.2.4", []string{"GHSA-1", "GHSA-2"}},
+		{"1.9.0", []string{"GHSA-2"}},
+		{"2.0.1", []string{"GHSA-1"}},
+		{"3.0.0-beta.1", []string{"GHSA-3"}},
+		{"1.2.6-rc.1", nil},
+		{"4.0.0", nil},
+	}
+
+	for _, tt := range tests {
+		if got := x.Match(semver.MustParse(tt.v)); !slices.Equal(got, tt.want) {
+			t.Errorf("Match(%q) = %q, want %q", tt.v, got, tt.want)
+		}
+	}
+
+	x.Add(semver.MustParseConstraint("*"), "all")
+
+	if got := x.Match(semver.MustParse("4.0.0")); !slices.Equal(got, []string{"all"}) {
+		t.Errorf("Match(4.0.0) after Add() = %q, want [all]", got)
+	}
+
+	got := x.Match(semver.MustParse("1.2.6-rc.1"), semver.IncludePrereleases())
+	if want := []string{"GHSA-2", "all"}; !slices.Equal(got, want) {
+		t.Errorf("Match(1.2.6-rc.1, IncludePrereleases()) = %q, want %q", got, want)
+	}
+}
+
+func TestRangeIndexEmpty(t *testing.T) {
+	t.Parallel()
+
+	v := semver.MustParse("1.0.0")
+
+	var x semver.RangeIndex[string]
+
+	if got := x.Match(v); got != nil {
+		t.Errorf("Match(%s) on the zero value = %q, want nil", v, got)
+	}
+
+	x.Add(semver.MustParseConstraint(">2.0.0 <1.0.0"), "never")
+
+	if got := x.Match(v); got != nil {
+		t.Errorf("Match(%s) with an unsatisfiable constraint = %q, want nil", v, got)
+	}
+}
+
+func TestRangeIndexMatchesCheck(t *testing.T) {
+	t.Parallel()
+
+	r := rand.New(rand.NewPCG(1, 2)) //nolint:gosec // deterministic test data
+
+	var (
+		x           semver.RangeIndex[int]
+		constraints []*semver.Constraint
+	)
+
+	for i := range 200 {
+		c := semver.MustParseConstraint(fmt.Sprintf(
+			">=%d.%d.0 <%d.%d.0 || =%d.0.%d",
+			r.IntN(5), r.IntN(5), r.IntN(5)+1, r.IntN(5), r.IntN(5), r.IntN(5),
+		))
+		constraints = append(constraints, c)
+		x.Add(c, i)
+	}
+
+	for range 500 {
+		v := semver.MustParse(fmt.Sprintf("%d.%d.%d", r.IntN(6), r.IntN(6), r.IntN(6)))
+
+		var want []int
+
+		for i, c := range constraints {
+			if c.Check(v) {
+				want = append(want, i)
+			}
+		}
+
+		if got := x.Match(v); !slices.Equal(got, want) {
+			t.Fatalf("Match(%s) = %v, want %v", v, got, want)
+		}
+	}
+}
+
+func BenchmarkRangeIndexMatch(b *testing.B) {
+	r := rand.New(rand.NewPCG(1, 2)) //nolint:gosec // deterministic test data
+
+	var x semver.RangeIndex[int]
+
+	for i := range 5000 {
+		major, minor := r.IntN(100), r.IntN(20)
+		c := fmt.Sprintf(">=%d.%d.0 <%d.%d.5", major, minor, major, minor)
+		x.Add(semver.MustParseConstraint(c), i)
+	}
+
+	v := semver.MustParse("50.10.2")
+
+	for b.Loop() {
+		x.Match(v)
+	}
+}
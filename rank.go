@@ -0,0 +1,55 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver
+
+// RankIn returns how far behind the latest releases in history v is, for
+// example for reporting the state of a fleet of clients. behindMajors is
+// the number of different major versions in history that are greater than
+// the major version of v, behindMinors is the number of different minor
+// version series that are newer than the series of v, and behindPatches is
+// the number of different releases that are newer than v. The pre-release
+// versions in history are ignored, and the build metadata doesn't affect
+// the counts. For example, with the history "1.2.0", "1.2.1", "1.3.0", and
+// "2.0.0", the version "1.2.0" is 1 major, 2 minors, and 3 patches behind.
+func RankIn(v *Version, history Versions) (int, int, int) {
+	var (
+		majors  = make(map[uint64]struct{})
+		minors  = make(map[[2]uint64]struct{})
+		patches = make(map[[3]uint64]struct{})
+	)
+
+	for _, w := range history {
+		if len(w.Prerelease) > 0 || w.Compare(v) <= 0 {
+			continue
+		}
+
+		patches[[3]uint64{w.Major, w.Minor, w.Patch}] = struct{}{}
+
+		if w.Major > v.Major || w.Major == v.Major && w.Minor > v.Minor {
+			minors[[2]uint64{w.Major, w.Minor}] = struct{}{}
+		}
+
+		if w.Major > v.Major {
+			majors[w.Major] = struct{}{}
+		}
+	}
+
+	return len(majors), len(minors), len(patches)
+}
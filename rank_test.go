@@ -0,0 +1,68 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver_test
+
+import (
+	"testing"
+
+	"github.com/anttikivi/semver"
+)
+
+func TestRankIn(t *testing.T) {
+	t.Parallel()
+
+	history := semver.Versions{
+		semver.MustParse("1.2.0"),
+		semver.MustParse("1.2.1"),
+		semver.MustParse("1.3.0"),
+		semver.MustParse("1.3.0+build.2"),
+		semver.MustParse("2.0.0-rc.1"),
+		semver.MustParse("2.0.0"),
+		semver.MustParse("2.1.0"),
+		semver.MustParse("3.0.0-beta.1"),
+	}
+
+	tests := []struct {
+		v                                   string
+		wantMajors, wantMinors, wantPatches int
+	}{
+		{"1.2.0", 1, 3, 4},
+		{"1.3.0-rc.1", 1, 2, 3},
+		{"2.0.0", 0, 1, 1},
+		{"2.1.0", 0, 0, 0},
+		{"3.0.0", 0, 0, 0},
+	}
+
+	for _, tt := range tests {
+		majors, minors, patches := semver.RankIn(semver.MustParse(tt.v), history)
+		if majors != tt.wantMajors || minors != tt.wantMinors || patches != tt.wantPatches {
+			t.Errorf(
+				"RankIn(%q) = %d, %d, %d, want %d, %d, %d",
+				tt.v,
+				majors,
+				minors,
+				patches,
+				tt.wantMajors,
+				tt.wantMinors,
+				tt.wantPatches,
+			)
+		}
+	}
+}
@@ -0,0 +1,44 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver
+
+import "strconv"
+
+// Redact returns the string representation of v with the parts that are less
+// significant than level masked, for telemetry that must not report versions
+// more precisely than needed. At LevelMajor, "1.4.7-rc.1+build.5" is redacted
+// to "1.x", at LevelMinor to "1.4.x", at LevelPatch to "1.4.7", and at
+// LevelPrerelease to "1.4.7-rc.1". At LevelBuild and LevelNone, nothing is
+// masked.
+func (v *Version) Redact(level ReleaseLevel) string {
+	switch level {
+	case LevelMajor:
+		return strconv.FormatUint(v.Major, 10) + ".x"
+	case LevelMinor:
+		return strconv.FormatUint(v.Major, 10) + "." + strconv.FormatUint(v.Minor, 10) + ".x"
+	case LevelPatch:
+		return v.CoreString()
+	case LevelPrerelease:
+		return v.ComparableString()
+	case LevelNone, LevelBuild:
+	}
+
+	return v.String()
+}
@@ -0,0 +1,50 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver_test
+
+import (
+	"testing"
+
+	"github.com/anttikivi/semver"
+)
+
+func TestVersionRedact(t *testing.T) {
+	t.Parallel()
+
+	v := semver.MustParse("1.4.7-rc.1+build.5")
+
+	tests := []struct {
+		level semver.ReleaseLevel
+		want  string
+	}{
+		{semver.LevelMajor, "1.x"},
+		{semver.LevelMinor, "1.4.x"},
+		{semver.LevelPatch, "1.4.7"},
+		{semver.LevelPrerelease, "1.4.7-rc.1"},
+		{semver.LevelBuild, "1.4.7-rc.1+build.5"},
+		{semver.LevelNone, "1.4.7-rc.1+build.5"},
+	}
+
+	for _, tt := range tests {
+		if got := v.Redact(tt.level); got != tt.want {
+			t.Errorf("Redact(%v) = %q, want %q", tt.level, got, tt.want)
+		}
+	}
+}
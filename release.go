@@ -0,0 +1,95 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver
+
+import (
+	"slices"
+	"time"
+)
+
+// A Release is a version with the metadata of its release, as reported by
+// package registries.
+type Release struct {
+	// Version is the version of the release.
+	Version *Version
+
+	// Time is the time when the release was published.
+	Time time.Time
+
+	// Yanked reports whether the release has been withdrawn from use.
+	Yanked bool
+
+	// Channel is the name of the release channel the release was
+	// published to, for example "stable" or "beta". It may be empty.
+	Channel string
+}
+
+// Releases is a slice of releases.
+type Releases []Release
+
+// Versions returns the versions of the releases in the same order.
+func (r Releases) Versions() Versions {
+	vs := make(Versions, len(r))
+	for i := range r {
+		vs[i] = r[i].Version
+	}
+
+	return vs
+}
+
+// LatestNotYanked returns the release with the highest version precedence that
+// is not yanked, and reports whether such release was found.
+func (r Releases) LatestNotYanked() (Release, bool) {
+	var (
+		latest Release
+		found  bool
+	)
+
+	for _, rel := range r {
+		if rel.Yanked || (found && rel.Version.Compare(latest.Version) <= 0) {
+			continue
+		}
+
+		latest = rel
+		found = true
+	}
+
+	return latest, found
+}
+
+// SortByVersion sorts the releases in increasing order of version precedence.
+// The releases with equal precedence keep their original order.
+func (r Releases) SortByVersion() {
+	slices.SortStableFunc(r, func(a, b Release) int {
+		return a.Version.Compare(b.Version)
+	})
+}
+
+// SortByTime sorts the releases in increasing order of their release time.
+// The releases released at the same time are sorted by version precedence.
+func (r Releases) SortByTime() {
+	slices.SortStableFunc(r, func(a, b Release) int {
+		if c := a.Time.Compare(b.Time); c != 0 {
+			return c
+		}
+
+		return a.Version.Compare(b.Version)
+	})
+}
@@ -0,0 +1,70 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver_test
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/anttikivi/semver"
+)
+
+func testReleases() semver.Releases {
+	t0 := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	return semver.Releases{
+		{Version: semver.MustParse("1.1.0"), Time: t0.Add(48 * time.Hour), Yanked: false, Channel: "stable"},
+		{Version: semver.MustParse("2.0.0"), Time: t0.Add(24 * time.Hour), Yanked: true, Channel: "stable"},
+		{Version: semver.MustParse("1.0.0"), Time: t0, Yanked: false, Channel: "stable"},
+		{Version: semver.MustParse("1.2.0-beta.1"), Time: t0.Add(72 * time.Hour), Yanked: false, Channel: "beta"},
+	}
+}
+
+func TestReleasesLatestNotYanked(t *testing.T) {
+	t.Parallel()
+
+	r, ok := testReleases().LatestNotYanked()
+	if !ok || r.Version.String() != "1.2.0-beta.1" {
+		t.Errorf("LatestNotYanked() = %v, %v, want 1.2.0-beta.1, true", r.Version, ok)
+	}
+
+	if _, ok := (semver.Releases{{Version: semver.MustParse("1.0.0"), Yanked: true}}).LatestNotYanked(); ok {
+		t.Error("LatestNotYanked() with only yanked releases reported a release")
+	}
+}
+
+func TestReleasesSort(t *testing.T) {
+	t.Parallel()
+
+	r := testReleases()
+
+	r.SortByVersion()
+
+	if got, want := versionStrings(r.Versions()), []string{"1.0.0", "1.1.0", "1.2.0-beta.1", "2.0.0"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("SortByVersion() = %v, want %v", got, want)
+	}
+
+	r.SortByTime()
+
+	if got, want := versionStrings(r.Versions()), []string{"1.0.0", "2.0.0", "1.1.0", "1.2.0-beta.1"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("SortByTime() = %v, want %v", got, want)
+	}
+}
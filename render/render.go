@@ -0,0 +1,121 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+// Package render formats versions for display in documents, for example as
+// the badges that release tooling updates in README files.
+package render
+
+import (
+	"html"
+	"net/url"
+	"strings"
+
+	"github.com/anttikivi/semver"
+	"github.com/anttikivi/semver/updates"
+)
+
+// ShieldsBaseURL is the base URL of the static badges of shields.io.
+const ShieldsBaseURL = "https://img.shields.io"
+
+// A Badge is the text and color of a version badge.
+type Badge struct {
+	// Label is the text on the left side of the badge.
+	Label string
+
+	// Message is the text on the right side of the badge.
+	Message string
+
+	// Color is the color of the right side of the badge. It is a color name
+	// or a hex color without the '#' supported by shields.io.
+	Color string
+}
+
+// NewBadge returns a badge with the given label for version v that is released
+// in the given channel. If channel is empty, the channel is derived from v
+// using [updates.ChannelOf]. The message of the badge is v with a "v" prefix,
+// and the color depends on the stability of the channel.
+func NewBadge(label string, v *semver.Version, channel string) Badge {
+	if channel == "" {
+		channel = updates.ChannelOf(v)
+	}
+
+	return Badge{Label: label, Message: "v" + v.String(), Color: ChannelColor(channel)}
+}
+
+// ChannelColor returns the badge color for the given release channel.
+func ChannelColor(channel string) string {
+	switch strings.ToLower(channel) {
+	case updates.Stable:
+		return "blue"
+	case updates.RC:
+		return "yellowgreen"
+	case updates.Beta:
+		return "yellow"
+	case updates.Alpha:
+		return "orange"
+	default:
+		return "lightgrey"
+	}
+}
+
+// ShieldsPath returns the path of the static shields.io badge, for example
+// "/badge/release-v1.2.3--rc.1-yellowgreen".
+func (b Badge) ShieldsPath() string {
+	return "/badge/" + shieldsEscape(b.Label) + "-" + shieldsEscape(b.Message) + "-" + shieldsEscape(b.Color)
+}
+
+// ShieldsURL returns the URL of the static shields.io badge.
+func (b Badge) ShieldsURL() string {
+	return ShieldsBaseURL + b.ShieldsPath()
+}
+
+// Markdown returns the badge as a Markdown image. If link is not empty,
+// the image links to it.
+func (b Badge) Markdown(link string) string {
+	img := "![" + strings.NewReplacer(`[`, `\[`, `]`, `\]`).Replace(b.Label) + "](" + b.ShieldsURL() + ")"
+	if link == "" {
+		return img
+	}
+
+	return "[" + img + "](" + link + ")"
+}
+
+// HTML returns the badge as an HTML image. If link is not empty, the image
+// links to it.
+func (b Badge) HTML(link string) string {
+	img := `<img alt="` + html.EscapeString(b.Label) + `" src="` + html.EscapeString(b.ShieldsURL()) + `">`
+	if link == "" {
+		return img
+	}
+
+	return `<a href="` + html.EscapeString(link) + `">` + img + `</a>`
+}
+
+// String returns the text of the badge, for example "release: v1.2.3".
+func (b Badge) String() string {
+	return b.Label + ": " + b.Message
+}
+
+// shieldsEscape escapes s for a static shields.io badge path where dashes and
+// underscores have special meanings.
+func shieldsEscape(s string) string {
+	s = strings.NewReplacer("-", "--", "_", "__", " ", "_").Replace(s)
+
+	return url.PathEscape(s)
+}
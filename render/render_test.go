@@ -0,0 +1,77 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package render_test
+
+import (
+	"testing"
+
+	"github.com/anttikivi/semver"
+	"github.com/anttikivi/semver/render"
+)
+
+func TestBadge(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		label    string
+		v        string
+		channel  string
+		wantPath string
+	}{
+		{"release", "1.2.3", "", "/badge/release-v1.2.3-blue"},
+		{"release", "1.2.3-rc.1", "", "/badge/release-v1.2.3--rc.1-yellowgreen"},
+		{"latest beta", "2.0.0-beta.2+build.5", "", "/badge/latest_beta-v2.0.0--beta.2+build.5-yellow"},
+		{"nightly", "2.0.0-dev.20250101", "nightly", "/badge/nightly-v2.0.0--dev.20250101-lightgrey"},
+		{"version", "1.0.0", "Alpha", "/badge/version-v1.0.0-orange"},
+	}
+
+	for _, tt := range tests {
+		b := render.NewBadge(tt.label, semver.MustParse(tt.v), tt.channel)
+		if got := b.ShieldsPath(); got != tt.wantPath {
+			t.Errorf("NewBadge(%q, %q, %q).ShieldsPath() = %q, want %q", tt.label, tt.v, tt.channel, got, tt.wantPath)
+		}
+	}
+}
+
+func TestBadgeFormats(t *testing.T) {
+	t.Parallel()
+
+	b := render.NewBadge("release", semver.MustParse("1.2.3"), "")
+
+	if got, want := b.String(), "release: v1.2.3"; got != want {
+		t.Errorf("Badge.String() = %q, want %q", got, want)
+	}
+
+	if got, want := b.Markdown(""), "![release](https://img.shields.io/badge/release-v1.2.3-blue)"; got != want {
+		t.Errorf("Badge.Markdown(\"\") = %q, want %q", got, want)
+	}
+
+	link := "https://example.com/releases?tag=v1.2.3&x=1"
+
+	if got, want := b.Markdown(link), "[![release](https://img.shields.io/badge/release-v1.2.3-blue)]("+link+")"; got != want {
+		t.Errorf("Badge.Markdown(%q) = %q, want %q", link, got, want)
+	}
+
+	want := `<a href="https://example.com/releases?tag=v1.2.3&amp;x=1">` +
+		`<img alt="release" src="https://img.shields.io/badge/release-v1.2.3-blue"></a>`
+	if got := b.HTML(link); got != want {
+		t.Errorf("Badge.HTML(%q) = %q, want %q", link, got, want)
+	}
+}
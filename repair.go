@@ -0,0 +1,303 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Kinds of fixes that [Repair] can apply to version strings.
+const (
+	// FixLeadingZeros is a fix that removes leading zeros from a version
+	// number.
+	FixLeadingZeros FixKind = iota + 1
+
+	// FixSeparators is a fix that replaces the invalid separators between
+	// version numbers, like underscores, with dots and removes whitespace
+	// around them.
+	FixSeparators
+
+	// FixUnicode is a fix that removes a zero-width character or replaces
+	// a full-width character with its ASCII equivalent.
+	FixUnicode
+)
+
+// A FixKind is the kind of a fix applied to a version string.
+type FixKind int
+
+// A Fix records a change that [Repair] made to a version string for it to be
+// parsed.
+type Fix struct {
+	// Kind is the kind of the fix.
+	Kind FixKind
+
+	// Pos is the byte offset of the changed part in the version string as
+	// it was before the fix was applied.
+	Pos int
+
+	// Old is the part of the version string that was changed.
+	Old string
+
+	// New is the part that replaced Old.
+	New string
+}
+
+// A RepairOption enables a fix that [Repair] applies to version strings.
+type RepairOption func(*repairOptions)
+
+// repairOptions holds the fixes enabled for [Repair].
+type repairOptions struct {
+	leadingZeros bool
+	separators   bool
+	unicode      bool
+}
+
+// WithAcceptLeadingZeros returns an option that makes [Repair] accept version
+// numbers with leading zeros in the core version, like "1.02.3", by removing
+// the zeros.
+func WithAcceptLeadingZeros() RepairOption {
+	return func(o *repairOptions) {
+		o.leadingZeros = true
+	}
+}
+
+// WithCoerceSeparators returns an option that makes [Repair] accept
+// underscores and whitespace as the separators between the core version
+// numbers, like "1_2_3" or "1 .2. 3", by replacing them with dots. Whitespace
+// around the core version is removed.
+func WithCoerceSeparators() RepairOption {
+	return func(o *repairOptions) {
+		o.separators = true
+	}
+}
+
+// WithNormalizeUnicode returns an option that makes [Repair] remove
+// zero-width characters from the version string and replace full-width
+// characters, like the full-width digits, with their ASCII equivalents. This
+// is done before the other fixes.
+func WithNormalizeUnicode() RepairOption {
+	return func(o *repairOptions) {
+		o.unicode = true
+	}
+}
+
+// Repair parses the given string into a Version like [ParseLax] after applying
+// the fixes enabled by the options to it. It returns the fixes that were
+// needed for parsing the version in the order they were applied. If no fixes
+// were needed, the returned slice is empty. The strict parsing functions are
+// not affected by the fixes.
+func Repair(s string, opts ...RepairOption) (*Version, []Fix, error) {
+	var o repairOptions
+
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	var fixes []Fix
+
+	if o.unicode {
+		s, fixes = fixUnicode(s, fixes)
+	}
+
+	if o.separators {
+		s, fixes = fixSeparators(s, fixes)
+	}
+
+	if o.leadingZeros {
+		s, fixes = fixLeadingZeros(s, fixes)
+	}
+
+	v, err := ParseLax(s)
+	if err != nil {
+		return nil, fixes, fmt.Errorf("failed to repair version: %w", err)
+	}
+
+	return v, fixes, nil
+}
+
+// String returns the string representation of the fix kind.
+func (k FixKind) String() string {
+	switch k {
+	case FixLeadingZeros:
+		return "leading zeros"
+	case FixSeparators:
+		return "separators"
+	case FixUnicode:
+		return "unicode"
+	default:
+		return fmt.Sprintf("FixKind(%d)", int(k))
+	}
+}
+
+// String returns a description of the fix.
+func (f Fix) String() string {
+	return fmt.Sprintf("%s: replaced %q with %q at %d", f.Kind, f.Old, f.New, f.Pos)
+}
+
+// coreBounds returns the start and the end positions of the core version in s.
+func coreBounds(s string) (int, int) {
+	start := 0
+	if strings.HasPrefix(s, "v") {
+		start = 1
+	}
+
+	end := start
+	for end < len(s) && (isDigit(s[end]) || s[end] == '.') {
+		end++
+	}
+
+	return start, end
+}
+
+// fixLeadingZeros removes the leading zeros from the core version numbers of
+// s and appends the applied fixes to fixes.
+func fixLeadingZeros(s string, fixes []Fix) (string, []Fix) {
+	start, end := coreBounds(s)
+
+	var sb strings.Builder
+
+	sb.WriteString(s[:start])
+
+	pos := start
+
+	for i, n := range strings.Split(s[start:end], ".") {
+		if i > 0 {
+			sb.WriteByte('.')
+		}
+
+		trimmed := strings.TrimLeft(n, "0")
+		if trimmed == "" && n != "" {
+			trimmed = "0"
+		}
+
+		if trimmed != n {
+			fixes = append(fixes, Fix{Kind: FixLeadingZeros, Pos: pos, Old: n, New: trimmed})
+		}
+
+		sb.WriteString(trimmed)
+
+		pos += len(n) + 1
+	}
+
+	sb.WriteString(s[end:])
+
+	return sb.String(), fixes
+}
+
+// fixSeparators replaces the underscores and whitespace between the core
+// version numbers of s with dots, removes the whitespace around the core
+// version, and appends the applied fixes to fixes.
+func fixSeparators(s string, fixes []Fix) (string, []Fix) {
+	isSpace := func(c byte) bool {
+		return c == ' ' || c == '\t'
+	}
+
+	var sb strings.Builder
+
+	start := 0
+	for start < len(s) && isSpace(s[start]) {
+		start++
+	}
+
+	if start > 0 {
+		fixes = append(fixes, Fix{Kind: FixSeparators, Pos: 0, Old: s[:start], New: ""})
+	}
+
+	if start < len(s) && s[start] == 'v' {
+		sb.WriteByte('v')
+
+		start++
+	}
+
+	end := start
+	for end < len(s) && (isDigit(s[end]) || s[end] == '.' || s[end] == '_' || isSpace(s[end])) {
+		end++
+	}
+
+	replacer := strings.NewReplacer(" ", "", "\t", "", "_", ".")
+
+	for i := start; i < end; {
+		if isDigit(s[i]) {
+			sb.WriteByte(s[i])
+
+			i++
+
+			continue
+		}
+
+		j := i
+		for j < end && !isDigit(s[j]) {
+			j++
+		}
+
+		run := s[i:j]
+
+		repl := replacer.Replace(run)
+		if repl == "" && i > start && j < end {
+			// Whitespace between two numbers separates them.
+			repl = "."
+		}
+
+		if repl != run {
+			fixes = append(fixes, Fix{Kind: FixSeparators, Pos: i, Old: run, New: repl})
+		}
+
+		sb.WriteString(repl)
+
+		i = j
+	}
+
+	sb.WriteString(s[end:])
+
+	return sb.String(), fixes
+}
+
+// fixUnicode removes the zero-width characters from s, replaces the full-width
+// characters with their ASCII equivalents, and appends the applied fixes to
+// fixes.
+func fixUnicode(s string, fixes []Fix) (string, []Fix) {
+	if isASCII(s) {
+		return s, fixes
+	}
+
+	var sb strings.Builder
+
+	for i, r := range s {
+		var repl string
+
+		switch {
+		case r == '\u200b', r == '\u200c', r == '\u200d', r == '\u2060', r == '\ufeff':
+			repl = ""
+		case r >= '\uff01' && r <= '\uff5e':
+			repl = string(r - '\uff01' + '!')
+		default:
+			sb.WriteRune(r)
+
+			continue
+		}
+
+		fixes = append(fixes, Fix{Kind: FixUnicode, Pos: i, Old: string(r), New: repl})
+
+		sb.WriteString(repl)
+	}
+
+	return sb.String(), fixes
+}
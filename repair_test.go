@@ -0,0 +1,212 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/anttikivi/semver"
+)
+
+func TestRepairLeadingZeros(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		s         string
+		want      string
+		wantFixes []semver.Fix
+		wantErr   bool
+	}{
+		{"1.2.3", "1.2.3", nil, false},
+		{
+			"1.02.3",
+			"1.2.3",
+			[]semver.Fix{{Kind: semver.FixLeadingZeros, Pos: 2, Old: "02", New: "2"}},
+			false,
+		},
+		{
+			"v001.000.0030-rc.1",
+			"1.0.30-rc.1",
+			[]semver.Fix{
+				{Kind: semver.FixLeadingZeros, Pos: 1, Old: "001", New: "1"},
+				{Kind: semver.FixLeadingZeros, Pos: 5, Old: "000", New: "0"},
+				{Kind: semver.FixLeadingZeros, Pos: 9, Old: "0030", New: "30"},
+			},
+			false,
+		},
+		{"01.2", "1.2.0", []semver.Fix{{Kind: semver.FixLeadingZeros, Pos: 0, Old: "01", New: "1"}}, false},
+		{"1.2.3-01", "", nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			t.Parallel()
+
+			got, fixes, err := semver.Repair(tt.s, semver.WithAcceptLeadingZeros())
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Repair(%q) error = %v, wantErr %v", tt.s, err, tt.wantErr)
+			}
+
+			if err != nil {
+				return
+			}
+
+			if got.String() != tt.want {
+				t.Errorf("Repair(%q) = %q, want %q", tt.s, got, tt.want)
+			}
+
+			if !reflect.DeepEqual(fixes, tt.wantFixes) {
+				t.Errorf("Repair(%q) fixes = %v, want %v", tt.s, fixes, tt.wantFixes)
+			}
+		})
+	}
+
+	if _, _, err := semver.Repair("1.02.3"); err == nil {
+		t.Error("Repair(\"1.02.3\") without options succeeded unexpectedly")
+	}
+}
+
+func TestRepairSeparators(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		s         string
+		want      string
+		wantFixes []semver.Fix
+		wantErr   bool
+	}{
+		{
+			"1_2_3",
+			"1.2.3",
+			[]semver.Fix{
+				{Kind: semver.FixSeparators, Pos: 1, Old: "_", New: "."},
+				{Kind: semver.FixSeparators, Pos: 3, Old: "_", New: "."},
+			},
+			false,
+		},
+		{
+			"1 .2. 3",
+			"1.2.3",
+			[]semver.Fix{
+				{Kind: semver.FixSeparators, Pos: 1, Old: " .", New: "."},
+				{Kind: semver.FixSeparators, Pos: 4, Old: ". ", New: "."},
+			},
+			false,
+		},
+		{
+			" v1 2 3 -beta",
+			"1.2.3-beta",
+			[]semver.Fix{
+				{Kind: semver.FixSeparators, Pos: 0, Old: " ", New: ""},
+				{Kind: semver.FixSeparators, Pos: 3, Old: " ", New: "."},
+				{Kind: semver.FixSeparators, Pos: 5, Old: " ", New: "."},
+				{Kind: semver.FixSeparators, Pos: 7, Old: " ", New: ""},
+			},
+			false,
+		},
+		{"1.2.3-beta_1", "", nil, true},
+		{"1__2.3", "", nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			t.Parallel()
+
+			got, fixes, err := semver.Repair(tt.s, semver.WithCoerceSeparators())
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Repair(%q) error = %v, wantErr %v", tt.s, err, tt.wantErr)
+			}
+
+			if err != nil {
+				return
+			}
+
+			if got.String() != tt.want {
+				t.Errorf("Repair(%q) = %q, want %q", tt.s, got, tt.want)
+			}
+
+			if !reflect.DeepEqual(fixes, tt.wantFixes) {
+				t.Errorf("Repair(%q) fixes = %v, want %v", tt.s, fixes, tt.wantFixes)
+			}
+		})
+	}
+
+	got, fixes, err := semver.Repair("1_02_3", semver.WithCoerceSeparators(), semver.WithAcceptLeadingZeros())
+	if err != nil || got.String() != "1.2.3" || len(fixes) != 3 {
+		t.Errorf("Repair(\"1_02_3\") = %v, %v, %v, want 1.2.3 with 3 fixes", got, fixes, err)
+	}
+}
+
+func TestRepairUnicode(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		s         string
+		want      string
+		wantFixes []semver.Fix
+		wantErr   bool
+	}{
+		{"1.2.3", "1.2.3", nil, false},
+		{
+			"\u200b1.2.3\ufeff",
+			"1.2.3",
+			[]semver.Fix{
+				{Kind: semver.FixUnicode, Pos: 0, Old: "\u200b", New: ""},
+				{Kind: semver.FixUnicode, Pos: 8, Old: "\ufeff", New: ""},
+			},
+			false,
+		},
+		{
+			"\uff11.\uff12.3\uff0drc",
+			"1.2.3-rc",
+			[]semver.Fix{
+				{Kind: semver.FixUnicode, Pos: 0, Old: "\uff11", New: "1"},
+				{Kind: semver.FixUnicode, Pos: 4, Old: "\uff12", New: "2"},
+				{Kind: semver.FixUnicode, Pos: 9, Old: "\uff0d", New: "-"},
+			},
+			false,
+		},
+		{"1.2.3-\u03b2", "", nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			t.Parallel()
+
+			got, fixes, err := semver.Repair(tt.s, semver.WithNormalizeUnicode())
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Repair(%q) error = %v, wantErr %v", tt.s, err, tt.wantErr)
+			}
+
+			if err != nil {
+				return
+			}
+
+			if got.String() != tt.want {
+				t.Errorf("Repair(%q) = %q, want %q", tt.s, got, tt.want)
+			}
+
+			if !reflect.DeepEqual(fixes, tt.wantFixes) {
+				t.Errorf("Repair(%q) fixes = %v, want %v", tt.s, fixes, tt.wantFixes)
+			}
+		})
+	}
+}
@@ -0,0 +1,253 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+)
+
+// The strategies for selecting the versions in [Resolve].
+const (
+	// MaximalSelection selects the greatest version that satisfies
+	// the requirement. It is the default strategy.
+	MaximalSelection Strategy = iota
+
+	// MinimalSelection selects the least version that satisfies
+	// the requirement, like the minimal version selection of Go modules. It
+	// makes the selection reproducible without a lock file, as new releases
+	// don't change it.
+	MinimalSelection
+)
+
+// ErrUnresolvable is returned when requirements cannot be resolved.
+var ErrUnresolvable = errors.New("unresolvable requirements")
+
+// A Strategy is a strategy for selecting a version out of the versions that
+// satisfy a requirement.
+type Strategy int
+
+// A ResolveOption is an option for [Resolve].
+type ResolveOption func(*resolveOptions)
+
+// resolveOptions holds the options for Resolve.
+type resolveOptions struct {
+	strategy Strategy
+}
+
+// A ResolveError is returned by [Resolve] when some of the requirements cannot
+// be satisfied.
+type ResolveError struct {
+	// Conflicts are the requirements that cannot be satisfied, sorted by
+	// name.
+	Conflicts []ResolveConflict
+}
+
+// A ResolveConflict describes a requirement that cannot be satisfied.
+type ResolveConflict struct {
+	// Name is the name of the requirement.
+	Name string
+
+	// Constraint is the constraint of the requirement. It is nil if
+	// the requirement has no constraint.
+	Constraint *Constraint
+
+	// Explanation is the chain of reasons why the requirement cannot be
+	// satisfied, starting from the requirement itself. For example:
+	//
+	//	tool requires ^2.0.0
+	//	^2.0.0 allows [2.0.0,3.0.0-0)
+	//	the available versions of tool are from 1.0.0 to 1.5.0
+	Explanation []string
+}
+
+// Resolve selects a version for each of the names in requirements from
+// the versions of the name in available. By default, the greatest available
+// version that satisfies the constraint of the name is selected, and
+// the strategy can be changed using [WithStrategy]. A nil constraint is
+// satisfied by any version that is not a pre-release.
+//
+// If some of the requirements cannot be satisfied, Resolve returns
+// the versions selected for the other names together with a [*ResolveError]
+// that explains the conflicts. The error wraps ErrUnresolvable.
+func Resolve(
+	requirements map[string]*Constraint,
+	available map[string]Versions,
+	opts ...ResolveOption,
+) (map[string]*Version, error) {
+	o := resolveOptions{strategy: MaximalSelection}
+
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	selected := make(map[string]*Version, len(requirements))
+
+	var conflicts []ResolveConflict
+
+	for _, name := range slices.Sorted(maps.Keys(requirements)) {
+		c := requirements[name]
+		if c == nil {
+			c = &Constraint{sets: [][]comparator{anyRange()}, str: "*"}
+		}
+
+		if v := o.selectVersion(c, available[name]); v != nil {
+			selected[name] = v
+
+			continue
+		}
+
+		conflicts = append(conflicts, ResolveConflict{
+			Name:        name,
+			Constraint:  requirements[name],
+			Explanation: explainUnsatisfied(name, c, available[name]),
+		})
+	}
+
+	if len(conflicts) > 0 {
+		return selected, &ResolveError{Conflicts: conflicts}
+	}
+
+	return selected, nil
+}
+
+// WithStrategy sets the strategy for selecting the versions in [Resolve].
+func WithStrategy(s Strategy) ResolveOption {
+	return func(o *resolveOptions) {
+		o.strategy = s
+	}
+}
+
+// Error returns the conflicts with their explanations.
+func (e *ResolveError) Error() string {
+	var sb strings.Builder
+
+	sb.WriteString(ErrUnresolvable.Error())
+
+	for _, c := range e.Conflicts {
+		sb.WriteString("\n")
+		sb.WriteString(c.String())
+	}
+
+	return sb.String()
+}
+
+// Is reports whether target is ErrUnresolvable.
+func (e *ResolveError) Is(target error) bool {
+	return target == ErrUnresolvable
+}
+
+// String returns the explanation of the conflict as lines, each of the reasons
+// indented under the previous one.
+func (c ResolveConflict) String() string {
+	var sb strings.Builder
+
+	for i, line := range c.Explanation {
+		if i > 0 {
+			sb.WriteString("\n")
+		}
+
+		sb.WriteString(strings.Repeat("  ", i+1))
+		sb.WriteString(line)
+	}
+
+	return sb.String()
+}
+
+// String returns the name of the strategy.
+func (s Strategy) String() string {
+	switch s {
+	case MaximalSelection:
+		return "maximal"
+	case MinimalSelection:
+		return "minimal"
+	default:
+		return fmt.Sprintf("Strategy(%d)", int(s))
+	}
+}
+
+// selectVersion selects the version that satisfies c from versions according
+// to the strategy.
+func (o resolveOptions) selectVersion(c *Constraint, versions Versions) *Version {
+	if o.strategy == MinimalSelection {
+		return c.MinSatisfying(versions)
+	}
+
+	return c.MaxSatisfying(versions)
+}
+
+// explainUnsatisfied returns the explanation why none of the versions satisfy
+// constraint c of name.
+func explainUnsatisfied(name string, c *Constraint, versions Versions) []string {
+	explanation := []string{fmt.Sprintf("%s requires %s", name, c)}
+
+	intervals := c.Intervals()
+	if len(intervals) == 0 {
+		return append(explanation, fmt.Sprintf("%s is not satisfied by any version", c))
+	}
+
+	allowed := make([]string, len(intervals))
+	for i, in := range intervals {
+		allowed[i] = in.String()
+	}
+
+	explanation = append(explanation, fmt.Sprintf("%s allows %s", c, strings.Join(allowed, " or ")))
+
+	if len(versions) == 0 {
+		return append(explanation, fmt.Sprintf("no versions of %s are available", name))
+	}
+
+	lowest, highest := versions[0], versions[0]
+	for _, v := range versions[1:] {
+		if v.Compare(lowest) < 0 {
+			lowest = v
+		}
+
+		if v.Compare(highest) > 0 {
+			highest = v
+		}
+	}
+
+	if lowest.Equal(highest) {
+		explanation = append(
+			explanation,
+			fmt.Sprintf("the only available version of %s is %s", name, lowest),
+		)
+	} else {
+		explanation = append(
+			explanation,
+			fmt.Sprintf("the available versions of %s are from %s to %s", name, lowest, highest),
+		)
+	}
+
+	for _, v := range versions {
+		if len(v.Prerelease) > 0 && c.Check(v, IncludePrereleases()) {
+			return append(
+				explanation,
+				fmt.Sprintf("%s is a pre-release that %s doesn't explicitly allow", v, c),
+			)
+		}
+	}
+
+	return explanation
+}
@@ -0,0 +1,144 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver_test
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/anttikivi/semver"
+)
+
+func TestResolve(t *testing.T) {
+	t.Parallel()
+
+	available := map[string]semver.Versions{
+		"a": {semver.MustParse("1.0.0"), semver.MustParse("1.4.2"), semver.MustParse("2.0.0")},
+		"b": {semver.MustParse("0.1.0"), semver.MustParse("0.2.0-beta.1")},
+		"c": {semver.MustParse("3.1.0"), semver.MustParse("3.2.0-rc.1")},
+	}
+
+	requirements := map[string]*semver.Constraint{
+		"a": semver.MustParseConstraint("^1.0.0"),
+		"b": semver.MustParseConstraint(">=0.2.0-beta.1"),
+		"c": nil,
+	}
+
+	got, err := semver.Resolve(requirements, available)
+	if err != nil {
+		t.Fatalf("Resolve() error = %v", err)
+	}
+
+	want := map[string]string{"a": "1.4.2", "b": "0.2.0-beta.1", "c": "3.1.0"}
+	if s := resolvedStrings(got); !reflect.DeepEqual(s, want) {
+		t.Errorf("Resolve() = %v, want %v", s, want)
+	}
+}
+
+func TestResolveConflicts(t *testing.T) {
+	t.Parallel()
+
+	available := map[string]semver.Versions{
+		"a": {semver.MustParse("1.0.0"), semver.MustParse("1.5.0")},
+		"b": {semver.MustParse("2.0.0-rc.1"), semver.MustParse("1.9.0")},
+		"c": {semver.MustParse("1.0.0")},
+	}
+
+	requirements := map[string]*semver.Constraint{
+		"a":       semver.MustParseConstraint("^2.0.0"),
+		"b":       semver.MustParseConstraint(">=1.9.1"),
+		"c":       semver.MustParseConstraint("^1.0.0"),
+		"missing": semver.MustParseConstraint("1.x"),
+	}
+
+	got, err := semver.Resolve(requirements, available)
+	if !errors.Is(err, semver.ErrUnresolvable) {
+		t.Fatalf("Resolve() error = %v, want %v", err, semver.ErrUnresolvable)
+	}
+
+	if s := resolvedStrings(got); !reflect.DeepEqual(s, map[string]string{"c": "1.0.0"}) {
+		t.Errorf("Resolve() = %v, want only c resolved", s)
+	}
+
+	var resolveErr *semver.ResolveError
+	if !errors.As(err, &resolveErr) || len(resolveErr.Conflicts) != 3 {
+		t.Fatalf("Resolve() error = %#v, want 3 conflicts", err)
+	}
+
+	wantFirst := []string{
+		"a requires ^2.0.0",
+		"^2.0.0 allows [2.0.0,3.0.0-0)",
+		"the available versions of a are from 1.0.0 to 1.5.0",
+	}
+	if got := resolveErr.Conflicts[0].Explanation; !reflect.DeepEqual(got, wantFirst) {
+		t.Errorf("Conflicts[0].Explanation = %q, want %q", got, wantFirst)
+	}
+
+	if got := resolveErr.Conflicts[1].Explanation; !strings.Contains(got[len(got)-1], "2.0.0-rc.1 is a pre-release") {
+		t.Errorf("Conflicts[1].Explanation = %q, want a pre-release explanation", got)
+	}
+
+	if got := resolveErr.Conflicts[2].Explanation; got[len(got)-1] != "no versions of missing are available" {
+		t.Errorf("Conflicts[2].Explanation = %q", got)
+	}
+
+	if msg := err.Error(); !strings.Contains(msg, "\n  a requires ^2.0.0\n    ^2.0.0 allows") {
+		t.Errorf("ResolveError.Error() = %q", msg)
+	}
+}
+
+func resolvedStrings(m map[string]*semver.Version) map[string]string {
+	s := make(map[string]string, len(m))
+	for name, v := range m {
+		s[name] = v.String()
+	}
+
+	return s
+}
+
+func TestResolveMinimalSelection(t *testing.T) {
+	t.Parallel()
+
+	available := map[string]semver.Versions{
+		"a": {semver.MustParse("1.4.2"), semver.MustParse("1.0.0"), semver.MustParse("1.2.0")},
+		"b": {semver.MustParse("0.1.0"), semver.MustParse("0.2.0-beta.1"), semver.MustParse("0.2.0")},
+	}
+
+	requirements := map[string]*semver.Constraint{
+		"a": semver.MustParseConstraint(">=1.1.0"),
+		"b": semver.MustParseConstraint(">=0.2.0-beta.1"),
+	}
+
+	got, err := semver.Resolve(requirements, available, semver.WithStrategy(semver.MinimalSelection))
+	if err != nil {
+		t.Fatalf("Resolve() error = %v", err)
+	}
+
+	want := map[string]string{"a": "1.2.0", "b": "0.2.0-beta.1"}
+	if s := resolvedStrings(got); !reflect.DeepEqual(s, want) {
+		t.Errorf("Resolve() = %v, want %v", s, want)
+	}
+
+	if got := semver.MinimalSelection.String(); got != "minimal" {
+		t.Errorf("MinimalSelection.String() = %q, want \"minimal\"", got)
+	}
+}
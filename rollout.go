@@ -0,0 +1,37 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver
+
+// RolloutRing returns the index of the first rollout ring in rings whose
+// constraint v satisfies, and reports whether there was one. The rings should
+// be ordered from the earliest ring of a staged deployment to the latest one,
+// for example "^2.0.0-0" for the canary clients followed by ">=1.8.0" and "*".
+// The options are passed to [Constraint.Check], so [IncludePrereleases] can be
+// used to place the pre-release clients into the rings like the other ones.
+// Nil constraints in rings are skipped.
+func RolloutRing(v *Version, rings []*Constraint, opts ...SatisfyOption) (int, bool) {
+	for i, c := range rings {
+		if c != nil && c.Check(v, opts...) {
+			return i, true
+		}
+	}
+
+	return -1, false
+}
@@ -0,0 +1,59 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver_test
+
+import (
+	"testing"
+
+	"github.com/anttikivi/semver"
+)
+
+func TestRolloutRing(t *testing.T) {
+	t.Parallel()
+
+	rings := []*semver.Constraint{
+		semver.MustParseConstraint(">=2.0.0-0"),
+		nil,
+		semver.MustParseConstraint(">=1.8.0"),
+		semver.MustParseConstraint(">=1.0.0"),
+	}
+
+	tests := []struct {
+		v      string
+		opts   []semver.SatisfyOption
+		want   int
+		wantOK bool
+	}{
+		{"2.0.0-beta.1", nil, 0, true},
+		{"2.1.0", nil, 0, true},
+		{"1.9.0", nil, 2, true},
+		{"1.2.0", nil, 3, true},
+		{"1.9.0-rc.1", nil, -1, false},
+		{"1.9.0-rc.1", []semver.SatisfyOption{semver.IncludePrereleases()}, 2, true},
+		{"0.9.0", nil, -1, false},
+	}
+
+	for _, tt := range tests {
+		got, ok := semver.RolloutRing(semver.MustParse(tt.v), rings, tt.opts...)
+		if got != tt.want || ok != tt.wantOK {
+			t.Errorf("RolloutRing(%q) = %d, %v, want %d, %v", tt.v, got, ok, tt.want, tt.wantOK)
+		}
+	}
+}
@@ -0,0 +1,92 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver
+
+import (
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// jsonSchema is the JSON Schema of the JSON representation of the versions.
+//
+//go:embed version.schema.json
+var jsonSchema []byte
+
+// JSONSchema returns the JSON Schema, draft 2020-12, of the JSON
+// representation of a version, which is the version string without a prefix
+// that [Version.MarshalText] produces. The schema also defines
+// the representations of [VersionSet] and [VersionMap] as "#/$defs/versions"
+// and "#/$defs/versionMap". The schema is also published as
+// version.schema.json in the repository of this package for the consumers in
+// other languages.
+func JSONSchema() []byte {
+	return append([]byte(nil), jsonSchema...)
+}
+
+// ValidateJSON reports whether data is a JSON document that is valid according
+// to the schema returned by [JSONSchema], that is, a JSON string that contains
+// a version that conforms to [ConformanceStrict]. The returned error wraps
+// [ErrInvalidVersion] if data is valid JSON but not a valid version. Unlike the
+// pattern of the schema, ValidateJSON also rejects the version numbers that
+// don't fit in a uint64.
+func ValidateJSON(data []byte) error {
+	var s string
+
+	if err := json.Unmarshal(data, &s); err != nil {
+		var typeErr *json.UnmarshalTypeError
+		if errors.As(err, &typeErr) {
+			return fmt.Errorf(
+				"%w: expected a JSON string, got %s",
+				ErrInvalidVersion,
+				typeErr.Value,
+			)
+		}
+
+		return fmt.Errorf("failed to validate JSON: %w", err)
+	}
+
+	if _, err := ConformanceStrict.Parse(s); err != nil {
+		return fmt.Errorf("failed to validate JSON: %w", err)
+	}
+
+	return nil
+}
+
+// MarshalText implements [encoding.TextMarshaler]. The text of a version is
+// its string representation, so encoding/json encodes a version as a JSON
+// string that is valid according to the schema returned by [JSONSchema].
+func (v *Version) MarshalText() ([]byte, error) {
+	return v.appendTo(nil), nil
+}
+
+// UnmarshalText implements [encoding.TextUnmarshaler]. It parses the text
+// using [Parse] and replaces v with the result.
+func (v *Version) UnmarshalText(text []byte) error {
+	w, err := Parse(string(text))
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal version: %w", err)
+	}
+
+	*v = *w
+
+	return nil
+}
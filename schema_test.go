@@ -0,0 +1,118 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver_test
+
+import (
+	"encoding/json"
+	"errors"
+	"regexp"
+	"testing"
+
+	"github.com/anttikivi/semver"
+)
+
+func TestValidateJSON(t *testing.T) {
+	t.Parallel()
+
+	var schema struct {
+		Pattern string `json:"pattern"`
+	}
+
+	if err := json.Unmarshal(semver.JSONSchema(), &schema); err != nil {
+		t.Fatalf("failed to unmarshal schema: %v", err)
+	}
+
+	re := regexp.MustCompile(schema.Pattern)
+
+	tests := []string{
+		"1.2.3",
+		"0.0.0-0",
+		"1.0.0-alpha.beta.1+build.5",
+		"1.0.0-x-y-z.--+21AF26D3----117B344092BD",
+		"v1.2.3",
+		"1.2",
+		"01.2.3",
+		"1.0.0-01",
+		"1.0.0-alpha..1",
+		"1.0.0+a_b",
+		" 1.2.3",
+		"",
+	}
+
+	for _, s := range tests {
+		data, err := json.Marshal(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		err = semver.ValidateJSON(data)
+		if (err == nil) != re.MatchString(s) {
+			t.Errorf(
+				"ValidateJSON(%s) = %v, schema pattern match = %t",
+				data,
+				err,
+				re.MatchString(s),
+			)
+		}
+
+		if err != nil && !errors.Is(err, semver.ErrInvalidVersion) {
+			t.Errorf("ValidateJSON(%s) error = %v, want %v", data, err, semver.ErrInvalidVersion)
+		}
+	}
+
+	err := semver.ValidateJSON([]byte(`123`))
+	if !errors.Is(err, semver.ErrInvalidVersion) {
+		t.Errorf("ValidateJSON(123) error = %v, want %v", err, semver.ErrInvalidVersion)
+	}
+
+	err = semver.ValidateJSON([]byte(`"1.2.3`))
+	if err == nil || errors.Is(err, semver.ErrInvalidVersion) {
+		t.Errorf("ValidateJSON(malformed) error = %v, want a syntax error", err)
+	}
+}
+
+func TestVersionJSON(t *testing.T) {
+	t.Parallel()
+
+	for _, s := range []string{"1.2.3", "1.2.3-rc.1+b", "0.0.0-alpha.0.x+001"} {
+		v := semver.MustParse(s)
+
+		data, err := json.Marshal(v)
+		if err != nil {
+			t.Fatalf("json.Marshal(%s) error = %v", s, err)
+		}
+
+		if err := semver.ValidateJSON(data); err != nil {
+			t.Errorf("ValidateJSON(json.Marshal(%s)) = %v", s, err)
+		}
+
+		var w semver.Version
+
+		if err := json.Unmarshal(data, &w); err != nil || !w.StrictEqual(v) {
+			t.Errorf("json.Unmarshal(%s) = %v, %v, want %v", data, &w, err, v)
+		}
+	}
+
+	var v semver.Version
+
+	if err := json.Unmarshal([]byte(`"1.2"`), &v); !errors.Is(err, semver.ErrInvalidVersion) {
+		t.Errorf("json.Unmarshal(\"1.2\") error = %v, want %v", err, semver.ErrInvalidVersion)
+	}
+}
@@ -32,6 +32,7 @@ capabilities of this package include:
     full parsing of the version.
   - Comparing versions.
   - Sorting versions.
+  - Checking if versions satisfy version constraints.
 
 The version strings can optionally have a "v" prefix.
 
@@ -92,6 +93,34 @@ The above code would print:
 	1.3.0
 	2.0.0
 
+# Version constraints
+
+The package includes the [Constraint] type for checking whether versions
+satisfy version constraints, also known as version ranges. Constraints are
+parsed using [ParseConstraint] and [MustParseConstraint], and their syntax
+follows the syntax of the version ranges used by npm.
+
+Example usage:
+
+	c, err := semver.ParseConstraint(">=1.2.3 <2.0.0 || ^3.1.0")
+	ok := c.Check(semver.MustParse("1.4.0"))
+
+# Concurrency and mutability
+
+A [Version] is safe for concurrent use by multiple goroutines as long as none
+of them modifies it. The fields of [Version], including the [Prerelease] and
+[Build] slices, are exported for convenience, so modifying them affects every
+holder of the same pointer. The functions and methods in this package never
+modify the versions passed to them. The types that index versions, like
+[VersionSet] and [AliasResolver], store copies of them so that later changes by
+the caller don't break their invariants, and the versions they return are
+copies of their internal state. The collections of versions, like [Versions],
+[SortedVersions], [VersionHeap], and [VersionMap], share the versions with
+the caller instead, so the versions in them must not be modified. A version
+that might be shared should be copied using [Version.Clone] before modifying
+it, and its identifiers can be read without sharing memory with it using
+[Version.PrereleaseIdentifiers] and [Version.BuildIdentifiers].
+
 [semantic versioning]: https://semver.org
 [semantic versioning 2.0.0]: https://semver.org/spec/v2.0.0.html
 */
@@ -119,7 +148,8 @@ var (
 )
 
 // A Version is a parsed instance of a version number that adheres to the
-// semantic versioning 2.0.0.
+// semantic versioning 2.0.0. The versions should be treated as immutable when
+// they are shared; see the package documentation for the details.
 type Version struct {
 	Major      uint64
 	Minor      uint64
@@ -238,11 +268,11 @@ func (v *Version) Compare(w *Version) int {
 		return d
 	}
 
-	if v.Prerelease == nil && w.Prerelease != nil {
+	if len(v.Prerelease) == 0 && len(w.Prerelease) > 0 {
 		return 1
 	}
 
-	if v.Prerelease != nil && w.Prerelease == nil {
+	if len(v.Prerelease) > 0 && len(w.Prerelease) == 0 {
 		return -1
 	}
 
@@ -299,6 +329,33 @@ func (v *Version) StrictEqual(w *Version) bool {
 		v.Build.equal(w.Build)
 }
 
+// Clone returns a deep copy of v. The pre-release identifiers and the build
+// metadata of the returned version don't share memory with v, so the copy can
+// be modified safely even if v is shared.
+func (v *Version) Clone() *Version {
+	return &Version{
+		Major:      v.Major,
+		Minor:      v.Minor,
+		Patch:      v.Patch,
+		Prerelease: slices.Clone(v.Prerelease),
+		Build:      slices.Clone(v.Build),
+	}
+}
+
+// PrereleaseIdentifiers returns a copy of the pre-release identifiers of v.
+// Modifying the returned slice doesn't change v, so it is a safe way to read
+// the identifiers of a shared version.
+func (v *Version) PrereleaseIdentifiers() Prerelease {
+	return slices.Clone(v.Prerelease)
+}
+
+// BuildIdentifiers returns a copy of the build metadata identifiers of v.
+// Modifying the returned slice doesn't change v, so it is a safe way to read
+// the identifiers of a shared version.
+func (v *Version) BuildIdentifiers() Build {
+	return slices.Clone(v.Build)
+}
+
 // String returns the string representation of v.
 func (v *Version) String() string {
 	var sb strings.Builder
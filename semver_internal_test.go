@@ -21,6 +21,7 @@ package semver
 
 import (
 	"errors"
+	"io"
 	"math"
 	"regexp"
 	"strconv"
@@ -796,6 +797,22 @@ func BenchmarkParse(b *testing.B) {
 	}
 }
 
+func BenchmarkParseBytes(b *testing.B) {
+	test := []byte("0.1.0-alpha.24+sha.19031c2.darwin.amd64")
+
+	for b.Loop() {
+		_, _ = ParseBytes(test)
+	}
+}
+
+func BenchmarkVersionWriteTo(b *testing.B) {
+	v := MustParse("0.1.0-alpha.24+sha.19031c2.darwin.amd64")
+
+	for b.Loop() {
+		_, _ = v.WriteTo(io.Discard)
+	}
+}
+
 func BenchmarkParseLax(b *testing.B) {
 	test := "0.1.0-alpha.24+sha.19031c2.darwin.amd64"
 
@@ -1779,6 +1796,26 @@ func TestVersionCompare(t *testing.T) {
 	}
 }
 
+func TestVersionCompareEmptyPrerelease(t *testing.T) {
+	t.Parallel()
+
+	v := &Version{Major: 1, Prerelease: Prerelease{}}
+	w := MustParse("1.0.0")
+	x := MustParse("1.0.0-rc.1")
+
+	if got := v.Compare(w); got != 0 {
+		t.Errorf("Version{%+v}.Compare(%q) = %v, want 0", v, w, got)
+	}
+
+	if got := v.Compare(x); got != 1 {
+		t.Errorf("Version{%+v}.Compare(%q) = %v, want 1", v, x, got)
+	}
+
+	if got := x.Compare(v); got != -1 {
+		t.Errorf("Version{%q}.Compare(%+v) = %v, want -1", x, v, got)
+	}
+}
+
 func TestVersionComparableString(t *testing.T) {
 	t.Parallel()
 
@@ -1883,6 +1920,64 @@ func TestVersionStringLax(t *testing.T) {
 	}
 }
 
+func TestVersionClone(t *testing.T) {
+	t.Parallel()
+
+	for _, tt := range stringerTests {
+		name := tt.v
+		if name == "" {
+			name = emptyName
+		}
+
+		v, _ := Parse(tt.v)
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if v == nil {
+				t.Fatalf("Setup error: Version is nil for input %q", tt.v)
+			}
+
+			c := v.Clone()
+			if c == v || !c.StrictEqual(v) {
+				t.Fatalf("Version{%q}.Clone() = %v, want a new equal version", tt.v, c)
+			}
+
+			if len(c.Prerelease) > 0 {
+				c.Prerelease[0] = alphanumericIdentifier{"changed"}
+			}
+
+			if len(c.Build) > 0 {
+				c.Build[0] = "changed"
+			}
+
+			if got := v.String(); got != tt.want {
+				t.Errorf("modifying Version{%q}.Clone() changed the original to %v", tt.v, got)
+			}
+		})
+	}
+}
+
+func TestVersionIdentifiers(t *testing.T) {
+	t.Parallel()
+
+	v := MustParse("1.2.3-alpha.1+build.5")
+
+	p := v.PrereleaseIdentifiers()
+	p[0] = alphanumericIdentifier{"changed"}
+
+	b := v.BuildIdentifiers()
+	b[0] = "changed"
+
+	if got, want := v.String(), "1.2.3-alpha.1+build.5"; got != want {
+		t.Errorf("modifying the identifiers of %q changed the version to %q", want, got)
+	}
+
+	if p := MustParse("1.2.3").PrereleaseIdentifiers(); len(p) != 0 {
+		t.Errorf("Version{\"1.2.3\"}.PrereleaseIdentifiers() = %v, want none", p)
+	}
+}
+
 // isValidByParse is the old implementation of the validation function.
 func isValidByParse(s string) bool {
 	if _, err := Parse(s); err != nil {
@@ -0,0 +1,322 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+// Package semverpb defines the canonical Protocol Buffers mapping of the
+// versions, so that gRPC APIs can carry structured versions instead of
+// strings. The mapping is the message semver.v1.Version in version.proto:
+//
+//	message Version {
+//	  uint64 major = 1;
+//	  uint64 minor = 2;
+//	  uint64 patch = 3;
+//	  repeated string prerelease = 4;
+//	  repeated string build = 5;
+//	}
+//
+// The [Version] type of this package has the same fields and getters as the
+// type generated from version.proto by protoc-gen-go, and it encodes itself in
+// the Protocol Buffers wire format, so the package doesn't depend on
+// the Protocol Buffers runtime. Programs that use the generated types can
+// exchange the encoded messages with this package or copy the fields between
+// the types.
+package semverpb
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/anttikivi/semver"
+)
+
+// The field numbers of the message.
+const (
+	fieldMajor      = 1
+	fieldMinor      = 2
+	fieldPatch      = 3
+	fieldPrerelease = 4
+	fieldBuild      = 5
+)
+
+// The wire types of the Protocol Buffers encoding.
+const (
+	wireVarint  = 0
+	wireFixed64 = 1
+	wireBytes   = 2
+	wireFixed32 = 5
+)
+
+// ErrInvalidMessage is returned when the encoded message is malformed.
+var ErrInvalidMessage = errors.New("invalid version message")
+
+// A Version is the message semver.v1.Version.
+type Version struct {
+	Major      uint64
+	Minor      uint64
+	Patch      uint64
+	Prerelease []string
+	Build      []string
+}
+
+// ToProto converts v into a message.
+func ToProto(v *semver.Version) *Version {
+	p := &Version{
+		Major:      v.Major,
+		Minor:      v.Minor,
+		Patch:      v.Patch,
+		Prerelease: nil,
+		Build:      nil,
+	}
+
+	for _, id := range v.Prerelease {
+		p.Prerelease = append(p.Prerelease, id.String())
+	}
+
+	if len(v.Build) > 0 {
+		p.Build = append([]string(nil), v.Build...)
+	}
+
+	return p
+}
+
+// FromProto converts the message p into a version. The returned error wraps
+// [semver.ErrInvalidVersion] if the identifiers of p are not valid.
+func FromProto(p *Version) (*semver.Version, error) {
+	var sb strings.Builder
+
+	sb.WriteString(strconv.FormatUint(p.GetMajor(), 10))
+	sb.WriteByte('.')
+	sb.WriteString(strconv.FormatUint(p.GetMinor(), 10))
+	sb.WriteByte('.')
+	sb.WriteString(strconv.FormatUint(p.GetPatch(), 10))
+
+	for _, f := range []struct {
+		sep byte
+		ids []string
+	}{{'-', p.GetPrerelease()}, {'+', p.GetBuild()}} {
+		for i, id := range f.ids {
+			if !isIdentifier(id) {
+				return nil, fmt.Errorf(
+					"failed to convert version message: %w: invalid identifier %q",
+					semver.ErrInvalidVersion,
+					id,
+				)
+			}
+
+			if i == 0 {
+				sb.WriteByte(f.sep)
+			} else {
+				sb.WriteByte('.')
+			}
+
+			sb.WriteString(id)
+		}
+	}
+
+	// Parsing checks the rest of the rules, like the leading zeros in
+	// the numeric identifiers.
+	v, err := semver.Parse(sb.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert version message: %w", err)
+	}
+
+	return v, nil
+}
+
+// GetMajor returns the major version or zero if p is nil.
+func (p *Version) GetMajor() uint64 {
+	if p == nil {
+		return 0
+	}
+
+	return p.Major
+}
+
+// GetMinor returns the minor version or zero if p is nil.
+func (p *Version) GetMinor() uint64 {
+	if p == nil {
+		return 0
+	}
+
+	return p.Minor
+}
+
+// GetPatch returns the patch version or zero if p is nil.
+func (p *Version) GetPatch() uint64 {
+	if p == nil {
+		return 0
+	}
+
+	return p.Patch
+}
+
+// GetPrerelease returns the pre-release identifiers or nil if p is nil.
+func (p *Version) GetPrerelease() []string {
+	if p == nil {
+		return nil
+	}
+
+	return p.Prerelease
+}
+
+// GetBuild returns the build identifiers or nil if p is nil.
+func (p *Version) GetBuild() []string {
+	if p == nil {
+		return nil
+	}
+
+	return p.Build
+}
+
+// MarshalBinary implements [encoding.BinaryMarshaler]. It encodes p in
+// the Protocol Buffers wire format. The fields are written in the order of
+// their numbers and the zero values are omitted, so the encoding is
+// deterministic.
+func (p *Version) MarshalBinary() ([]byte, error) {
+	var b []byte
+
+	for _, f := range []struct {
+		num int
+		v   uint64
+	}{{fieldMajor, p.Major}, {fieldMinor, p.Minor}, {fieldPatch, p.Patch}} {
+		if f.v != 0 {
+			b = binary.AppendUvarint(b, uint64(f.num<<3|wireVarint))
+			b = binary.AppendUvarint(b, f.v)
+		}
+	}
+
+	b = appendStrings(b, fieldPrerelease, p.Prerelease)
+	b = appendStrings(b, fieldBuild, p.Build)
+
+	return b, nil
+}
+
+// UnmarshalBinary implements [encoding.BinaryUnmarshaler]. It decodes
+// a message in the Protocol Buffers wire format into p, replacing its
+// contents. The unknown fields are skipped.
+func (p *Version) UnmarshalBinary(data []byte) error {
+	*p = Version{Major: 0, Minor: 0, Patch: 0, Prerelease: nil, Build: nil}
+
+	for len(data) > 0 {
+		tag, n := binary.Uvarint(data)
+		if n <= 0 {
+			return fmt.Errorf("%w: invalid tag", ErrInvalidMessage)
+		}
+
+		data = data[n:]
+		num, typ := tag>>3, tag&7 //nolint:mnd // field number and wire type
+
+		var (
+			u uint64
+			s []byte
+		)
+
+		switch typ {
+		case wireVarint:
+			u, n = binary.Uvarint(data)
+			if n <= 0 {
+				return fmt.Errorf("%w: invalid varint in field %d", ErrInvalidMessage, num)
+			}
+		case wireBytes:
+			u, n = binary.Uvarint(data)
+			if n <= 0 || u > uint64(len(data)-n) {
+				return fmt.Errorf("%w: invalid length in field %d", ErrInvalidMessage, num)
+			}
+
+			s = data[n : n+int(u)] //nolint:gosec // checked against the length above
+			n += int(u)            //nolint:gosec // checked against the length above
+		case wireFixed64, wireFixed32:
+			n = 8 //nolint:mnd // size of fixed64
+			if typ == wireFixed32 {
+				n = 4 //nolint:mnd // size of fixed32
+			}
+
+			if len(data) < n {
+				return fmt.Errorf("%w: truncated field %d", ErrInvalidMessage, num)
+			}
+		default:
+			return fmt.Errorf("%w: unsupported wire type %d", ErrInvalidMessage, typ)
+		}
+
+		data = data[n:]
+
+		if err := p.setField(num, typ, u, s); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// setField sets the field num of p from its decoded value.
+func (p *Version) setField(num, typ, u uint64, s []byte) error {
+	want := uint64(wireVarint)
+	if num == fieldPrerelease || num == fieldBuild {
+		want = wireBytes
+	}
+
+	if num >= fieldMajor && num <= fieldBuild && typ != want {
+		return fmt.Errorf("%w: wrong wire type %d for field %d", ErrInvalidMessage, typ, num)
+	}
+
+	switch num {
+	case fieldMajor:
+		p.Major = u
+	case fieldMinor:
+		p.Minor = u
+	case fieldPatch:
+		p.Patch = u
+	case fieldPrerelease:
+		p.Prerelease = append(p.Prerelease, string(s))
+	case fieldBuild:
+		p.Build = append(p.Build, string(s))
+	}
+
+	return nil
+}
+
+// appendStrings appends the repeated string field num to b.
+func appendStrings(b []byte, num int, a []string) []byte {
+	for _, s := range a {
+		b = binary.AppendUvarint(b, uint64(num<<3|wireBytes))
+		b = binary.AppendUvarint(b, uint64(len(s)))
+		b = append(b, s...)
+	}
+
+	return b
+}
+
+// isIdentifier reports whether s is not empty and consists only of
+// the characters allowed in the identifiers.
+func isIdentifier(s string) bool {
+	if s == "" {
+		return false
+	}
+
+	for i := range len(s) {
+		c := s[i]
+		if (c < '0' || c > '9') && (c < 'a' || c > 'z') && (c < 'A' || c > 'Z') && c != '-' {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,118 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semverpb_test
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/anttikivi/semver"
+	"github.com/anttikivi/semver/semverpb"
+)
+
+func TestProtoRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	tests := []string{"0.0.0", "1.2.3", "1.2.3-rc.1", "1.0.0-alpha-1.0.x+build.001.sha"}
+
+	for _, s := range tests {
+		v := semver.MustParse(s)
+		p := semverpb.ToProto(v)
+
+		data, err := p.MarshalBinary()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var q semverpb.Version
+
+		if err := q.UnmarshalBinary(data); err != nil {
+			t.Fatalf("UnmarshalBinary(%x) error = %v", data, err)
+		}
+
+		if !reflect.DeepEqual(&q, p) {
+			t.Errorf("UnmarshalBinary(MarshalBinary(%+v)) = %+v", p, q)
+		}
+
+		w, err := semverpb.FromProto(&q)
+		if err != nil || !w.StrictEqual(v) {
+			t.Errorf("FromProto(ToProto(%q)) = %v, %v", s, w, err)
+		}
+	}
+}
+
+func TestVersionMarshalBinary(t *testing.T) {
+	t.Parallel()
+
+	p := semverpb.ToProto(semver.MustParse("1.0.300-rc+b"))
+
+	// The encoding of the message by the Protocol Buffers libraries.
+	want := []byte{0x08, 0x01, 0x18, 0xac, 0x02, 0x22, 0x02, 'r', 'c', 0x2a, 0x01, 'b'}
+
+	if got, _ := p.MarshalBinary(); !bytes.Equal(got, want) {
+		t.Errorf("MarshalBinary() = %x, want %x", got, want)
+	}
+
+	// An unknown fixed32 field 6 is skipped.
+	var q semverpb.Version
+
+	if err := q.UnmarshalBinary(append([]byte{0x35, 1, 2, 3, 4}, want...)); err != nil {
+		t.Fatalf("UnmarshalBinary() error = %v", err)
+	}
+
+	if !reflect.DeepEqual(&q, p) {
+		t.Errorf("UnmarshalBinary() = %+v, want %+v", q, p)
+	}
+
+	for _, data := range [][]byte{{0x08}, {0x22, 0x05, 'a'}, {0x0a, 0x00}, {0x0b}} {
+		if err := q.UnmarshalBinary(data); !errors.Is(err, semverpb.ErrInvalidMessage) {
+			t.Errorf(
+				"UnmarshalBinary(%x) error = %v, want %v",
+				data,
+				err,
+				semverpb.ErrInvalidMessage,
+			)
+		}
+	}
+}
+
+func TestFromProto(t *testing.T) {
+	t.Parallel()
+
+	tests := []*semverpb.Version{
+		{Major: 1, Prerelease: []string{"rc+1"}},
+		{Major: 1, Prerelease: []string{"rc.1"}},
+		{Major: 1, Prerelease: []string{"01"}},
+		{Major: 1, Build: []string{""}},
+	}
+
+	for _, p := range tests {
+		_, err := semverpb.FromProto(p)
+		if !errors.Is(err, semver.ErrInvalidVersion) {
+			t.Errorf("FromProto(%+v) error = %v, want %v", p, err, semver.ErrInvalidVersion)
+		}
+	}
+
+	if v, err := semverpb.FromProto(nil); err != nil || v.String() != "0.0.0" {
+		t.Errorf("FromProto(nil) = %v, %v, want 0.0.0", v, err)
+	}
+}
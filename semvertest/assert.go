@@ -0,0 +1,126 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semvertest
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/anttikivi/semver"
+)
+
+// AssertSorted reports an error to tb if vs is not sorted in increasing order
+// of precedence, and it reports whether vs is sorted. The report lists the
+// versions and marks each version that is less than the one before it, for
+// example:
+//
+//	versions are not sorted:
+//	  1.0.0
+//	  1.2.0
+//	> 1.1.0 (less than 1.2.0)
+func AssertSorted(tb testing.TB, vs semver.Versions) bool {
+	tb.Helper()
+
+	var sb strings.Builder
+
+	sorted := true
+
+	for i, v := range vs {
+		if i > 0 && vs[i-1].Compare(v) > 0 {
+			sorted = false
+
+			fmt.Fprintf(&sb, "\n> %s (less than %s)", v, vs[i-1])
+		} else {
+			fmt.Fprintf(&sb, "\n  %s", v)
+		}
+	}
+
+	if !sorted {
+		tb.Errorf("versions are not sorted:%s", sb.String())
+	}
+
+	return sorted
+}
+
+// AssertEqualSets reports an error to tb if got and want don't contain the same
+// versions, ignoring their order and duplicates, and it reports whether they
+// do. The versions are the same if they are equal including their build
+// identifiers. The report lists the missing versions with "-" and the
+// unexpected ones with "+" in increasing order of precedence, for example:
+//
+//	version sets differ (-want +got):
+//	- 1.2.0
+//	+ 1.3.0-rc.1
+func AssertEqualSets(tb testing.TB, got, want semver.Versions) bool {
+	tb.Helper()
+
+	type entry struct {
+		v    *semver.Version
+		mark byte
+	}
+
+	diff := make(map[string]entry, len(got)+len(want))
+
+	for _, v := range want {
+		diff[v.String()] = entry{v, '-'}
+	}
+
+	for _, v := range got {
+		s := v.String()
+		if e, ok := diff[s]; ok && e.mark != '+' {
+			e.mark = ' '
+			diff[s] = e
+		} else if !ok {
+			diff[s] = entry{v, '+'}
+		}
+	}
+
+	entries := make([]entry, 0, len(diff))
+
+	for _, e := range diff {
+		if e.mark != ' ' {
+			entries = append(entries, e)
+		}
+	}
+
+	if len(entries) == 0 {
+		return true
+	}
+
+	slices.SortFunc(entries, func(a, b entry) int {
+		if c := a.v.Compare(b.v); c != 0 {
+			return c
+		}
+
+		return strings.Compare(a.v.String(), b.v.String())
+	})
+
+	var sb strings.Builder
+
+	for _, e := range entries {
+		fmt.Fprintf(&sb, "\n%c %s", e.mark, e.v)
+	}
+
+	tb.Errorf("version sets differ (-want +got):%s", sb.String())
+
+	return false
+}
@@ -0,0 +1,95 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semvertest_test
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/anttikivi/semver"
+	"github.com/anttikivi/semver/semvertest"
+)
+
+// recorder is a testing.TB that records the reported errors.
+type recorder struct {
+	testing.TB
+
+	errors []string
+}
+
+func (r *recorder) Helper() {}
+
+func (r *recorder) Errorf(format string, args ...any) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+func versions(a ...string) semver.Versions {
+	vs := make(semver.Versions, len(a))
+	for i, s := range a {
+		vs[i] = semver.MustParse(s)
+	}
+
+	return vs
+}
+
+func TestAssertSorted(t *testing.T) {
+	t.Parallel()
+
+	if r := (&recorder{}); !semvertest.AssertSorted(r, semvertest.SpecChain()) || r.errors != nil {
+		t.Errorf("AssertSorted(SpecChain()) reported %q", r.errors)
+	}
+
+	r := &recorder{}
+	if semvertest.AssertSorted(r, versions("1.0.0", "1.2.0", "1.1.0", "1.1.0+b", "1.0.0")) {
+		t.Error("AssertSorted() = true for unsorted versions")
+	}
+
+	want := []string{"versions are not sorted:\n  1.0.0\n  1.2.0\n> 1.1.0 (less than 1.2.0)\n" +
+		"  1.1.0+b\n> 1.0.0 (less than 1.1.0+b)"}
+	if !slices.Equal(r.errors, want) {
+		t.Errorf("AssertSorted() reported %q, want %q", r.errors, want)
+	}
+}
+
+func TestAssertEqualSets(t *testing.T) {
+	t.Parallel()
+
+	r := &recorder{}
+	got := versions("2.0.0", "v1.0.0", "2.0.0")
+
+	if !semvertest.AssertEqualSets(r, got, versions("1.0.0", "2.0.0")) {
+		t.Errorf("AssertEqualSets() = false for equal sets: %q", r.errors)
+	}
+
+	got = versions("1.0.0", "1.3.0-rc.1", "2.0.0+b")
+	want := versions("2.0.0+a", "1.2.0", "1.0.0")
+
+	if semvertest.AssertEqualSets(r, got, want) {
+		t.Error("AssertEqualSets() = true for different sets")
+	}
+
+	wantErrors := []string{
+		"version sets differ (-want +got):\n- 1.2.0\n+ 1.3.0-rc.1\n- 2.0.0+a\n+ 2.0.0+b",
+	}
+	if !slices.Equal(r.errors, wantErrors) {
+		t.Errorf("AssertEqualSets() reported %q, want %q", r.errors, wantErrors)
+	}
+}
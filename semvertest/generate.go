@@ -0,0 +1,179 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semvertest
+
+import (
+	"math"
+	"math/rand/v2"
+	"strconv"
+
+	"github.com/anttikivi/semver"
+)
+
+// generateAttempts is the number of random candidates GenerateSatisfying tries
+// before falling back to the bounds of the constraint.
+const generateAttempts = 100
+
+// GenerateSatisfying returns a random version that satisfies c, using r as
+// the source of randomness so that the results are reproducible with the same
+// seed. The generated versions favor the edges of the intervals allowed by c:
+// the bounds, the versions next to them, and the pre-release versions at
+// the bounds. GenerateSatisfying returns nil if it cannot find a version that
+// satisfies c, for example when no version satisfies it.
+func GenerateSatisfying(r *rand.Rand, c *semver.Constraint) *semver.Version {
+	intervals := c.Intervals()
+	if len(intervals) == 0 {
+		return nil
+	}
+
+	for range generateAttempts {
+		i := intervals[r.IntN(len(intervals))]
+
+		var v *semver.Version
+
+		switch r.IntN(5) { //nolint:mnd // number of candidate kinds
+		case 0:
+			v = lowerEdge(i)
+		case 1:
+			v = upperEdge(r, i)
+		case 2: //nolint:mnd // candidate kind
+			v = prereleaseEdge(r, i)
+		default:
+			v = inside(r, i)
+		}
+
+		if v != nil && c.Check(v) {
+			return v
+		}
+	}
+
+	for _, i := range intervals {
+		for _, v := range []*semver.Version{lowerEdge(i), upperEdge(r, i), prereleaseEdge(r, i)} {
+			if v != nil && c.Check(v) {
+				return v
+			}
+		}
+	}
+
+	return nil
+}
+
+// lowerEdge returns the lowest version in the interval, or the version right
+// after the lower bound if it is exclusive. For an exclusive release version
+// bound, the next patch release is returned.
+func lowerEdge(i semver.Interval) *semver.Version {
+	if i.Lower.Version == nil {
+		return version(0, 0, 0, "")
+	}
+
+	v := i.Lower.Version
+	if i.Lower.Inclusive {
+		return version(v.Major, v.Minor, v.Patch, v.Prerelease.String())
+	}
+
+	if len(v.Prerelease) > 0 {
+		return version(v.Major, v.Minor, v.Patch, v.Prerelease.String()+".0")
+	}
+
+	if v.Patch == math.MaxUint64 {
+		return nil
+	}
+
+	return version(v.Major, v.Minor, v.Patch+1, "")
+}
+
+// upperEdge returns the greatest version in the interval if the upper bound is
+// inclusive, and otherwise a version right before the upper bound.
+func upperEdge(r *rand.Rand, i semver.Interval) *semver.Version {
+	v := i.Upper.Version
+
+	switch {
+	case v == nil:
+		return version(r.Uint64(), r.Uint64(), r.Uint64(), "")
+	case i.Upper.Inclusive:
+		return version(v.Major, v.Minor, v.Patch, v.Prerelease.String())
+	case len(v.Prerelease) == 0:
+		return version(v.Major, v.Minor, v.Patch, "0")
+	case v.Patch > 0:
+		return version(v.Major, v.Minor, v.Patch-1, "")
+	case v.Minor > 0:
+		return version(v.Major, v.Minor-1, r.Uint64N(100), "") //nolint:mnd // arbitrary limit
+	case v.Major > 0:
+		return version(v.Major-1, r.Uint64N(100), r.Uint64N(100), "") //nolint:mnd // arbitrary limit
+	default:
+		return nil
+	}
+}
+
+// prereleaseEdge returns a pre-release version at the bounds of the interval.
+func prereleaseEdge(r *rand.Rand, i semver.Interval) *semver.Version {
+	b := i.Lower
+	if r.IntN(2) == 0 || b.Version == nil {
+		b = i.Upper
+	}
+
+	if b.Version == nil {
+		return nil
+	}
+
+	v := b.Version
+
+	if len(v.Prerelease) > 0 {
+		return version(v.Major, v.Minor, v.Patch, v.Prerelease.String()+"."+strconv.Itoa(r.IntN(3))) //nolint:mnd // arbitrary limit
+	}
+
+	return version(v.Major, v.Minor, v.Patch, []string{"0", "alpha", "beta.1", "rc.1"}[r.IntN(4)]) //nolint:mnd // number of labels
+}
+
+// inside returns a random version that is near the lower bound of
+// the interval.
+func inside(r *rand.Rand, i semver.Interval) *semver.Version {
+	base := lowerEdge(i)
+	if base == nil {
+		return nil
+	}
+
+	v := version(base.Major, base.Minor, base.Patch, "")
+
+	switch r.IntN(3) { //nolint:mnd // number of levels
+	case 0:
+		v.Patch += r.Uint64N(10) //nolint:mnd // arbitrary limit
+	case 1:
+		v = version(v.Major, v.Minor+r.Uint64N(10), r.Uint64N(10), "") //nolint:mnd // arbitrary limit
+	default:
+		v = version(v.Major+r.Uint64N(3), r.Uint64N(10), r.Uint64N(10), "") //nolint:mnd // arbitrary limit
+	}
+
+	if !i.Contains(v) {
+		return base
+	}
+
+	return v
+}
+
+// version returns a new version with the given version core and pre-release.
+func version(major, minor, patch uint64, prerelease string) *semver.Version {
+	s := strconv.FormatUint(major, 10) + "." + strconv.FormatUint(minor, 10) + "." + strconv.FormatUint(patch, 10)
+	if prerelease != "" {
+		s += "-" + prerelease
+	}
+
+	return semver.MustParse(s)
+}
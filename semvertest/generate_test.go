@@ -0,0 +1,78 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semvertest_test
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"github.com/anttikivi/semver"
+	"github.com/anttikivi/semver/semvertest"
+)
+
+func TestGenerateSatisfying(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		c     string
+		edges []string
+	}{
+		{">=1.2.3 <2.0.0", []string{"1.2.3"}},
+		{"<=1.5.0", []string{"1.5.0", "0.0.0"}},
+		{">1.0.0 <=1.0.5", []string{"1.0.1", "1.0.5"}},
+		{">=1.0.0-beta.2 <1.0.0", []string{"1.0.0-beta.2"}},
+		{"^0.2.3 || 3.x", []string{"0.2.3", "3.0.0"}},
+		{"1.2.3", []string{"1.2.3"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.c, func(t *testing.T) {
+			t.Parallel()
+
+			r := rand.New(rand.NewPCG(1, 2)) //nolint:gosec // deterministic test
+			c := semver.MustParseConstraint(tt.c)
+			seen := make(map[string]bool)
+
+			for range 1000 {
+				v := semvertest.GenerateSatisfying(r, c)
+				if v == nil {
+					t.Fatalf("GenerateSatisfying(%q) = nil", tt.c)
+				}
+
+				if !c.Check(v) {
+					t.Fatalf("GenerateSatisfying(%q) = %q that doesn't satisfy the constraint", tt.c, v)
+				}
+
+				seen[v.String()] = true
+			}
+
+			for _, e := range tt.edges {
+				if !seen[e] {
+					t.Errorf("GenerateSatisfying(%q) never generated %q", tt.c, e)
+				}
+			}
+		})
+	}
+
+	r := rand.New(rand.NewPCG(1, 2)) //nolint:gosec // deterministic test
+	if v := semvertest.GenerateSatisfying(r, semver.MustParseConstraint(">2.0.0 <1.0.0")); v != nil {
+		t.Errorf("GenerateSatisfying() for unsatisfiable constraint = %q, want nil", v)
+	}
+}
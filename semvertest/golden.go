@@ -0,0 +1,96 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semvertest
+
+import (
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/anttikivi/semver"
+)
+
+// GoldenConstraints checks the constraints in the golden file at path against
+// the versions and reports an error to tb for each row whose result is not
+// the expected one. Each line of the file is a row of a constraint,
+// a version, and the expected result of checking whether the version
+// satisfies the constraint, separated by whitespace:
+//
+//	# constraint               version     expect
+//	^1.2.3                     1.9.9       true
+//	>=1.0.0 <2.0.0 || ^3.1.0   2.5.0       false
+//
+// The constraint is the part of the line before the last two fields so it may
+// contain spaces. The empty lines and the lines starting with "#" are skipped.
+// A golden file records the behavior of the constraints at the time it was
+// written, so checking it in tests catches the changes in the behavior when
+// the package is upgraded.
+func GoldenConstraints(tb testing.TB, path string) {
+	tb.Helper()
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		tb.Fatalf("failed to read golden file: %v", err)
+	}
+
+	for i, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) < 3 { //nolint:mnd // constraint, version, and expect
+			tb.Errorf("%s:%d: expected a constraint, a version, and a result", path, i+1)
+
+			continue
+		}
+
+		n := len(fields)
+
+		expect, err := strconv.ParseBool(fields[n-1])
+		if err != nil {
+			tb.Errorf("%s:%d: invalid expected result %q", path, i+1, fields[n-1])
+
+			continue
+		}
+
+		v, err := semver.Parse(fields[n-2])
+		if err != nil {
+			tb.Errorf("%s:%d: %v", path, i+1, err)
+
+			continue
+		}
+
+		expr := strings.Join(fields[:n-2], " ")
+
+		c, err := semver.ParseConstraint(expr)
+		if err != nil {
+			tb.Errorf("%s:%d: %v", path, i+1, err)
+
+			continue
+		}
+
+		if got := c.Check(v); got != expect {
+			tb.Errorf("%s:%d: %q: Check(%s) = %t, want %t", path, i+1, expr, v, got, expect)
+		}
+	}
+}
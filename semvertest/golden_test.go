@@ -0,0 +1,58 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semvertest_test
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/anttikivi/semver/semvertest"
+)
+
+func TestGoldenConstraints(t *testing.T) {
+	t.Parallel()
+
+	semvertest.GoldenConstraints(t, filepath.Join("testdata", "constraints.golden"))
+
+	path := filepath.Join(t.TempDir(), "constraints.golden")
+	data := "^1.2.3  2.0.0  true\n\n# comment\n1.x 1.0.0\n>=1.0.0 || 2.0.0 x.0.0 false\n" +
+		"~1.2 1.2.0 maybe\n"
+
+	if err := os.WriteFile(path, []byte(data), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	r := &recorder{}
+	semvertest.GoldenConstraints(r, path)
+
+	want := []string{
+		path + `:1: "^1.2.3": Check(2.0.0) = false, want true`,
+		path + ":4: expected a constraint, a version, and a result",
+		path + `:5: failed to parse version: failed to parse the version prefix: ` +
+			`invalid semantic version: ` +
+			`version "x.0.0" does not start with a digit or 'v'`,
+		path + `:6: invalid expected result "maybe"`,
+	}
+	if !slices.Equal(r.errors, want) {
+		t.Errorf("GoldenConstraints() reported %q, want %q", r.errors, want)
+	}
+}
@@ -0,0 +1,44 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semvertest_test
+
+import (
+	"testing"
+
+	"github.com/anttikivi/semver/semvertest"
+)
+
+func TestSpecChain(t *testing.T) {
+	t.Parallel()
+
+	chain := semvertest.SpecChain()
+
+	for i := 1; i < len(chain); i++ {
+		if chain[i-1].Compare(chain[i]) >= 0 {
+			t.Errorf("%q is not less than %q", chain[i-1], chain[i])
+		}
+	}
+
+	chain[0].Major = 9
+
+	if semvertest.SpecChain()[0].Major != 1 {
+		t.Error("SpecChain() returned a shared version")
+	}
+}
@@ -0,0 +1,73 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semvertest
+
+// Shrink reduces the input s for which predicate reports true, for example
+// a fuzz input that makes a parser fail, to a minimal input for which predicate
+// still reports true. It first removes as many bytes as it can and then
+// replaces the remaining digits with '0' and the letters with 'a' where
+// possible. If predicate reports false for s, Shrink returns s unchanged.
+//
+// The predicate should be deterministic. It is called many times with
+// the candidate inputs, and the result is minimal in the sense that removing
+// any single byte or simplifying any single character makes predicate report
+// false.
+func Shrink(s string, predicate func(string) bool) string {
+	if !predicate(s) {
+		return s
+	}
+
+	for changed := true; changed; {
+		changed = false
+
+		for n := max(len(s)/2, 1); n >= 1; n /= 2 { //nolint:mnd // divide the chunks in half
+			for i := 0; i+n <= len(s); {
+				if c := s[:i] + s[i+n:]; predicate(c) {
+					s = c
+					changed = true
+
+					continue
+				}
+
+				i++
+			}
+		}
+
+		for i := range len(s) {
+			var r byte
+
+			switch c := s[i]; {
+			case c > '0' && c <= '9':
+				r = '0'
+			case (c > 'a' && c <= 'z') || (c >= 'A' && c <= 'Z'):
+				r = 'a'
+			default:
+				continue
+			}
+
+			if c := s[:i] + string(r) + s[i+1:]; predicate(c) {
+				s = c
+				changed = true
+			}
+		}
+	}
+
+	return s
+}
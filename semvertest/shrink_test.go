@@ -0,0 +1,76 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semvertest_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/anttikivi/semver"
+	"github.com/anttikivi/semver/semvertest"
+)
+
+func TestShrink(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		s         string
+		predicate func(string) bool
+		want      string
+	}{
+		{
+			"leading zero",
+			"v12.034.5-beta.7+linux.amd64",
+			func(s string) bool {
+				_, err := semver.Parse(s)
+
+				return err != nil && strings.Contains(err.Error(), "leading zero")
+			},
+			"0.00.",
+		},
+		{
+			"substring",
+			"1.2.3-rc.1+build.5",
+			func(s string) bool {
+				return strings.Contains(s, "+b")
+			},
+			"+b",
+		},
+		{
+			"no failure",
+			"1.2.3",
+			func(string) bool {
+				return false
+			},
+			"1.2.3",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := semvertest.Shrink(tt.s, tt.predicate); got != tt.want {
+				t.Errorf("Shrink(%q) = %q, want %q", tt.s, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,330 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"math"
+	"slices"
+)
+
+const (
+	// bloomHeaderLen is the length of the header in the binary encoding of
+	// a BloomFilter: the number of hash functions and the number of bits.
+	bloomHeaderLen = 12
+
+	// maxBloomHashes is the maximum number of hash functions of a BloomFilter.
+	// It is already enough for a false positive rate far below 1e-18.
+	maxBloomHashes = 64
+)
+
+// Errors returned by the version sets.
+var (
+	// ErrInvalidBloomFilter is returned when decoding an invalid binary
+	// representation of a BloomFilter.
+	ErrInvalidBloomFilter = errors.New("invalid bloom filter")
+)
+
+// A VersionSet is a set of versions. Two versions are considered to be the same
+// element of the set if they are equal according to [Version.Equal], so
+// the build metadata is not taken into account. The zero value is an empty set
+// ready to use.
+//
+// A VersionSet is not safe for concurrent use by multiple goroutines if any of
+// them modifies the set.
+type VersionSet struct {
+	m map[string]*Version
+}
+
+// A BloomFilter is a probabilistic set of versions. It can tell with certainty
+// that a version is not in the set, but a positive answer may be a false
+// positive. The filter is considerably more compact than a [VersionSet], and it
+// is useful for rejecting most of the versions before a lookup from a slower
+// storage.
+//
+// A BloomFilter is created from a VersionSet using [VersionSet.BloomFilter].
+// The zero value is an empty filter that contains no versions.
+type BloomFilter struct {
+	bits []uint64
+	m    uint64
+	k    uint32
+}
+
+// NewVersionSet returns a new VersionSet that contains the given versions.
+func NewVersionSet(versions ...*Version) *VersionSet {
+	s := &VersionSet{m: make(map[string]*Version, len(versions))}
+
+	for _, v := range versions {
+		s.Add(v)
+	}
+
+	return s
+}
+
+// Add adds a copy of v to the set. It reports whether v was added, i.e. whether
+// the set did not already contain a version equal to v.
+func (s *VersionSet) Add(v *Version) bool {
+	if s.m == nil {
+		s.m = make(map[string]*Version)
+	}
+
+	key := v.ComparableString()
+	if _, ok := s.m[key]; ok {
+		return false
+	}
+
+	s.m[key] = v.Clone()
+
+	return true
+}
+
+// Contains reports whether the set contains a version equal to v.
+func (s *VersionSet) Contains(v *Version) bool {
+	_, ok := s.m[v.ComparableString()]
+
+	return ok
+}
+
+// Remove removes the version equal to v from the set. It reports whether
+// the set contained the version.
+func (s *VersionSet) Remove(v *Version) bool {
+	key := v.ComparableString()
+	if _, ok := s.m[key]; !ok {
+		return false
+	}
+
+	delete(s.m, key)
+
+	return true
+}
+
+// Len returns the number of versions in the set.
+func (s *VersionSet) Len() int {
+	return len(s.m)
+}
+
+// Union returns a new set that contains the versions from both s and o. If both
+// of the sets contain an equal version, the one in s is used.
+func (s *VersionSet) Union(o *VersionSet) *VersionSet {
+	u := &VersionSet{m: make(map[string]*Version, max(s.Len(), o.Len()))}
+
+	for k, v := range s.m {
+		u.m[k] = v
+	}
+
+	for k, v := range o.m {
+		if _, ok := u.m[k]; !ok {
+			u.m[k] = v
+		}
+	}
+
+	return u
+}
+
+// Versions returns copies of the versions in the set sorted in increasing
+// order.
+func (s *VersionSet) Versions() Versions {
+	vs := make(Versions, 0, len(s.m))
+
+	for _, v := range s.m {
+		vs = append(vs, v.Clone())
+	}
+
+	slices.SortFunc(vs, Compare)
+
+	return vs
+}
+
+// MarshalJSON implements [json.Marshaler]. The set is encoded as an array of
+// version strings in increasing order.
+func (s *VersionSet) MarshalJSON() ([]byte, error) {
+	vs := s.Versions()
+	a := make([]string, len(vs))
+
+	for i, v := range vs {
+		a[i] = v.String()
+	}
+
+	b, err := json.Marshal(a)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal version set: %w", err)
+	}
+
+	return b, nil
+}
+
+// UnmarshalJSON implements [json.Unmarshaler]. It decodes an array of version
+// strings into the set, replacing the previous contents of the set.
+func (s *VersionSet) UnmarshalJSON(data []byte) error {
+	var a []string
+
+	if err := json.Unmarshal(data, &a); err != nil {
+		return fmt.Errorf("failed to unmarshal version set: %w", err)
+	}
+
+	m := make(map[string]*Version, len(a))
+
+	for _, str := range a {
+		v, err := Parse(str)
+		if err != nil {
+			return fmt.Errorf("failed to unmarshal version set: %w", err)
+		}
+
+		key := v.ComparableString()
+		if _, ok := m[key]; !ok {
+			m[key] = v
+		}
+	}
+
+	s.m = m
+
+	return nil
+}
+
+// BloomFilter returns a BloomFilter that contains the versions in the set and
+// has the given false positive rate for them. The rate must be greater than
+// zero and less than one.
+func (s *VersionSet) BloomFilter(falsePositiveRate float64) *BloomFilter {
+	if falsePositiveRate <= 0 || falsePositiveRate >= 1 {
+		panic(fmt.Sprintf("invalid bloom filter false positive rate: %v", falsePositiveRate))
+	}
+
+	n := float64(max(len(s.m), 1))
+	m := math.Ceil(-n * math.Log(falsePositiveRate) / (math.Ln2 * math.Ln2))
+	k := min(max(math.Round(m/n*math.Ln2), 1), maxBloomHashes)
+
+	f := &BloomFilter{
+		bits: make([]uint64, (uint64(m)+63)/64), //nolint:mnd // bits in a word
+		m:    uint64(m),
+		k:    uint32(k),
+	}
+
+	for key := range s.m {
+		f.add(key)
+	}
+
+	return f
+}
+
+// MayContain reports whether v may be in the filter. If it returns false, v is
+// certainly not in the set the filter was created from.
+func (f *BloomFilter) MayContain(v *Version) bool {
+	if f.m == 0 {
+		return false
+	}
+
+	h1, h2 := bloomHashes(v.ComparableString())
+
+	for i := range uint64(f.k) {
+		if !f.isSet((h1 + i*h2) % f.m) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// MarshalBinary implements [encoding.BinaryMarshaler].
+func (f *BloomFilter) MarshalBinary() ([]byte, error) {
+	b := make([]byte, bloomHeaderLen, bloomHeaderLen+8*len(f.bits))
+
+	binary.BigEndian.PutUint32(b, f.k)
+	binary.BigEndian.PutUint64(b[4:], f.m)
+
+	for _, w := range f.bits {
+		b = binary.BigEndian.AppendUint64(b, w)
+	}
+
+	return b, nil
+}
+
+// UnmarshalBinary implements [encoding.BinaryUnmarshaler].
+func (f *BloomFilter) UnmarshalBinary(data []byte) error {
+	if len(data) < bloomHeaderLen {
+		return fmt.Errorf("%w: data is too short", ErrInvalidBloomFilter)
+	}
+
+	k := binary.BigEndian.Uint32(data)
+	m := binary.BigEndian.Uint64(data[4:])
+	data = data[bloomHeaderLen:]
+
+	// The zero value of the filter has neither any hash functions nor
+	// a size.
+	if k == 0 && m == 0 && len(data) == 0 {
+		*f = BloomFilter{bits: nil, m: 0, k: 0}
+
+		return nil
+	}
+
+	if k == 0 || k > maxBloomHashes {
+		return fmt.Errorf("%w: invalid number of hash functions %d", ErrInvalidBloomFilter, k)
+	}
+
+	if len(data)%8 != 0 { //nolint:mnd // bytes in a word
+		return fmt.Errorf("%w: data is not whole words", ErrInvalidBloomFilter)
+	}
+
+	// The size is checked against the length of the data instead of
+	// computing the length from the size, as that could overflow.
+	words := uint64(len(data) / 8)                     //nolint:mnd // bytes in a word
+	if m == 0 || m > words*64 || words != (m-1)/64+1 { //nolint:mnd // bits in a word
+		return fmt.Errorf("%w: data does not match the size %d", ErrInvalidBloomFilter, m)
+	}
+
+	bits := make([]uint64, 0, len(data)/8) //nolint:mnd // bytes in a word
+
+	for i := 0; i < len(data); i += 8 {
+		bits = append(bits, binary.BigEndian.Uint64(data[i:]))
+	}
+
+	f.bits = bits
+	f.m = m
+	f.k = k
+
+	return nil
+}
+
+func (f *BloomFilter) add(key string) {
+	h1, h2 := bloomHashes(key)
+
+	for i := range uint64(f.k) {
+		j := (h1 + i*h2) % f.m
+		f.bits[j/64] |= 1 << (j % 64)
+	}
+}
+
+func (f *BloomFilter) isSet(j uint64) bool {
+	return f.bits[j/64]&(1<<(j%64)) != 0
+}
+
+// bloomHashes returns the two hashes of key that are combined for the hash
+// functions of a BloomFilter.
+func bloomHashes(key string) (uint64, uint64) {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(key))
+	sum := h.Sum64()
+
+	// Force the second hash to be odd so that it never is zero.
+	return sum >> 32, (sum & math.MaxUint32) | 1 //nolint:mnd // half of the bits
+}
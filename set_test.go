@@ -0,0 +1,276 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver_test
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"math"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/anttikivi/semver"
+)
+
+func TestVersionSet(t *testing.T) {
+	t.Parallel()
+
+	var s semver.VersionSet
+
+	if !s.Add(semver.MustParse("1.2.3")) {
+		t.Error("VersionSet.Add(1.2.3) = false on an empty set, want true")
+	}
+
+	if s.Add(semver.MustParse("1.2.3+build")) {
+		t.Error("VersionSet.Add(1.2.3+build) = true with 1.2.3 in the set, want false")
+	}
+
+	s.Add(semver.MustParse("2.0.0-rc.1"))
+
+	tests := []struct {
+		v    string
+		want bool
+	}{
+		{"1.2.3", true},
+		{"1.2.3+meta", true},
+		{"2.0.0-rc.1", true},
+		{"2.0.0", false},
+		{"1.2.4", false},
+		{"1.2.3-alpha", false},
+	}
+
+	for _, tt := range tests {
+		if got := s.Contains(semver.MustParse(tt.v)); got != tt.want {
+			t.Errorf("VersionSet.Contains(%q) = %v, want %v", tt.v, got, tt.want)
+		}
+	}
+
+	if s.Len() != 2 {
+		t.Errorf("VersionSet.Len() = %d, want 2", s.Len())
+	}
+
+	if !s.Remove(semver.MustParse("2.0.0-rc.1")) || s.Contains(semver.MustParse("2.0.0-rc.1")) {
+		t.Error("VersionSet.Remove(2.0.0-rc.1) did not remove the version")
+	}
+}
+
+func TestVersionSetCopies(t *testing.T) {
+	t.Parallel()
+
+	v := semver.MustParse("1.0.0-rc.1")
+	s := semver.NewVersionSet(v)
+
+	v.Prerelease = nil
+
+	if !s.Contains(semver.MustParse("1.0.0-rc.1")) || s.Contains(semver.MustParse("1.0.0")) {
+		t.Error("modifying an added version changed the set")
+	}
+
+	s.Versions()[0].Major = 2
+
+	if got := versionStrings(s.Versions()); !reflect.DeepEqual(got, []string{"1.0.0-rc.1"}) {
+		t.Errorf("modifying a returned version changed the set to %v", got)
+	}
+}
+
+func TestVersionSetUnion(t *testing.T) {
+	t.Parallel()
+
+	a := semver.NewVersionSet(semver.MustParse("1.0.0"), semver.MustParse("1.1.0+a"))
+	b := semver.NewVersionSet(semver.MustParse("1.1.0+b"), semver.MustParse("0.9.0"))
+
+	got := versionStrings(a.Union(b).Versions())
+	want := []string{"0.9.0", "1.0.0", "1.1.0+a"}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("VersionSet.Union() = %v, want %v", got, want)
+	}
+
+	if a.Len() != 2 || b.Len() != 2 {
+		t.Errorf("VersionSet.Union() modified its operands")
+	}
+}
+
+func TestVersionSetJSON(t *testing.T) {
+	t.Parallel()
+
+	s := semver.NewVersionSet(
+		semver.MustParse("2.0.0"),
+		semver.MustParse("1.0.0-beta+exp"),
+		semver.MustParse("1.0.0"),
+	)
+
+	b, err := json.Marshal(s)
+	if err != nil {
+		t.Fatalf("json.Marshal(VersionSet) failed unexpectedly: %v", err)
+	}
+
+	if want := `["1.0.0-beta+exp","1.0.0","2.0.0"]`; string(b) != want {
+		t.Errorf("json.Marshal(VersionSet) = %s, want %s", b, want)
+	}
+
+	var got semver.VersionSet
+
+	if err = json.Unmarshal(b, &got); err != nil {
+		t.Fatalf("json.Unmarshal(%s) failed unexpectedly: %v", b, err)
+	}
+
+	if !reflect.DeepEqual(versionStrings(got.Versions()), versionStrings(s.Versions())) {
+		t.Errorf("json.Unmarshal(%s) = %v, want %v", b, got.Versions(), s.Versions())
+	}
+
+	if err = json.Unmarshal([]byte(`["1.0.0","bad"]`), &got); err == nil {
+		t.Error("json.Unmarshal() with an invalid version succeeded unexpectedly")
+	}
+}
+
+func TestBloomFilter(t *testing.T) {
+	t.Parallel()
+
+	s := semver.NewVersionSet()
+
+	for i := range 1000 {
+		s.Add(semver.MustParse("1." + strconv.Itoa(i) + ".0"))
+	}
+
+	f := s.BloomFilter(0.01)
+
+	b, err := f.MarshalBinary()
+	if err != nil {
+		t.Fatalf("BloomFilter.MarshalBinary() failed unexpectedly: %v", err)
+	}
+
+	var decoded semver.BloomFilter
+
+	if err = decoded.UnmarshalBinary(b); err != nil {
+		t.Fatalf("BloomFilter.UnmarshalBinary() failed unexpectedly: %v", err)
+	}
+
+	for _, filter := range []*semver.BloomFilter{f, &decoded} {
+		for _, v := range s.Versions() {
+			if !filter.MayContain(v) {
+				t.Fatalf("BloomFilter.MayContain(%q) = false for a version in the set", v)
+			}
+		}
+
+		positives := 0
+
+		for i := range 1000 {
+			if filter.MayContain(semver.MustParse("2." + strconv.Itoa(i) + ".0")) {
+				positives++
+			}
+		}
+
+		// The expected number of false positives is 10.
+		if positives > 50 {
+			t.Errorf("BloomFilter.MayContain() returned %d false positives out of 1000", positives)
+		}
+	}
+
+	if err = decoded.UnmarshalBinary(b[:len(b)-1]); err == nil {
+		t.Error("BloomFilter.UnmarshalBinary() with truncated data succeeded unexpectedly")
+	}
+}
+
+func TestBloomFilterZero(t *testing.T) {
+	t.Parallel()
+
+	var f semver.BloomFilter
+
+	v := semver.MustParse("1.2.3")
+	if f.MayContain(v) {
+		t.Errorf("BloomFilter{}.MayContain(%s) = true", v)
+	}
+
+	b, err := f.MarshalBinary()
+	if err != nil {
+		t.Fatalf("BloomFilter{}.MarshalBinary() failed unexpectedly: %v", err)
+	}
+
+	var decoded semver.BloomFilter
+
+	if err = decoded.UnmarshalBinary(b); err != nil {
+		t.Fatalf("BloomFilter.UnmarshalBinary() failed unexpectedly: %v", err)
+	}
+
+	if decoded.MayContain(v) {
+		t.Errorf("BloomFilter.MayContain(%s) = true after decoding an empty filter", v)
+	}
+
+	b[3] = 1
+
+	if err = decoded.UnmarshalBinary(b); !errors.Is(err, semver.ErrInvalidBloomFilter) {
+		t.Errorf(
+			"BloomFilter.UnmarshalBinary() error = %v, want %v",
+			err,
+			semver.ErrInvalidBloomFilter,
+		)
+	}
+}
+
+func TestBloomFilterUnmarshalInvalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		k    uint32
+		m    uint64
+		data []byte
+	}{
+		{"zero k", 0, 64, make([]byte, 8)},
+		{"zero m", 1, 0, nil},
+		{"too many hashes", math.MaxUint32, 64, make([]byte, 8)},
+		{"overflowing size", 1, math.MaxUint64, nil},
+		{"too small size", 1, 64, make([]byte, 16)},
+		{"too large size", 1, 65, make([]byte, 8)},
+		{"partial word", 1, 64, make([]byte, 12)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			b := binary.BigEndian.AppendUint32(nil, tt.k)
+			b = binary.BigEndian.AppendUint64(b, tt.m)
+			b = append(b, tt.data...)
+
+			var f semver.BloomFilter
+			if err := f.UnmarshalBinary(b); !errors.Is(err, semver.ErrInvalidBloomFilter) {
+				t.Errorf(
+					"BloomFilter.UnmarshalBinary() error = %v, want %v",
+					err,
+					semver.ErrInvalidBloomFilter,
+				)
+			}
+		})
+	}
+}
+
+func versionStrings(vs semver.Versions) []string {
+	a := make([]string, len(vs))
+
+	for i, v := range vs {
+		a[i] = v.String()
+	}
+
+	return a
+}
@@ -0,0 +1,310 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver
+
+import (
+	"math"
+	"strings"
+)
+
+// Simplify returns a constraint that is satisfied by the same versions as c in
+// a minimal canonical form. The overlapping and touching ranges of c are
+// merged, each range is reduced to at most a lower and an upper bound, and
+// the ranges are ordered by their lower bounds. For example,
+// ">=1.2.0 <2.0.0 || >=1.5.0 <1.8.0" is simplified to ">=1.2.0 <2.0.0" and
+// "^1.2.0 || ^1.4.0" to ">=1.2.0 <2.0.0".
+//
+// The pre-release versions that c allows are kept allowed: if merging
+// the ranges would change them, the ranges that allow them are kept as
+// separate ranges. If c is not satisfied by any version, it is returned
+// unchanged.
+//
+// The equivalence holds for checking the versions without
+// [IncludePrereleases]. The gaps between the ranges that contain only
+// pre-release versions may be closed, so with [IncludePrereleases] the
+// simplified constraint can allow more versions: "^1.0.0 || ^2.0.0" is
+// simplified to ">=1.0.0 <3.0.0", which allows "2.0.0-beta" when it's checked
+// with [IncludePrereleases].
+func (c *Constraint) Simplify() *Constraint {
+	intervals := c.Intervals()
+	if len(intervals) == 0 {
+		return c
+	}
+
+	// The gaps between the intervals that have only pre-release versions,
+	// like the one between "^1.0.0" and "^2.0.0", are closed if that doesn't
+	// change the allowed pre-release versions.
+	if simple := c.simplify(closeReleaseGaps(intervals)); simple != nil {
+		return simple
+	}
+
+	if simple := c.simplify(intervals); simple != nil {
+		return simple
+	}
+
+	return c
+}
+
+// simplify returns a constraint with a range for each of the intervals, and
+// the ranges for the pre-release versions that c allows but the intervals
+// don't. It returns nil if the constraint is not satisfied by the same versions
+// as c.
+func (c *Constraint) simplify(intervals []Interval) *Constraint {
+	ranges := make([]string, 0, len(intervals))
+	for _, i := range intervals {
+		ranges = append(ranges, formatRange(i))
+	}
+
+	simple, err := ParseConstraint(strings.Join(ranges, " || "))
+	if err != nil {
+		return nil
+	}
+
+	prereleases := c.prereleaseIntervals()
+
+	if missing := subtractIntervals(prereleases, simple.prereleaseIntervals()); len(missing) > 0 {
+		for _, i := range missing {
+			ranges = append(ranges, formatRange(i))
+		}
+
+		simple, err = ParseConstraint(strings.Join(ranges, " || "))
+		if err != nil {
+			return nil
+		}
+	}
+
+	if !intervalsEqual(c.releaseIntervals(), simple.releaseIntervals()) ||
+		!intervalsEqual(prereleases, simple.prereleaseIntervals()) {
+		return nil
+	}
+
+	return simple
+}
+
+// closeReleaseGaps merges the consecutive intervals that have only pre-release
+// versions between them.
+func closeReleaseGaps(intervals []Interval) []Interval {
+	result := make([]Interval, 0, len(intervals))
+
+	for _, i := range intervals {
+		n := len(result)
+		if n == 0 {
+			result = append(result, i)
+
+			continue
+		}
+
+		last := result[n-1]
+		gap := Interval{
+			Lower: Bound{Version: last.Upper.Version, Inclusive: !last.Upper.Inclusive},
+			Upper: Bound{Version: i.Lower.Version, Inclusive: !i.Lower.Inclusive},
+		}
+
+		if releaseInterval(gap).IsEmpty() {
+			result[n-1].Upper = i.Upper
+		} else {
+			result = append(result, i)
+		}
+	}
+
+	return result
+}
+
+// formatRange returns the range of a constraint that is satisfied by
+// the versions in the interval, ignoring the pre-release rule. An exclusive
+// upper bound at the lowest pre-release of a version, like "<2.0.0-0", is
+// written without the pre-release as it doesn't change the satisfying
+// versions.
+func formatRange(i Interval) string {
+	lower, upper := i.Lower, i.Upper
+
+	if lower.Version != nil && upper.Version != nil && lower.Inclusive && upper.Inclusive &&
+		lower.Version.Equal(upper.Version) {
+		return "=" + lower.Version.String()
+	}
+
+	if lower.Version != nil && lower.Inclusive && lower.Version.Equal(coreVersion(0, 0, 0)) {
+		lower.Version = nil
+	}
+
+	var parts []string
+
+	if lower.Version != nil {
+		op := ">"
+		if lower.Inclusive {
+			op = ">="
+		}
+
+		parts = append(parts, op+lower.Version.String())
+	}
+
+	if upper.Version != nil {
+		op := "<"
+		if upper.Inclusive {
+			op = "<="
+		}
+
+		v := upper.Version
+		if !upper.Inclusive && v.Equal(lowestVersion(v.Major, v.Minor, v.Patch)) {
+			v = coreVersion(v.Major, v.Minor, v.Patch)
+		}
+
+		parts = append(parts, op+v.String())
+	}
+
+	if len(parts) == 0 {
+		return "*"
+	}
+
+	return strings.Join(parts, " ")
+}
+
+// releaseIntervals returns the intervals of the versions without pre-release
+// identifiers that satisfy c in a canonical form, merged. Two constraints are
+// satisfied by the same versions without pre-release identifiers if and only
+// if their release intervals are equal.
+func (c *Constraint) releaseIntervals() []Interval {
+	var all []Interval
+
+	for _, i := range c.Intervals() {
+		if r := releaseInterval(i); !r.IsEmpty() {
+			all = append(all, r)
+		}
+	}
+
+	return mergeIntervals(all)
+}
+
+// releaseInterval returns the interval that has the same versions without
+// pre-release identifiers as i, with the lower bound inclusive and the upper
+// bound exclusive, if possible, and neither of them having pre-release
+// identifiers or build metadata.
+func releaseInterval(i Interval) Interval {
+	lower := Bound{Version: coreVersion(0, 0, 0), Inclusive: true}
+	if v := i.Lower.Version; v != nil {
+		lower = Bound{Version: coreVersion(v.Major, v.Minor, v.Patch), Inclusive: true}
+		if len(v.Prerelease) == 0 && !i.Lower.Inclusive {
+			lower = Bound{Version: coreVersion(v.Major, v.Minor, v.Patch), Inclusive: false}
+			if v.Patch < math.MaxUint64 {
+				lower = Bound{Version: coreVersion(v.Major, v.Minor, v.Patch+1), Inclusive: true}
+			}
+		}
+	}
+
+	upper := Bound{Version: nil, Inclusive: false}
+	if v := i.Upper.Version; v != nil {
+		upper = Bound{Version: coreVersion(v.Major, v.Minor, v.Patch), Inclusive: false}
+		if len(v.Prerelease) == 0 && i.Upper.Inclusive {
+			upper = Bound{Version: coreVersion(v.Major, v.Minor, v.Patch), Inclusive: true}
+			if v.Patch < math.MaxUint64 {
+				upper = Bound{Version: coreVersion(v.Major, v.Minor, v.Patch+1), Inclusive: false}
+			}
+		}
+	}
+
+	return Interval{Lower: lower, Upper: upper}
+}
+
+// prereleaseIntervals returns the intervals of the pre-release versions that
+// satisfy c, merged. A range of c allows the pre-release versions of
+// the version cores of its comparators that have pre-release identifiers.
+func (c *Constraint) prereleaseIntervals() []Interval {
+	var all []Interval
+
+	for _, set := range c.sets {
+		intervals := rangeIntervals(set)
+
+		for _, comp := range set {
+			if len(comp.v.Prerelease) == 0 {
+				continue
+			}
+
+			v := comp.v
+			span := Interval{
+				Lower: Bound{Version: lowestVersion(v.Major, v.Minor, v.Patch), Inclusive: true},
+				Upper: Bound{Version: coreVersion(v.Major, v.Minor, v.Patch), Inclusive: false},
+			}
+
+			for _, i := range intervals {
+				if x := i.intersect(span); !x.IsEmpty() {
+					all = append(all, x)
+				}
+			}
+		}
+	}
+
+	return mergeIntervals(all)
+}
+
+// intervalsEqual reports whether the merged intervals a and b are equal.
+func intervalsEqual(a, b []Interval) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if compareLowerBounds(a[i].Lower, b[i].Lower) != 0 ||
+			compareUpperBounds(a[i].Upper, b[i].Upper) != 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// subtractIntervals returns the parts of the merged intervals a that are not
+// in any of the merged intervals b.
+func subtractIntervals(a, b []Interval) []Interval {
+	var result []Interval
+
+	for _, i := range a {
+		rest := []Interval{i}
+
+		for _, j := range b {
+			var next []Interval
+
+			for _, r := range rest {
+				if j.Lower.Version != nil {
+					upper := Bound{Version: j.Lower.Version, Inclusive: !j.Lower.Inclusive}
+
+					before := r.intersect(Interval{Lower: r.Lower, Upper: upper})
+					if !before.IsEmpty() {
+						next = append(next, before)
+					}
+				}
+
+				if j.Upper.Version != nil {
+					lower := Bound{Version: j.Upper.Version, Inclusive: !j.Upper.Inclusive}
+
+					after := r.intersect(Interval{Lower: lower, Upper: r.Upper})
+					if !after.IsEmpty() {
+						next = append(next, after)
+					}
+				}
+			}
+
+			rest = next
+		}
+
+		result = append(result, rest...)
+	}
+
+	return result
+}
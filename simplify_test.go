@@ -0,0 +1,84 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver_test
+
+import (
+	"testing"
+
+	"github.com/anttikivi/semver"
+	"github.com/anttikivi/semver/semvertest"
+)
+
+func TestConstraintSimplify(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		c    string
+		want string
+	}{
+		{">=1.2.0 <2.0.0 || >=1.5.0 <1.8.0", ">=1.2.0 <2.0.0"},
+		{">=1.2.0 <2.0.0 || >=1.5.0", ">=1.2.0"},
+		{"^1.2.0 || ^1.4.0", ">=1.2.0 <2.0.0"},
+		{"^2.0.0 || ^1.0.0", ">=1.0.0 <3.0.0"},
+		{"~1.2.3 || ~1.4.0", ">=1.2.3 <1.3.0 || >=1.4.0 <1.5.0"},
+		{"1.2.3 || 1.2.3", "=1.2.3"},
+		{">=1.0.0 >=1.1.0 <3.0.0 <2.0.0", ">=1.1.0 <2.0.0"},
+		{"* || ^1.0.0", "*"},
+		{"<1.0.0 || >=0.5.0 <2.0.0", "<2.0.0"},
+		{">=1.2.0-beta <2.0.0 || >=1.5.0", ">=1.2.0-beta"},
+		{">=1.0.0 <1.3.0 || >=1.3.0-beta <2.0.0", ">=1.0.0 <2.0.0 || >=1.3.0-beta <1.3.0"},
+		{">2.0.0 <1.0.0", ">2.0.0 <1.0.0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.c, func(t *testing.T) {
+			t.Parallel()
+
+			c := semver.MustParseConstraint(tt.c)
+			got := c.Simplify()
+
+			if got.String() != tt.want {
+				t.Errorf("Constraint{%q}.Simplify() = %q, want %q", tt.c, got, tt.want)
+			}
+
+			for _, v := range probeVersions() {
+				if got.Check(v) != c.Check(v) {
+					t.Errorf(
+						"Constraint{%q}.Simplify().Check(%q) = %v, want %v",
+						tt.c, v, got.Check(v), c.Check(v),
+					)
+				}
+			}
+		})
+	}
+}
+
+func probeVersions() semver.Versions {
+	vs := semvertest.SpecChain()
+	for _, s := range []string{
+		"0.0.0", "0.5.0", "0.9.9", "1.0.0-rc.1", "1.2.0-alpha", "1.2.0-beta", "1.2.0-rc.1", "1.2.0", "1.2.3",
+		"1.2.4", "1.3.0-beta", "1.3.0-rc.1", "1.3.0", "1.4.0", "1.4.9", "1.5.0", "1.8.0", "1.9.9", "2.0.0-rc.1",
+		"2.5.0", "3.0.0-rc.1", "3.0.0", "10.0.0",
+	} {
+		vs = append(vs, semver.MustParse(s))
+	}
+
+	return vs
+}
@@ -0,0 +1,103 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver
+
+// A VersionSkew is the distance between two versions. The distance is given at
+// the most significant level in which the versions differ: if the major
+// versions differ, only Majors is set, if the minor versions differ, only
+// Minors is set, and if only the patch versions differ, only Patches is set.
+// For example, the skew between "1.2.3" and "1.5.0" is three minor versions.
+type VersionSkew struct {
+	Majors  uint64
+	Minors  uint64
+	Patches uint64
+}
+
+// A SkewPolicy is a policy for the allowed skew between two versions, for
+// example, between the components of a cluster during a rolling upgrade.
+// The zero value allows only versions that have the same major and minor
+// versions.
+type SkewPolicy struct {
+	// MaxMajors is the maximum number of major versions the versions may be
+	// apart.
+	MaxMajors uint64
+
+	// MaxMinors is the maximum number of minor versions the versions may be
+	// apart if they have the same major version.
+	MaxMinors uint64
+}
+
+// Skew returns the distance between versions a and b. The order of
+// the versions doesn't matter.
+func Skew(a, b *Version) VersionSkew {
+	switch {
+	case a.Major != b.Major:
+		return VersionSkew{Majors: absDiff(a.Major, b.Major), Minors: 0, Patches: 0}
+	case a.Minor != b.Minor:
+		return VersionSkew{Majors: 0, Minors: absDiff(a.Minor, b.Minor), Patches: 0}
+	default:
+		return VersionSkew{Majors: 0, Minors: 0, Patches: absDiff(a.Patch, b.Patch)}
+	}
+}
+
+// MaxSkew returns the greatest distance between any two versions in vs. It is
+// the distance between the lowest and the greatest version in vs.
+func MaxSkew(vs Versions) VersionSkew {
+	if len(vs) == 0 {
+		return VersionSkew{Majors: 0, Minors: 0, Patches: 0}
+	}
+
+	lowest, greatest := vs[0], vs[0]
+
+	for _, v := range vs[1:] {
+		if v.Compare(lowest) < 0 {
+			lowest = v
+		}
+
+		if v.Compare(greatest) > 0 {
+			greatest = v
+		}
+	}
+
+	return Skew(lowest, greatest)
+}
+
+// AllowedSkew reports whether the distance between versions a and b is allowed
+// by policy p.
+func AllowedSkew(p SkewPolicy, a, b *Version) bool {
+	return p.Allows(Skew(a, b))
+}
+
+// Allows reports whether policy p allows the skew s.
+func (p SkewPolicy) Allows(s VersionSkew) bool {
+	if s.Majors > 0 {
+		return s.Majors <= p.MaxMajors
+	}
+
+	return s.Minors <= p.MaxMinors
+}
+
+func absDiff(a, b uint64) uint64 {
+	if a > b {
+		return a - b
+	}
+
+	return b - a
+}
@@ -0,0 +1,94 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver_test
+
+import (
+	"testing"
+
+	"github.com/anttikivi/semver"
+)
+
+func TestSkew(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		a    string
+		b    string
+		want semver.VersionSkew
+	}{
+		{"1.2.3", "1.2.3", semver.VersionSkew{}},
+		{"1.2.3", "1.2.5", semver.VersionSkew{Patches: 2}},
+		{"1.2.3", "1.5.0", semver.VersionSkew{Minors: 3}},
+		{"1.5.0", "1.2.3", semver.VersionSkew{Minors: 3}},
+		{"3.0.0", "1.9.9", semver.VersionSkew{Majors: 2}},
+		{"1.2.3-rc.1", "1.2.3", semver.VersionSkew{}},
+	}
+
+	for _, tt := range tests {
+		if got := semver.Skew(semver.MustParse(tt.a), semver.MustParse(tt.b)); got != tt.want {
+			t.Errorf("Skew(%q, %q) = %+v, want %+v", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestMaxSkew(t *testing.T) {
+	t.Parallel()
+
+	vs := semver.Versions{
+		semver.MustParse("1.28.3"),
+		semver.MustParse("1.25.0"),
+		semver.MustParse("1.29.1"),
+		semver.MustParse("1.27.0"),
+	}
+
+	if got, want := semver.MaxSkew(vs), (semver.VersionSkew{Minors: 4}); got != want {
+		t.Errorf("MaxSkew(%v) = %+v, want %+v", vs, got, want)
+	}
+
+	if got := semver.MaxSkew(nil); got != (semver.VersionSkew{}) {
+		t.Errorf("MaxSkew(nil) = %+v, want zero", got)
+	}
+}
+
+func TestAllowedSkew(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		p    semver.SkewPolicy
+		a    string
+		b    string
+		want bool
+	}{
+		{semver.SkewPolicy{}, "1.2.3", "1.2.9", true},
+		{semver.SkewPolicy{}, "1.2.3", "1.3.0", false},
+		{semver.SkewPolicy{MaxMinors: 3}, "1.26.0", "1.29.0", true},
+		{semver.SkewPolicy{MaxMinors: 3}, "1.25.0", "1.29.0", false},
+		{semver.SkewPolicy{MaxMinors: 3}, "1.29.0", "2.0.0", false},
+		{semver.SkewPolicy{MaxMajors: 1}, "1.29.0", "2.0.0", true},
+		{semver.SkewPolicy{MaxMajors: 1}, "1.0.0", "3.0.0", false},
+	}
+
+	for _, tt := range tests {
+		got := semver.AllowedSkew(tt.p, semver.MustParse(tt.a), semver.MustParse(tt.b))
+		if got != tt.want {
+			t.Errorf("AllowedSkew(%+v, %q, %q) = %v, want %v", tt.p, tt.a, tt.b, got, tt.want)
+		}
+	}
+}
@@ -21,11 +21,13 @@ package semver_test
 
 import (
 	"reflect"
+	"slices"
 	"sort"
 	"strconv"
 	"testing"
 
 	"github.com/anttikivi/semver"
+	"github.com/anttikivi/semver/semvertest"
 )
 
 func TestVersionsSort(t *testing.T) {
@@ -164,3 +166,17 @@ func TestVersionsSort(t *testing.T) {
 		})
 	}
 }
+
+func TestVersionsSortSpecChain(t *testing.T) {
+	t.Parallel()
+
+	want := versionStrings(semvertest.SpecChain())
+
+	vs := semvertest.SpecChain()
+	slices.Reverse(vs)
+	sort.Sort(vs)
+
+	if got := versionStrings(vs); !reflect.DeepEqual(got, want) {
+		t.Errorf("sort.Sort(SpecChain()) = %v, want %v", got, want)
+	}
+}
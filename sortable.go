@@ -0,0 +1,250 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Marker bytes used in the sortable key encoding. Their relative byte order is
+// what makes the encoded keys sort by precedence, so they must not be changed:
+// the build marker sorts before every identifier marker, the numeric identifier
+// marker before the alphanumeric one, and all of them before the release
+// marker.
+const (
+	sortableBuild        = '!'
+	sortableNumeric      = '#'
+	sortableAlphanumeric = '$'
+	sortableRelease      = '~'
+)
+
+// sortableNumberWidth is the number of digits used for encoding the numbers in
+// the sortable key. It is the number of digits in the largest uint64.
+const sortableNumberWidth = 20
+
+// sortableCoreWidth is the width of the encoded version core in the sortable
+// key: three numbers and two dots.
+const sortableCoreWidth = 3*sortableNumberWidth + 2
+
+// SortableKey returns an encoded form of v whose byte order equals the order of
+// precedence of the versions. The keys can be compared using plain byte
+// comparison, for example, using [strings.Compare] or in the index of
+// a key-value store or a database without a custom collator.
+//
+// The numbers in the version core and the numeric pre-release identifiers are
+// zero-padded to a fixed width of 20 digits. The core is followed by a marker
+// that sorts releases after pre-releases, and the pre-release identifiers are
+// prefixed by markers that sort numeric identifiers before alphanumeric ones.
+// The build metadata is appended at the end of the key, so it is only used for
+// ordering the keys of versions that have the same precedence.
+//
+// The key holds all of the information in the version, and the version can be
+// recovered from it using [ParseSortableKey].
+func (v *Version) SortableKey() string {
+	var sb strings.Builder
+
+	sb.Grow(sortableCoreWidth + 1)
+
+	writeSortableNumber(&sb, v.Major)
+	sb.WriteByte('.')
+	writeSortableNumber(&sb, v.Minor)
+	sb.WriteByte('.')
+	writeSortableNumber(&sb, v.Patch)
+
+	if len(v.Prerelease) == 0 {
+		sb.WriteByte(sortableRelease)
+	}
+
+	for _, ident := range v.Prerelease {
+		switch i := ident.(type) {
+		case numericIdentifier:
+			sb.WriteByte(sortableNumeric)
+			writeSortableNumber(&sb, i.v)
+		case alphanumericIdentifier:
+			sb.WriteByte(sortableAlphanumeric)
+			sb.WriteString(i.v)
+		default:
+			// Internal invariant violation.
+			panic("invalid pre-release identifier in sortable key: " + ident.String())
+		}
+	}
+
+	if len(v.Build) > 0 {
+		sb.WriteByte(sortableBuild)
+		sb.WriteString(v.Build.String())
+	}
+
+	return sb.String()
+}
+
+// ParseSortableKey parses a key returned by [Version.SortableKey] back into
+// a Version. The returned Version is strictly equal to the Version the key was
+// created from.
+func ParseSortableKey(s string) (*Version, error) {
+	if len(s) < sortableCoreWidth+1 {
+		return nil, fmt.Errorf("%w: sortable key %q is too short", ErrInvalidVersion, s)
+	}
+
+	var (
+		nums [3]uint64
+		err  error
+	)
+
+	for i := range nums {
+		start := i * (sortableNumberWidth + 1)
+
+		if i > 0 && s[start-1] != '.' {
+			return nil, fmt.Errorf(
+				"%w: invalid char %q at %d in sortable key",
+				ErrInvalidVersion,
+				s[start-1],
+				start-1,
+			)
+		}
+
+		if nums[i], err = parseSortableNumber(s[start : start+sortableNumberWidth]); err != nil {
+			return nil, err
+		}
+	}
+
+	v := &Version{
+		Major:      nums[0],
+		Minor:      nums[1],
+		Patch:      nums[2],
+		Prerelease: nil,
+		Build:      nil,
+	}
+
+	pos := sortableCoreWidth
+
+	if s[pos] == sortableRelease {
+		pos++
+	} else if v.Prerelease, pos, err = parseSortablePrerelease(s, pos); err != nil {
+		return nil, err
+	}
+
+	if pos == len(s) {
+		return v, nil
+	}
+
+	if s[pos] != sortableBuild {
+		return nil, fmt.Errorf(
+			"%w: invalid char %q at %d in sortable key",
+			ErrInvalidVersion,
+			s[pos],
+			pos,
+		)
+	}
+
+	if v.Build, err = parseBuild(s[pos+1:]); err != nil {
+		return nil, fmt.Errorf("failed to parse the build identifiers in sortable key: %w", err)
+	}
+
+	return v, nil
+}
+
+// parseSortablePrerelease parses the pre-release identifiers of a sortable key
+// starting at pos. It returns the identifiers and the position after them.
+func parseSortablePrerelease(s string, pos int) (Prerelease, int, error) {
+	var prerelease Prerelease
+
+	for pos < len(s) && s[pos] != sortableBuild {
+		marker := s[pos]
+		pos++
+
+		switch marker {
+		case sortableNumeric:
+			if len(s)-pos < sortableNumberWidth {
+				return nil, pos, fmt.Errorf(
+					"%w: truncated numeric identifier in sortable key %q",
+					ErrInvalidVersion,
+					s,
+				)
+			}
+
+			u, err := parseSortableNumber(s[pos : pos+sortableNumberWidth])
+			if err != nil {
+				return nil, pos, err
+			}
+
+			prerelease = append(prerelease, numericIdentifier{u})
+			pos += sortableNumberWidth
+		case sortableAlphanumeric:
+			end := pos
+
+			for end < len(s) && isIdentifierCharacter(s[end]) {
+				end++
+			}
+
+			ident := s[pos:end]
+
+			// Numeric identifiers must use the numeric marker so that every
+			// version has exactly one key.
+			if ident == "" || isNumericIdentifier(ident) {
+				return nil, pos, fmt.Errorf(
+					"%w: invalid alphanumeric identifier %q in sortable key",
+					ErrInvalidVersion,
+					ident,
+				)
+			}
+
+			prerelease = append(prerelease, alphanumericIdentifier{ident})
+			pos = end
+		default:
+			return nil, pos, fmt.Errorf(
+				"%w: invalid char %q at %d in sortable key",
+				ErrInvalidVersion,
+				marker,
+				pos-1,
+			)
+		}
+	}
+
+	if len(prerelease) == 0 {
+		return nil, pos, fmt.Errorf("%w: missing release marker in sortable key", ErrInvalidVersion)
+	}
+
+	return prerelease, pos, nil
+}
+
+func parseSortableNumber(s string) (uint64, error) {
+	if !isNumericIdentifier(s) {
+		return 0, fmt.Errorf("%w: invalid number %q in sortable key", ErrInvalidVersion, s)
+	}
+
+	u, err := strconv.ParseUint(s, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("failed to convert the string %q to uint64: %w", s, err)
+	}
+
+	return u, nil
+}
+
+func writeSortableNumber(sb *strings.Builder, u uint64) {
+	s := strconv.FormatUint(u, 10)
+
+	for range sortableNumberWidth - len(s) {
+		sb.WriteByte('0')
+	}
+
+	sb.WriteString(s)
+}
@@ -0,0 +1,195 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/anttikivi/semver"
+)
+
+func TestVersionSortableKey(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		v    string
+		want string
+	}{
+		{"0.0.0", "00000000000000000000.00000000000000000000.00000000000000000000~"},
+		{"1.2.3", "00000000000000000001.00000000000000000002.00000000000000000003~"},
+		{
+			"1.2.3-alpha.1",
+			"00000000000000000001.00000000000000000002.00000000000000000003$alpha#00000000000000000001",
+		},
+		{
+			"1.2.3+build.5",
+			"00000000000000000001.00000000000000000002.00000000000000000003~!build.5",
+		},
+		{
+			"18446744073709551615.0.0",
+			"18446744073709551615.00000000000000000000.00000000000000000000~",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.v, func(t *testing.T) {
+			t.Parallel()
+
+			got := semver.MustParse(tt.v).SortableKey()
+			if got != tt.want {
+				t.Errorf("Version{%q}.SortableKey() = %q, want %q", tt.v, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVersionSortableKeyOrder(t *testing.T) {
+	t.Parallel()
+
+	versions := []string{
+		"0.0.0",
+		"0.0.1",
+		"0.1.0",
+		"1.0.0-0",
+		"1.0.0-0.0",
+		"1.0.0-1",
+		"1.0.0-2",
+		"1.0.0-10",
+		"1.0.0--",
+		"1.0.0-A",
+		"1.0.0-alpha",
+		"1.0.0-alpha.1",
+		"1.0.0-alpha.-",
+		"1.0.0-alpha.beta",
+		"1.0.0-alpha-",
+		"1.0.0-alpha0",
+		"1.0.0-beta",
+		"1.0.0-beta.2",
+		"1.0.0-beta.11",
+		"1.0.0-rc.1",
+		"1.0.0",
+		"1.0.0+build",
+		"1.0.1-beta+build",
+		"1.0.1",
+		"1.2.0",
+		"1.10.0",
+		"2.0.0",
+		"10.0.0",
+		"18446744073709551615.0.0",
+	}
+
+	for i, x := range versions {
+		for j, y := range versions {
+			v := semver.MustParse(x)
+			w := semver.MustParse(y)
+
+			want := v.Compare(w)
+			if want == 0 {
+				// Build metadata breaks the ties.
+				want = strings.Compare(v.Build.String(), w.Build.String())
+			}
+
+			got := strings.Compare(v.SortableKey(), w.SortableKey())
+			if got != want {
+				t.Errorf(
+					"strings.Compare(%q.SortableKey(), %q.SortableKey()) = %d, want %d (indexes %d and %d)",
+					x,
+					y,
+					got,
+					want,
+					i,
+					j,
+				)
+			}
+		}
+	}
+}
+
+func TestParseSortableKey(t *testing.T) {
+	t.Parallel()
+
+	tests := []string{
+		"0.0.0",
+		"1.2.3",
+		"1.2.3-0",
+		"1.2.3-alpha",
+		"1.2.3-alpha.1",
+		"1.2.3-alpha.beta.0.-.x-y-z",
+		"1.2.3-0a.1a",
+		"1.2.3+build",
+		"1.2.3+001.sha-5114f85",
+		"1.2.3-rc.1+build.2",
+		"18446744073709551615.18446744073709551615.18446744073709551615-18446744073709551615",
+	}
+
+	for _, tt := range tests {
+		t.Run(tt, func(t *testing.T) {
+			t.Parallel()
+
+			v := semver.MustParse(tt)
+
+			got, err := semver.ParseSortableKey(v.SortableKey())
+			if err != nil {
+				t.Fatalf("ParseSortableKey(%q) failed unexpectedly: %v", v.SortableKey(), err)
+			}
+
+			if !got.StrictEqual(v) {
+				t.Errorf("ParseSortableKey(%q) = %v, want %v", v.SortableKey(), got, v)
+			}
+		})
+	}
+}
+
+func TestParseSortableKeyInvalid(t *testing.T) {
+	t.Parallel()
+
+	core := "00000000000000000001.00000000000000000002.00000000000000000003"
+
+	tests := []string{
+		"",
+		"1.2.3",
+		core,
+		core + "!build",
+		core + "~~",
+		core + "~!",
+		core + "~!build..1",
+		core + "$",
+		core + "$123",
+		core + "$alpha.beta",
+		core + "#1",
+		core + "#0000000000000000000a",
+		core + "#99999999999999999999",
+		"0000000000000000000a.00000000000000000002.00000000000000000003~",
+		"00000000000000000001-00000000000000000002.00000000000000000003~",
+		"99999999999999999999.00000000000000000002.00000000000000000003~",
+	}
+
+	for _, tt := range tests {
+		t.Run(tt, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := semver.ParseSortableKey(tt)
+			if err == nil {
+				t.Errorf("ParseSortableKey(%q) = %v, want error", tt, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,178 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+)
+
+// ErrNotSorted is returned when versions that must be in strictly increasing
+// order are not.
+var ErrNotSorted = errors.New("versions are not sorted")
+
+// SortedVersions is a collection of versions that are in strictly increasing
+// order of precedence, i.e. sorted and without equal versions. The zero value
+// is an empty collection. The versions are shared with the slices that
+// the collection is created from, and they must not be modified.
+type SortedVersions struct {
+	x Versions
+}
+
+// NewSortedVersions returns a SortedVersions with the given versions. It
+// sorts a copy of the versions and removes the equal versions, keeping
+// the first one of them.
+func NewSortedVersions(versions ...*Version) SortedVersions {
+	x := slices.Clone(versions)
+	slices.SortStableFunc(x, Compare)
+
+	return SortedVersions{x: slices.CompactFunc(x, (*Version).Equal)}
+}
+
+// AsSortedVersions returns a SortedVersions that uses x without copying it.
+// It returns an error that wraps [ErrNotSorted] if x is not in strictly
+// increasing order. x must not be modified after the call.
+func AsSortedVersions(x Versions) (SortedVersions, error) {
+	for i := 1; i < len(x); i++ {
+		if x[i-1].Compare(x[i]) >= 0 {
+			return SortedVersions{}, fmt.Errorf(
+				"%w: %s at index %d is not less than %s",
+				ErrNotSorted,
+				x[i-1],
+				i-1,
+				x[i],
+			)
+		}
+	}
+
+	return SortedVersions{x: x[:len(x):len(x)]}, nil
+}
+
+// Merge returns the versions in a and b as a SortedVersions. If a version is
+// in both of them, the one in a is kept. Merge takes linear time.
+func Merge(a, b SortedVersions) SortedVersions {
+	x := make(Versions, 0, len(a.x)+len(b.x))
+
+	i, j := 0, 0
+	for i < len(a.x) && j < len(b.x) {
+		switch c := a.x[i].Compare(b.x[j]); {
+		case c < 0:
+			x = append(x, a.x[i])
+			i++
+		case c > 0:
+			x = append(x, b.x[j])
+			j++
+		default:
+			x = append(x, a.x[i])
+			i++
+			j++
+		}
+	}
+
+	x = append(x, a.x[i:]...)
+	x = append(x, b.x[j:]...)
+
+	return SortedVersions{x: x}
+}
+
+// Search returns the index of the first version in x that is greater than or
+// equal to v, or len(x) if there is none. x must be sorted in increasing
+// order. Search uses binary search.
+func (x Versions) Search(v *Version) int {
+	return sort.Search(len(x), func(i int) bool { return x[i].Compare(v) >= 0 })
+}
+
+// SliceBetween returns the part of x with the versions that are greater than
+// or equal to lo and less than hi. If lo is nil, the part starts at
+// the beginning of x, and if hi is nil, it continues to the end. x must be
+// sorted in increasing order. The returned slice shares its elements with x.
+func (x Versions) SliceBetween(lo, hi *Version) Versions {
+	i, j := 0, len(x)
+
+	if lo != nil {
+		i = x.Search(lo)
+	}
+
+	if hi != nil {
+		j = x.Search(hi)
+	}
+
+	if j < i {
+		j = i
+	}
+
+	return x[i:j:j]
+}
+
+// Insert adds v to s in its place and reports whether it was added. If s
+// already has a version that is equal to v, s is not changed. Insert doesn't
+// modify the versions that s shares with other collections, so the results of
+// the earlier calls to [SortedVersions.SliceBetween] stay the same.
+func (s *SortedVersions) Insert(v *Version) bool {
+	i := s.x.Search(v)
+	if i < len(s.x) && s.x[i].Equal(v) {
+		return false
+	}
+
+	x := make(Versions, len(s.x)+1)
+	copy(x, s.x[:i])
+	x[i] = v
+	copy(x[i+1:], s.x[i:])
+
+	s.x = x
+
+	return true
+}
+
+// Len returns the number of versions in s.
+func (s SortedVersions) Len() int {
+	return len(s.x)
+}
+
+// At returns the version at index i in s.
+func (s SortedVersions) At(i int) *Version {
+	return s.x[i]
+}
+
+// Versions returns a copy of the versions in s.
+func (s SortedVersions) Versions() Versions {
+	return slices.Clone(s.x)
+}
+
+// Search returns the index of the first version in s that is greater than or
+// equal to v, or s.Len() if there is none.
+func (s SortedVersions) Search(v *Version) int {
+	return s.x.Search(v)
+}
+
+// Contains reports whether s has a version that is equal to v.
+func (s SortedVersions) Contains(v *Version) bool {
+	i := s.x.Search(v)
+
+	return i < len(s.x) && s.x[i].Equal(v)
+}
+
+// SliceBetween returns the versions in s that are greater than or equal to lo
+// and less than hi. If lo or hi is nil, that end is not bounded.
+func (s SortedVersions) SliceBetween(lo, hi *Version) SortedVersions {
+	return SortedVersions{x: s.x.SliceBetween(lo, hi)}
+}
@@ -0,0 +1,184 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver_test
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/anttikivi/semver"
+)
+
+func TestVersionsSearch(t *testing.T) {
+	t.Parallel()
+
+	x := semver.Versions{
+		semver.MustParse("1.0.0"),
+		semver.MustParse("1.1.0-rc.1"),
+		semver.MustParse("1.1.0"),
+		semver.MustParse("2.0.0"),
+	}
+
+	tests := []struct {
+		v    string
+		want int
+	}{
+		{"0.1.0", 0},
+		{"1.0.0", 0},
+		{"1.1.0-0", 1},
+		{"1.1.0+build", 2},
+		{"1.5.0", 3},
+		{"3.0.0", 4},
+	}
+
+	for _, tt := range tests {
+		if got := x.Search(semver.MustParse(tt.v)); got != tt.want {
+			t.Errorf("Search(%q) = %d, want %d", tt.v, got, tt.want)
+		}
+	}
+}
+
+func TestVersionsSliceBetween(t *testing.T) {
+	t.Parallel()
+
+	x := semver.Versions{
+		semver.MustParse("1.0.0"),
+		semver.MustParse("1.1.0"),
+		semver.MustParse("1.2.0"),
+		semver.MustParse("2.0.0"),
+	}
+
+	tests := []struct {
+		lo, hi string
+		want   []string
+	}{
+		{"1.1.0", "2.0.0", []string{"1.1.0", "1.2.0"}},
+		{"", "1.1.0", []string{"1.0.0"}},
+		{"1.2.0", "", []string{"1.2.0", "2.0.0"}},
+		{"2.0.0", "1.0.0", []string{}},
+	}
+
+	for _, tt := range tests {
+		var lo, hi *semver.Version
+
+		if tt.lo != "" {
+			lo = semver.MustParse(tt.lo)
+		}
+
+		if tt.hi != "" {
+			hi = semver.MustParse(tt.hi)
+		}
+
+		if got := versionStrings(x.SliceBetween(lo, hi)); !slices.Equal(got, tt.want) {
+			t.Errorf("SliceBetween(%q, %q) = %q, want %q", tt.lo, tt.hi, got, tt.want)
+		}
+	}
+}
+
+func TestSortedVersions(t *testing.T) {
+	t.Parallel()
+
+	s := semver.NewSortedVersions(
+		semver.MustParse("2.0.0"),
+		semver.MustParse("1.0.0+a"),
+		semver.MustParse("1.5.0"),
+		semver.MustParse("1.0.0+b"),
+	)
+
+	want := []string{"1.0.0+a", "1.5.0", "2.0.0"}
+	if got := versionStrings(s.Versions()); !slices.Equal(got, want) {
+		t.Errorf("NewSortedVersions() = %q, want %q", got, want)
+	}
+
+	if !s.Contains(semver.MustParse("1.5.0")) || s.Contains(semver.MustParse("1.4.0")) {
+		t.Error("Contains() returned a wrong result")
+	}
+
+	if got := s.SliceBetween(semver.MustParse("1.1.0"), nil); got.Len() != 2 ||
+		got.At(0).String() != "1.5.0" {
+		t.Errorf("SliceBetween(1.1.0, nil) = %q", versionStrings(got.Versions()))
+	}
+
+	_, err := semver.AsSortedVersions(semver.Versions{
+		semver.MustParse("1.0.0"),
+		semver.MustParse("1.0.0+build"),
+	})
+	if !errors.Is(err, semver.ErrNotSorted) {
+		t.Errorf("AsSortedVersions() error = %v, want %v", err, semver.ErrNotSorted)
+	}
+
+	if _, err := semver.AsSortedVersions(s.Versions()); err != nil {
+		t.Errorf("AsSortedVersions() error = %v", err)
+	}
+}
+
+func TestSortedVersionsInsert(t *testing.T) {
+	t.Parallel()
+
+	s := semver.NewSortedVersions(semver.MustParse("1.0.0"), semver.MustParse("2.0.0"))
+	before := s.SliceBetween(nil, nil)
+
+	if !s.Insert(semver.MustParse("1.5.0")) {
+		t.Error("Insert(1.5.0) = false, want true")
+	}
+
+	if s.Insert(semver.MustParse("1.5.0+build")) {
+		t.Error("Insert(1.5.0+build) = true, want false")
+	}
+
+	if !s.Insert(semver.MustParse("0.1.0")) || !s.Insert(semver.MustParse("3.0.0")) {
+		t.Error("Insert() at the ends = false, want true")
+	}
+
+	want := []string{"0.1.0", "1.0.0", "1.5.0", "2.0.0", "3.0.0"}
+	if got := versionStrings(s.Versions()); !slices.Equal(got, want) {
+		t.Errorf("after Insert() = %q, want %q", got, want)
+	}
+
+	if got := versionStrings(before.Versions()); !slices.Equal(got, []string{"1.0.0", "2.0.0"}) {
+		t.Errorf("Insert() changed an earlier slice to %q", got)
+	}
+}
+
+func TestMerge(t *testing.T) {
+	t.Parallel()
+
+	a := semver.NewSortedVersions(
+		semver.MustParse("1.0.0+a"),
+		semver.MustParse("1.2.0"),
+		semver.MustParse("3.0.0"),
+	)
+	b := semver.NewSortedVersions(
+		semver.MustParse("1.0.0+b"),
+		semver.MustParse("2.0.0"),
+		semver.MustParse("4.0.0"),
+		semver.MustParse("5.0.0"),
+	)
+
+	want := []string{"1.0.0+a", "1.2.0", "2.0.0", "3.0.0", "4.0.0", "5.0.0"}
+	if got := versionStrings(semver.Merge(a, b).Versions()); !slices.Equal(got, want) {
+		t.Errorf("Merge() = %q, want %q", got, want)
+	}
+
+	if got := semver.Merge(semver.SortedVersions{}, semver.SortedVersions{}); got.Len() != 0 {
+		t.Errorf("Merge() of empty collections has %d versions", got.Len())
+	}
+}
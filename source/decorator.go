@@ -0,0 +1,148 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package source
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/anttikivi/semver"
+)
+
+// A Limiter limits the rate of the requests to a source.
+// The Limiter type of golang.org/x/time/rate implements it.
+type Limiter interface {
+	// Wait blocks until the next request is allowed or ctx is done. It returns
+	// an error if the request is not allowed before ctx is done.
+	Wait(ctx context.Context) error
+}
+
+// cached is the source returned by CachedSource.
+type cached struct {
+	src semver.VersionSource
+	ttl time.Duration
+
+	mu       sync.Mutex
+	versions semver.Versions
+	expires  time.Time
+}
+
+// IntervalLimiter is a [Limiter] that allows at most one request per interval.
+// It is safe for concurrent use.
+type IntervalLimiter struct {
+	interval time.Duration
+
+	mu   sync.Mutex
+	next time.Time
+}
+
+// rateLimited is the source returned by RateLimitedSource.
+type rateLimited struct {
+	src     semver.VersionSource
+	limiter Limiter
+}
+
+// CachedSource returns a source that caches the versions listed by src for
+// the duration of ttl. The errors from src are not cached. The returned
+// source is safe for concurrent use, and the concurrent calls during a fetch
+// wait for it instead of calling src again.
+func CachedSource(src semver.VersionSource, ttl time.Duration) semver.VersionSource {
+	return &cached{src: src, ttl: ttl, mu: sync.Mutex{}, versions: nil, expires: time.Time{}}
+}
+
+// NewIntervalLimiter returns a new IntervalLimiter that allows at most one
+// request per interval.
+func NewIntervalLimiter(interval time.Duration) *IntervalLimiter {
+	return &IntervalLimiter{interval: interval, mu: sync.Mutex{}, next: time.Time{}}
+}
+
+// RateLimitedSource returns a source that waits for limiter before each call
+// to src.
+func RateLimitedSource(src semver.VersionSource, limiter Limiter) semver.VersionSource {
+	return &rateLimited{src: src, limiter: limiter}
+}
+
+// List returns the cached versions or lists them from the underlying source if
+// the cache has expired.
+func (c *cached) List(ctx context.Context) (semver.Versions, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.versions != nil && time.Now().Before(c.expires) {
+		return slices.Clone(c.versions), nil
+	}
+
+	versions, err := c.src.List(ctx)
+	if err != nil {
+		return nil, err //nolint:wrapcheck // the source is transparent
+	}
+
+	c.versions = slices.Clip(slices.Clone(versions))
+	if c.versions == nil {
+		c.versions = semver.Versions{}
+	}
+
+	c.expires = time.Now().Add(c.ttl)
+
+	return versions, nil
+}
+
+// Wait blocks until the interval since the previous allowed request has
+// passed or ctx is done.
+func (l *IntervalLimiter) Wait(ctx context.Context) error {
+	l.mu.Lock()
+
+	now := time.Now()
+	at := now
+	if l.next.After(now) {
+		at = l.next
+	}
+
+	l.next = at.Add(l.interval)
+
+	l.mu.Unlock()
+
+	if at.Equal(now) {
+		return nil
+	}
+
+	t := time.NewTimer(at.Sub(now))
+	defer t.Stop()
+
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("interrupted while waiting: %w", ctx.Err())
+	}
+}
+
+// List waits for the limiter and lists the versions from the underlying
+// source.
+func (r *rateLimited) List(ctx context.Context) (semver.Versions, error) {
+	if err := r.limiter.Wait(ctx); err != nil {
+		return nil, fmt.Errorf("failed to wait for rate limiter: %w", err)
+	}
+
+	return r.src.List(ctx) //nolint:wrapcheck // the source is transparent
+}
@@ -0,0 +1,138 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package source_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/anttikivi/semver"
+	"github.com/anttikivi/semver/source"
+)
+
+var errLimited = errors.New("limited")
+
+type countingSource struct {
+	calls atomic.Int32
+	err   error
+}
+
+type failingLimiter struct{}
+
+func (s *countingSource) List(context.Context) (semver.Versions, error) {
+	s.calls.Add(1)
+
+	if s.err != nil {
+		return nil, s.err
+	}
+
+	return semver.Versions{semver.MustParse("1.0.0")}, nil
+}
+
+func (failingLimiter) Wait(context.Context) error {
+	return errLimited
+}
+
+func TestCachedSource(t *testing.T) {
+	t.Parallel()
+
+	src := &countingSource{}
+	c := source.CachedSource(src, time.Hour)
+
+	for range 3 {
+		got, err := c.List(t.Context())
+		if err != nil {
+			t.Fatalf("List() error = %v", err)
+		}
+
+		if len(got) != 1 {
+			t.Fatalf("List() = %v, want one version", got)
+		}
+
+		got[0] = nil
+	}
+
+	if n := src.calls.Load(); n != 1 {
+		t.Errorf("underlying source called %d times, want 1", n)
+	}
+
+	expiring := source.CachedSource(src, time.Nanosecond)
+
+	for range 2 {
+		if _, err := expiring.List(t.Context()); err != nil {
+			t.Fatalf("List() error = %v", err)
+		}
+
+		time.Sleep(time.Millisecond)
+	}
+
+	if n := src.calls.Load(); n != 3 {
+		t.Errorf("underlying source called %d times, want 3", n)
+	}
+
+	failing := &countingSource{err: errLimited}
+	c = source.CachedSource(failing, time.Hour)
+
+	for range 2 {
+		if _, err := c.List(t.Context()); !errors.Is(err, errLimited) {
+			t.Errorf("List() error = %v, want %v", err, errLimited)
+		}
+	}
+
+	if n := failing.calls.Load(); n != 2 {
+		t.Errorf("failing source called %d times, want 2", n)
+	}
+}
+
+func TestRateLimitedSource(t *testing.T) {
+	t.Parallel()
+
+	src := &countingSource{}
+	limited := source.RateLimitedSource(src, source.NewIntervalLimiter(20*time.Millisecond))
+
+	start := time.Now()
+
+	for range 3 {
+		if _, err := limited.List(t.Context()); err != nil {
+			t.Fatalf("List() error = %v", err)
+		}
+	}
+
+	if d := time.Since(start); d < 40*time.Millisecond {
+		t.Errorf("three limited calls took %v, want at least 40ms", d)
+	}
+
+	if _, err := source.RateLimitedSource(src, failingLimiter{}).List(t.Context()); !errors.Is(err, errLimited) {
+		t.Errorf("List() error = %v, want %v", err, errLimited)
+	}
+
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+
+	slow := source.RateLimitedSource(src, source.NewIntervalLimiter(time.Hour))
+	_, _ = slow.List(ctx)
+
+	if _, err := slow.List(ctx); !errors.Is(err, context.Canceled) {
+		t.Errorf("List() error = %v, want %v", err, context.Canceled)
+	}
+}
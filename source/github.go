@@ -0,0 +1,224 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package source
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/anttikivi/semver"
+)
+
+// GitHubAPIURL is the base URL of the GitHub REST API.
+const GitHubAPIURL = "https://api.github.com"
+
+// githubPerPage is the number of items requested per page from the GitHub API.
+const githubPerPage = 100
+
+// ErrForeignLink is returned when the Link header of a response of the GitHub
+// API points the next page to a different scheme or host than the base URL.
+// The link isn't followed so that the token isn't sent to another host.
+var ErrForeignLink = errors.New("link to the next page on a different host")
+
+// A GitHub is a source that lists the versions of a GitHub repository from its
+// releases or tags. The draft releases and the releases and tags that are not
+// valid versions are skipped.
+type GitHub struct {
+	// Owner is the owner of the repository.
+	Owner string
+
+	// Repo is the name of the repository.
+	Repo string
+
+	// Tags makes the source list the tags of the repository instead of its
+	// releases.
+	Tags bool
+
+	// Prefix is the prefix that is stripped from the tag names before parsing
+	// them, for example "tool/" for the tags of a tool in a monorepo. The tags
+	// without the prefix are skipped. The "v" prefix of the versions doesn't
+	// have to be included.
+	Prefix string
+
+	// Token is the token used for authenticating to the API. It may be empty.
+	Token string
+
+	// BaseURL is the base URL of the API. If it is empty, [GitHubAPIURL] is
+	// used.
+	BaseURL string
+
+	// Client is the HTTP client for the requests. If it is nil,
+	// [http.DefaultClient] is used.
+	Client *http.Client
+}
+
+// githubRelease is a release in the responses of the GitHub API. The tags in
+// the responses are decoded into it, too, as they have the name of the tag in
+// Name.
+type githubRelease struct {
+	TagName     string    `json:"tag_name"`
+	Name        string    `json:"name"`
+	Draft       bool      `json:"draft"`
+	PublishedAt time.Time `json:"published_at"`
+}
+
+// List fetches the versions of the releases or tags of the repository.
+func (g *GitHub) List(ctx context.Context) (semver.Versions, error) {
+	releases, err := g.Releases(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return releases.Versions(), nil
+}
+
+// Releases fetches the releases or tags of the repository. The time of
+// the releases is the time when they were published. The tags don't have
+// a time.
+func (g *GitHub) Releases(ctx context.Context) (semver.Releases, error) {
+	base := g.BaseURL
+	if base == "" {
+		base = GitHubAPIURL
+	}
+
+	endpoint := "releases"
+	if g.Tags {
+		endpoint = "tags"
+	}
+
+	next := fmt.Sprintf(
+		"%s/repos/%s/%s/%s?per_page=%d",
+		strings.TrimSuffix(base, "/"),
+		url.PathEscape(g.Owner),
+		url.PathEscape(g.Repo),
+		endpoint,
+		githubPerPage,
+	)
+
+	header := http.Header{
+		"Accept":               {"application/vnd.github+json"},
+		"X-Github-Api-Version": {"2022-11-28"},
+	}
+	if g.Token != "" {
+		header.Set("Authorization", "Bearer "+g.Token)
+	}
+
+	origin, err := url.Parse(next)
+	if err != nil {
+		return nil, fmt.Errorf("invalid GitHub API URL %q: %w", next, err)
+	}
+
+	var releases semver.Releases
+
+	for next != "" {
+		page, link, err := g.page(ctx, next, header)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, r := range page {
+			tag := r.TagName
+			if g.Tags {
+				tag = r.Name
+			}
+
+			if r.Draft || !strings.HasPrefix(tag, g.Prefix) {
+				continue
+			}
+
+			v, err := semver.Parse(strings.TrimPrefix(tag, g.Prefix))
+			if err != nil {
+				continue
+			}
+
+			releases = append(releases, semver.Release{
+				Version: v,
+				Time:    r.PublishedAt,
+				Yanked:  false,
+				Channel: "",
+			})
+		}
+
+		next = nextLink(link)
+		if next == "" {
+			break
+		}
+
+		u, err := url.Parse(next)
+		if err != nil {
+			return nil, fmt.Errorf("invalid link to the next page %q: %w", next, err)
+		}
+
+		if u.Scheme != origin.Scheme || u.Host != origin.Host {
+			return nil, fmt.Errorf("%w: %s", ErrForeignLink, next)
+		}
+	}
+
+	return releases, nil
+}
+
+// page fetches a single page from the GitHub API and returns its items and
+// the Link header of the response.
+func (g *GitHub) page(
+	ctx context.Context,
+	u string,
+	header http.Header,
+) ([]githubRelease, string, error) {
+	resp, err := get(ctx, g.Client, u, header)
+	if err != nil {
+		return nil, "", err
+	}
+	defer resp.Body.Close()
+
+	var page []githubRelease
+
+	dec := json.NewDecoder(io.LimitReader(resp.Body, maxResponseSize))
+	if err := dec.Decode(&page); err != nil {
+		return nil, "", fmt.Errorf("failed to decode response from %s: %w", u, err)
+	}
+
+	return page, resp.Header.Get("Link"), nil
+}
+
+// nextLink returns the URL of the next page from the value of a Link header,
+// or an empty string if there is no next page.
+func nextLink(link string) string {
+	for part := range strings.SplitSeq(link, ",") {
+		target, params, ok := strings.Cut(strings.TrimSpace(part), ";")
+		if !ok || !strings.HasPrefix(target, "<") || !strings.HasSuffix(target, ">") {
+			continue
+		}
+
+		for param := range strings.SplitSeq(params, ";") {
+			if strings.TrimSpace(param) == `rel="next"` {
+				return target[1 : len(target)-1]
+			}
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,117 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package source_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/anttikivi/semver/source"
+)
+
+func TestGitHub(t *testing.T) {
+	t.Parallel()
+
+	mux := http.NewServeMux()
+
+	var srv *httptest.Server
+
+	mux.HandleFunc("GET /repos/o/r/releases", func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("Authorization"); got != "Bearer token" {
+			t.Errorf("Authorization = %q, want \"Bearer token\"", got)
+		}
+
+		if r.URL.Query().Get("page") == "2" {
+			fmt.Fprint(w, `[{"tag_name": "v0.9.0", "published_at": "2025-01-01T00:00:00Z"}]`)
+
+			return
+		}
+
+		w.Header().Set("Link", fmt.Sprintf(
+			`<%s/repos/o/r/releases?page=2>; rel="next", <%s/repos/o/r/releases?page=2>; rel="last"`,
+			srv.URL, srv.URL,
+		))
+		fmt.Fprint(w, `[
+			{"tag_name": "v1.1.0-rc.1", "published_at": "2025-03-01T00:00:00Z"},
+			{"tag_name": "v1.0.0", "published_at": "2025-02-01T00:00:00Z"},
+			{"tag_name": "v2.0.0", "draft": true},
+			{"tag_name": "nightly"}
+		]`)
+	})
+	mux.HandleFunc("GET /repos/o/r/tags", func(w http.ResponseWriter, _ *http.Request) {
+		fmt.Fprint(w, `[{"name": "tool/v1.2.3"}, {"name": "other/v2.0.0"}, {"name": "tool/1.3.0"}]`)
+	})
+
+	srv = httptest.NewServer(mux)
+	defer srv.Close()
+
+	releases := &source.GitHub{Owner: "o", Repo: "r", Token: "token", BaseURL: srv.URL}
+
+	got, err := releases.Releases(t.Context())
+	if err != nil {
+		t.Fatalf("Releases() error = %v", err)
+	}
+
+	if want := []string{"1.1.0-rc.1", "1.0.0", "0.9.0"}; !reflect.DeepEqual(versionStrings(got.Versions()), want) {
+		t.Errorf("Releases() = %v, want %v", versionStrings(got.Versions()), want)
+	}
+
+	if got[1].Time.Month() != 2 {
+		t.Errorf("Releases()[1].Time = %v, want February", got[1].Time)
+	}
+
+	tags := &source.GitHub{Owner: "o", Repo: "r", Tags: true, Prefix: "tool/", BaseURL: srv.URL + "/"}
+
+	vs, err := tags.List(t.Context())
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+
+	if want := []string{"1.2.3", "1.3.0"}; !reflect.DeepEqual(versionStrings(vs), want) {
+		t.Errorf("List() = %v, want %v", versionStrings(vs), want)
+	}
+}
+
+func TestGitHubForeignLink(t *testing.T) {
+	t.Parallel()
+
+	foreign := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("request to a foreign host with Authorization = %q", r.Header.Get("Authorization"))
+		fmt.Fprint(w, `[]`)
+	}))
+	defer foreign.Close()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		link := fmt.Sprintf(`<%s/repos/o/r/releases?page=2>; rel="next"`, foreign.URL)
+		w.Header().Set("Link", link)
+		fmt.Fprint(w, `[{"tag_name": "v1.0.0"}]`)
+	}))
+	defer srv.Close()
+
+	g := &source.GitHub{Owner: "o", Repo: "r", Token: "token", BaseURL: srv.URL}
+
+	if _, err := g.Releases(t.Context()); !errors.Is(err, source.ErrForeignLink) {
+		t.Errorf("Releases() error = %v, want %v", err, source.ErrForeignLink)
+	}
+}
@@ -0,0 +1,139 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package source
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/anttikivi/semver"
+)
+
+// GoProxyURL is the URL of the public Go module proxy.
+const GoProxyURL = "https://proxy.golang.org"
+
+// The lengths of the timestamp and the revision in a pseudo-version.
+const (
+	pseudoVersionTimestampLen = 14
+	pseudoVersionRevisionLen  = 12
+)
+
+// A GoProxy is a source that lists the versions of a Go module from
+// the "@v/list" endpoint of a Go module proxy. The versions that are not valid
+// semantic versions are skipped.
+type GoProxy struct {
+	// Module is the path of the module, for example
+	// "github.com/anttikivi/semver".
+	Module string
+
+	// BaseURL is the URL of the proxy. If it is empty, [GoProxyURL] is used.
+	BaseURL string
+
+	// Client is the HTTP client for the requests. If it is nil,
+	// [http.DefaultClient] is used.
+	Client *http.Client
+}
+
+// IsPseudoVersion reports whether v is a Go pseudo-version, like
+// "0.0.0-20191109021931-daa7c04131f5" or "1.2.4-0.20191109021931-daa7c04131f5",
+// that refers to a commit instead of a tagged version.
+func IsPseudoVersion(v *semver.Version) bool {
+	n := len(v.Prerelease)
+	if n == 0 {
+		return false
+	}
+
+	timestamp, revision, ok := strings.Cut(v.Prerelease[n-1].String(), "-")
+	if !ok || len(timestamp) != pseudoVersionTimestampLen ||
+		len(revision) != pseudoVersionRevisionLen {
+		return false
+	}
+
+	for i := range len(timestamp) {
+		if timestamp[i] < '0' || timestamp[i] > '9' {
+			return false
+		}
+	}
+
+	for i := range len(revision) {
+		if (revision[i] < '0' || revision[i] > '9') && (revision[i] < 'a' || revision[i] > 'f') {
+			return false
+		}
+	}
+
+	if n == 1 {
+		return v.Minor == 0 && v.Patch == 0
+	}
+
+	return v.Prerelease[n-2].String() == "0"
+}
+
+// List fetches the versions of the module from the proxy.
+func (p *GoProxy) List(ctx context.Context) (semver.Versions, error) {
+	base := p.BaseURL
+	if base == "" {
+		base = GoProxyURL
+	}
+
+	u := strings.TrimSuffix(base, "/") + "/" + escapeModulePath(p.Module) + "/@v/list"
+
+	resp, err := get(ctx, p.Client, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var versions semver.Versions
+
+	scanner := bufio.NewScanner(io.LimitReader(resp.Body, maxResponseSize))
+	for scanner.Scan() {
+		if v, err := semver.Parse(strings.TrimSpace(scanner.Text())); err == nil {
+			versions = append(versions, v)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read response from %s: %w", u, err)
+	}
+
+	return versions, nil
+}
+
+// escapeModulePath escapes the module path for the proxy protocol by replacing
+// every uppercase letter with an exclamation mark followed by the letter in
+// lowercase.
+func escapeModulePath(path string) string {
+	var sb strings.Builder
+
+	for _, r := range path {
+		if r >= 'A' && r <= 'Z' {
+			sb.WriteByte('!')
+			sb.WriteRune(r + ('a' - 'A'))
+		} else {
+			sb.WriteRune(r)
+		}
+	}
+
+	return sb.String()
+}
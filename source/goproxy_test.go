@@ -0,0 +1,84 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package source_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/anttikivi/semver"
+	"github.com/anttikivi/semver/source"
+)
+
+func TestGoProxy(t *testing.T) {
+	t.Parallel()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /github.com/!burnt!sushi/toml/@v/list", func(w http.ResponseWriter, _ *http.Request) {
+		fmt.Fprint(w, "v1.0.0\nv1.1.0\nv0.0.0-20191109021931-daa7c04131f5\nv2.0.0+incompatible\n\nbad\n")
+	})
+
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	src := &source.GoProxy{Module: "github.com/BurntSushi/toml", BaseURL: srv.URL}
+
+	got, err := src.List(t.Context())
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+
+	want := []string{"1.0.0", "1.1.0", "0.0.0-20191109021931-daa7c04131f5", "2.0.0+incompatible"}
+	if !reflect.DeepEqual(versionStrings(got), want) {
+		t.Errorf("List() = %v, want %v", versionStrings(got), want)
+	}
+
+	missing := &source.GoProxy{Module: "example.com/missing", BaseURL: srv.URL}
+	if _, err := missing.List(t.Context()); err == nil {
+		t.Error("List() for a missing module succeeded")
+	}
+}
+
+func TestIsPseudoVersion(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		v    string
+		want bool
+	}{
+		{"0.0.0-20191109021931-daa7c04131f5", true},
+		{"1.2.4-0.20191109021931-daa7c04131f5", true},
+		{"1.2.3-pre.0.20191109021931-daa7c04131f5", true},
+		{"1.2.3-20191109021931-daa7c04131f5", false},
+		{"1.2.3-pre.20191109021931-daa7c04131f5", false},
+		{"0.0.0-20191109021931-DAA7C04131F5", false},
+		{"1.2.3-rc.1", false},
+		{"1.2.3", false},
+	}
+
+	for _, tt := range tests {
+		if got := source.IsPseudoVersion(semver.MustParse(tt.v)); got != tt.want {
+			t.Errorf("IsPseudoVersion(%q) = %v, want %v", tt.v, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,174 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package source
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/anttikivi/semver"
+)
+
+// A ReleaseSource is a source that also lists the metadata of the releases,
+// like their publishing times. [GitHub] and [FileSource] implement it.
+type ReleaseSource interface {
+	semver.VersionSource
+
+	// Releases returns the available releases. The order of the releases is
+	// not specified.
+	Releases(ctx context.Context) (semver.Releases, error)
+}
+
+// A FileSource is a source that lists the versions from a snapshot file
+// written by [Snapshot]. The file is read on every call, so it can be
+// replaced with a newer snapshot while the source is in use.
+type FileSource struct {
+	// Path is the path of the snapshot file.
+	Path string
+}
+
+// snapshotFile is the JSON format of the snapshot files.
+type snapshotFile struct {
+	Taken    time.Time         `json:"taken"`
+	Releases []snapshotRelease `json:"releases"`
+}
+
+// snapshotRelease is a release in a snapshot file.
+type snapshotRelease struct {
+	Version string     `json:"version"`
+	Time    *time.Time `json:"time,omitempty"`
+	Yanked  bool       `json:"yanked,omitempty"`
+	Channel string     `json:"channel,omitempty"`
+}
+
+// ReadSnapshot reads a snapshot written by [Snapshot] from r. It returns
+// the releases in the snapshot and the time when the snapshot was taken.
+func ReadSnapshot(r io.Reader) (semver.Releases, time.Time, error) {
+	var f snapshotFile
+	if err := json.NewDecoder(r).Decode(&f); err != nil {
+		return nil, time.Time{}, fmt.Errorf("failed to decode snapshot: %w", err)
+	}
+
+	releases := make(semver.Releases, 0, len(f.Releases))
+
+	for _, r := range f.Releases {
+		v, err := semver.Parse(r.Version)
+		if err != nil {
+			return nil, time.Time{}, fmt.Errorf("invalid version in snapshot: %w", err)
+		}
+
+		var t time.Time
+		if r.Time != nil {
+			t = *r.Time
+		}
+
+		releases = append(releases, semver.Release{
+			Version: v,
+			Time:    t,
+			Yanked:  r.Yanked,
+			Channel: r.Channel,
+		})
+	}
+
+	return releases, f.Taken, nil
+}
+
+// Snapshot lists the versions from src and writes them as JSON to w, together
+// with the time when the snapshot was taken. If src is a [ReleaseSource],
+// the metadata of the releases, like their publishing times, is included.
+// The snapshot can be read using [ReadSnapshot] or [FileSource], for example,
+// for resolving constraints in an environment without network access.
+func Snapshot(ctx context.Context, src semver.VersionSource, w io.Writer) error {
+	taken := time.Now().UTC()
+
+	var releases semver.Releases
+
+	if rs, ok := src.(ReleaseSource); ok {
+		var err error
+
+		releases, err = rs.Releases(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to list releases: %w", err)
+		}
+	} else {
+		versions, err := src.List(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to list versions: %w", err)
+		}
+
+		releases = make(semver.Releases, len(versions))
+		for i, v := range versions {
+			releases[i] = semver.Release{Version: v, Time: time.Time{}, Yanked: false, Channel: ""}
+		}
+	}
+
+	f := snapshotFile{Taken: taken, Releases: make([]snapshotRelease, len(releases))}
+
+	for i, r := range releases {
+		f.Releases[i] = snapshotRelease{
+			Version: r.Version.String(),
+			Time:    nil,
+			Yanked:  r.Yanked,
+			Channel: r.Channel,
+		}
+		if !r.Time.IsZero() {
+			f.Releases[i].Time = &r.Time
+		}
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+
+	if err := enc.Encode(f); err != nil {
+		return fmt.Errorf("failed to write snapshot: %w", err)
+	}
+
+	return nil
+}
+
+// List reads the versions from the snapshot file.
+func (f *FileSource) List(ctx context.Context) (semver.Versions, error) {
+	releases, err := f.Releases(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return releases.Versions(), nil
+}
+
+// Releases reads the releases from the snapshot file.
+func (f *FileSource) Releases(context.Context) (semver.Releases, error) {
+	file, err := os.Open(f.Path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open snapshot: %w", err)
+	}
+	defer file.Close()
+
+	releases, _, err := ReadSnapshot(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", f.Path, err)
+	}
+
+	return releases, nil
+}
@@ -0,0 +1,126 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package source_test
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/anttikivi/semver"
+	"github.com/anttikivi/semver/source"
+)
+
+type releaseSource struct {
+	releases semver.Releases
+}
+
+func (s *releaseSource) List(ctx context.Context) (semver.Versions, error) {
+	releases, err := s.Releases(ctx)
+
+	return releases.Versions(), err
+}
+
+func (s *releaseSource) Releases(context.Context) (semver.Releases, error) {
+	return s.releases, nil
+}
+
+func TestSnapshot(t *testing.T) {
+	t.Parallel()
+
+	published := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	src := &releaseSource{releases: semver.Releases{
+		{Version: semver.MustParse("1.0.0"), Time: published},
+		{Version: semver.MustParse("1.1.0"), Yanked: true, Channel: "stable"},
+	}}
+
+	var buf bytes.Buffer
+
+	before := time.Now()
+
+	if err := source.Snapshot(t.Context(), src, &buf); err != nil {
+		t.Fatalf("Snapshot() error = %v", err)
+	}
+
+	releases, taken, err := source.ReadSnapshot(bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatalf("ReadSnapshot() error = %v", err)
+	}
+
+	if taken.Before(before.Add(-time.Second)) {
+		t.Errorf("ReadSnapshot() taken = %v, want after %v", taken, before)
+	}
+
+	if !reflect.DeepEqual(versionStrings(releases.Versions()), []string{"1.0.0", "1.1.0"}) {
+		t.Errorf("ReadSnapshot() = %v", versionStrings(releases.Versions()))
+	}
+
+	if !releases[0].Time.Equal(published) || !releases[1].Time.IsZero() || !releases[1].Yanked ||
+		releases[1].Channel != "stable" {
+		t.Errorf("ReadSnapshot() = %+v", releases)
+	}
+
+	path := filepath.Join(t.TempDir(), "versions.json")
+	if err := os.WriteFile(path, buf.Bytes(), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	vs, err := (&source.FileSource{Path: path}).List(t.Context())
+	if err != nil {
+		t.Fatalf("FileSource.List() error = %v", err)
+	}
+
+	if !reflect.DeepEqual(versionStrings(vs), []string{"1.0.0", "1.1.0"}) {
+		t.Errorf("FileSource.List() = %v", versionStrings(vs))
+	}
+}
+
+func TestSnapshotVersionSource(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+
+	src := source.Static(semver.Versions{semver.MustParse("2.0.0-rc.1")})
+	if err := source.Snapshot(t.Context(), src, &buf); err != nil {
+		t.Fatalf("Snapshot() error = %v", err)
+	}
+
+	if strings.Contains(buf.String(), `"time"`) {
+		t.Errorf("Snapshot() wrote release times for a plain source:\n%s", buf.String())
+	}
+
+	releases, _, err := source.ReadSnapshot(&buf)
+	if err != nil || len(releases) != 1 || releases[0].Version.String() != "2.0.0-rc.1" {
+		t.Errorf("ReadSnapshot() = %v, %v", releases, err)
+	}
+
+	if _, _, err := source.ReadSnapshot(strings.NewReader(`{"releases": [{"version": "1.2"}]}`)); err == nil {
+		t.Error("ReadSnapshot() with an invalid version succeeded")
+	}
+
+	if _, err := (&source.FileSource{Path: "missing.json"}).List(t.Context()); err == nil {
+		t.Error("FileSource.List() with a missing file succeeded")
+	}
+}
@@ -0,0 +1,191 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+// Package source implements [semver.VersionSource] for static lists of
+// versions and for the common release registries. The package depends only on
+// the standard library.
+package source
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+
+	"github.com/anttikivi/semver"
+)
+
+// maxResponseSize is the maximum size of a response body that the sources
+// read.
+const maxResponseSize = 32 << 20
+
+// ErrUnexpectedStatus is returned when a registry responds with a status other
+// than 200 OK.
+var ErrUnexpectedStatus = errors.New("unexpected HTTP status")
+
+// An HTTPJSON is a source that fetches the versions from an HTTP endpoint that
+// responds with JSON. The response must be either an array or an object with
+// the array as the member named by Key. The elements of the array must be
+// either version strings or objects with the version string as the member
+// "version". The elements that are not valid versions are skipped.
+type HTTPJSON struct {
+	// URL is the URL of the endpoint.
+	URL string
+
+	// Client is the HTTP client for the requests. If it is nil,
+	// [http.DefaultClient] is used.
+	Client *http.Client
+
+	// Header contains the additional headers for the requests.
+	Header http.Header
+
+	// Key is the member of the response object that contains the versions. If
+	// it is empty, "versions" is used.
+	Key string
+
+	// Lax makes the source parse the versions using [semver.ParseLax] instead
+	// of [semver.Parse].
+	Lax bool
+}
+
+// static is the source returned by Static.
+type static struct {
+	versions semver.Versions
+}
+
+// Static returns a source that always lists the given versions.
+func Static(versions semver.Versions) semver.VersionSource {
+	return &static{versions: slices.Clone(versions)}
+}
+
+// List fetches the versions from the endpoint.
+func (s *HTTPJSON) List(ctx context.Context) (semver.Versions, error) {
+	var body json.RawMessage
+	if err := getJSON(ctx, s.Client, s.URL, s.Header, &body); err != nil {
+		return nil, err
+	}
+
+	var entries []json.RawMessage
+	if err := json.Unmarshal(body, &entries); err != nil {
+		var obj map[string]json.RawMessage
+		if err := json.Unmarshal(body, &obj); err != nil {
+			return nil, fmt.Errorf("failed to decode response from %s: %w", s.URL, err)
+		}
+
+		key := s.Key
+		if key == "" {
+			key = "versions"
+		}
+
+		if err := json.Unmarshal(obj[key], &entries); err != nil {
+			return nil, fmt.Errorf("failed to decode %q in response from %s: %w", key, s.URL, err)
+		}
+	}
+
+	parse := semver.Parse
+	if s.Lax {
+		parse = semver.ParseLax
+	}
+
+	versions := make(semver.Versions, 0, len(entries))
+
+	for _, e := range entries {
+		var entry struct {
+			Version string `json:"version"`
+		}
+
+		if err := json.Unmarshal(e, &entry.Version); err != nil {
+			if err := json.Unmarshal(e, &entry); err != nil {
+				continue
+			}
+		}
+
+		if v, err := parse(entry.Version); err == nil {
+			versions = append(versions, v)
+		}
+	}
+
+	return versions, nil
+}
+
+// List returns the versions of the source.
+func (s *static) List(context.Context) (semver.Versions, error) {
+	return slices.Clone(s.versions), nil
+}
+
+// getJSON sends a GET request to url and decodes the JSON response into dst.
+func getJSON(
+	ctx context.Context,
+	client *http.Client,
+	url string,
+	header http.Header,
+	dst any,
+) error {
+	resp, err := get(ctx, client, url, header)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxResponseSize)).Decode(dst); err != nil {
+		return fmt.Errorf("failed to decode response from %s: %w", url, err)
+	}
+
+	return nil
+}
+
+// get sends a GET request to url and returns the response if its status is
+// 200 OK. The caller must close the body of the response.
+func get(
+	ctx context.Context,
+	client *http.Client,
+	url string,
+	header http.Header,
+) (*http.Response, error) {
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, http.NoBody)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	for k, vs := range header {
+		for _, v := range vs {
+			req.Header.Add(k, v)
+		}
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch %s: %w", url, err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+
+		return nil, fmt.Errorf("%w: %s from %s", ErrUnexpectedStatus, resp.Status, url)
+	}
+
+	return resp, nil
+}
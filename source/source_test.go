@@ -0,0 +1,121 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package source_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/anttikivi/semver"
+	"github.com/anttikivi/semver/source"
+)
+
+func TestStatic(t *testing.T) {
+	t.Parallel()
+
+	vs := semver.Versions{semver.MustParse("1.0.0"), semver.MustParse("2.0.0")}
+	src := source.Static(vs)
+
+	got, err := src.List(t.Context())
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+
+	got[0] = nil
+
+	got, err = src.List(t.Context())
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+
+	if want := []string{"1.0.0", "2.0.0"}; !reflect.DeepEqual(versionStrings(got), want) {
+		t.Errorf("List() = %v, want %v", versionStrings(got), want)
+	}
+}
+
+func TestHTTPJSON(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		body string
+		src  source.HTTPJSON
+		want []string
+	}{
+		{"array", `["1.0.0", "v1.1.0", "latest"]`, source.HTTPJSON{}, []string{"1.0.0", "1.1.0"}},
+		{"object", `{"versions": ["1.0.0", "2.0.0-rc.1"]}`, source.HTTPJSON{}, []string{"1.0.0", "2.0.0-rc.1"}},
+		{"key", `{"releases": [{"version": "1.2"}]}`, source.HTTPJSON{Key: "releases", Lax: true}, []string{"1.2.0"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Header.Get("Authorization") != "Bearer token" {
+					w.WriteHeader(http.StatusUnauthorized)
+
+					return
+				}
+
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			defer srv.Close()
+
+			src := tt.src
+			src.URL = srv.URL
+			src.Header = http.Header{"Authorization": {"Bearer token"}}
+
+			got, err := src.List(t.Context())
+			if err != nil {
+				t.Fatalf("List() error = %v", err)
+			}
+
+			if !reflect.DeepEqual(versionStrings(got), tt.want) {
+				t.Errorf("List() = %v, want %v", versionStrings(got), tt.want)
+			}
+		})
+	}
+}
+
+func TestHTTPJSONStatus(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.NotFoundHandler())
+	defer srv.Close()
+
+	src := &source.HTTPJSON{URL: srv.URL}
+
+	if _, err := src.List(t.Context()); !errors.Is(err, source.ErrUnexpectedStatus) {
+		t.Errorf("List() error = %v, want %v", err, source.ErrUnexpectedStatus)
+	}
+}
+
+func versionStrings(vs semver.Versions) []string {
+	s := make([]string, 0, len(vs))
+	for _, v := range vs {
+		s = append(s, v.String())
+	}
+
+	return s
+}
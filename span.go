@@ -0,0 +1,136 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver
+
+import (
+	"errors"
+	"fmt"
+	"io"
+)
+
+// Kinds of spans.
+const (
+	// SpanPrefix is the "v" prefix.
+	SpanPrefix SpanKind = iota
+
+	// SpanMajor is the major version number.
+	SpanMajor
+
+	// SpanMinor is the minor version number.
+	SpanMinor
+
+	// SpanPatch is the patch version number.
+	SpanPatch
+
+	// SpanPrerelease is the pre-release without the leading hyphen.
+	SpanPrerelease
+
+	// SpanBuild is the build metadata without the leading plus sign.
+	SpanBuild
+)
+
+// A SpanKind is the kind of a Span.
+type SpanKind int
+
+// A Span is the byte range of a component of a version string.
+type Span struct {
+	// Kind is the component of the version in the span.
+	Kind SpanKind
+
+	// Start is the byte offset of the start of the span.
+	Start int
+
+	// End is the byte offset of the end of the span.
+	End int
+}
+
+// Spans returns the spans of the components of the version string s in
+// order, for example for highlighting the components in an editor.
+// The separators between the components, i.e. the dots between the version
+// numbers, the hyphen, and the plus sign, are not in the spans, but the dots
+// between the pre-release and the build identifiers are. If s is not a valid
+// version, Spans returns the spans of the components before the error and
+// a [*ParseError] with the offset of the error.
+func Spans(s string) ([]Span, error) {
+	var spans []Span
+
+	t := NewTokenizer(s)
+
+	for {
+		tok, err := t.Next()
+		if errors.Is(err, io.EOF) {
+			return spans, nil
+		}
+
+		if err != nil {
+			return spans, err
+		}
+
+		var kind SpanKind
+
+		switch tok.Kind {
+		case TokenPrefix:
+			kind = SpanPrefix
+		case TokenMajor:
+			kind = SpanMajor
+		case TokenMinor:
+			kind = SpanMinor
+		case TokenPatch:
+			kind = SpanPatch
+		case TokenPrerelease:
+			kind = SpanPrerelease
+		case TokenBuild:
+			kind = SpanBuild
+		case TokenDot, TokenHyphen, TokenPlus:
+			continue
+		}
+
+		// The identifiers of the pre-release and the build metadata are
+		// joined into a single span.
+		if n := len(spans); n > 0 && spans[n-1].Kind == kind &&
+			(kind == SpanPrerelease || kind == SpanBuild) {
+			spans[n-1].End = tok.End
+
+			continue
+		}
+
+		spans = append(spans, Span{Kind: kind, Start: tok.Start, End: tok.End})
+	}
+}
+
+// String returns the name of the span kind.
+func (k SpanKind) String() string {
+	switch k {
+	case SpanPrefix:
+		return "prefix"
+	case SpanMajor:
+		return "major"
+	case SpanMinor:
+		return "minor"
+	case SpanPatch:
+		return "patch"
+	case SpanPrerelease:
+		return "pre-release"
+	case SpanBuild:
+		return "build"
+	default:
+		return fmt.Sprintf("SpanKind(%d)", int(k))
+	}
+}
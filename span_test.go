@@ -0,0 +1,76 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver_test
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/anttikivi/semver"
+)
+
+func TestSpans(t *testing.T) {
+	t.Parallel()
+
+	s := "v1.20.3-rc.1+build.5"
+
+	got, err := semver.Spans(s)
+	if err != nil {
+		t.Fatalf("Spans(%q) error = %v", s, err)
+	}
+
+	want := []semver.Span{
+		{Kind: semver.SpanPrefix, Start: 0, End: 1},
+		{Kind: semver.SpanMajor, Start: 1, End: 2},
+		{Kind: semver.SpanMinor, Start: 3, End: 5},
+		{Kind: semver.SpanPatch, Start: 6, End: 7},
+		{Kind: semver.SpanPrerelease, Start: 8, End: 12},
+		{Kind: semver.SpanBuild, Start: 13, End: 20},
+	}
+
+	if !slices.Equal(got, want) {
+		t.Errorf("Spans(%q) = %+v, want %+v", s, got, want)
+	}
+
+	if s[got[4].Start:got[4].End] != "rc.1" {
+		t.Errorf("pre-release span = %q, want \"rc.1\"", s[got[4].Start:got[4].End])
+	}
+}
+
+func TestSpansInvalid(t *testing.T) {
+	t.Parallel()
+
+	got, err := semver.Spans("1.2.x")
+
+	var pe *semver.ParseError
+	if !errors.As(err, &pe) || pe.Offset != 4 {
+		t.Fatalf("Spans(\"1.2.x\") error = %v, want a *ParseError at offset 4", err)
+	}
+
+	want := []semver.Span{
+		{Kind: semver.SpanMajor, Start: 0, End: 1},
+		{Kind: semver.SpanMinor, Start: 2, End: 3},
+	}
+
+	if !slices.Equal(got, want) {
+		t.Errorf("Spans(\"1.2.x\") = %+v, want %+v", got, want)
+	}
+}
@@ -0,0 +1,182 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+// Package stability reports the stability levels of the exported symbols of
+// the packages in this module. The symbols that were part of the 1.0.0
+// release are [Stable] and follow the compatibility guarantees of semantic
+// versioning. The newer symbols, like the constraints and the resolver, are
+// [Experimental] until a later release declares them stable: their
+// signatures and behavior may still change in a minor release.
+//
+// The names of the methods, the fields, and the embedded types are qualified
+// by the name of their type:
+//
+//	stability.Lookup("github.com/anttikivi/semver", "Version.String")
+//
+// A test of this package checks that every exported symbol of the module has
+// a level, so a new symbol can't be added without deciding its stability.
+package stability
+
+import (
+	_ "embed"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// Stability levels of the symbols.
+const (
+	// Unknown is the level of the symbols that have no stability level, for
+	// example because they don't exist.
+	Unknown Level = iota
+
+	// Stable symbols don't change incompatibly before the next major
+	// version.
+	Stable
+
+	// Experimental symbols may change incompatibly or be removed in a minor
+	// version.
+	Experimental
+)
+
+// errSyntax is the error of a malformed list of stability levels.
+var errSyntax = errors.New("syntax error in the stability levels")
+
+// levelsFile is the list of the stability levels of the symbols.
+//
+//go:embed levels.txt
+var levelsFile string
+
+// symbols returns the parsed contents of levelsFile.
+var symbols = sync.OnceValue(func() []Symbol {
+	syms, err := parse(levelsFile)
+	if err != nil {
+		panic(err)
+	}
+
+	return syms
+})
+
+// Level is the stability level of a symbol.
+type Level int
+
+// Symbol is an exported symbol and its stability level.
+type Symbol struct {
+	// Package is the import path of the package of the symbol.
+	Package string
+
+	// Name is the name of the symbol. The names of the methods, the fields,
+	// and the embedded types are qualified by the name of their type, like
+	// "Version.String".
+	Name string
+
+	// Level is the stability level of the symbol.
+	Level Level
+}
+
+// Lookup returns the stability level of the symbol name in the package with
+// the import path pkg, or [Unknown] if the symbol has no level.
+func Lookup(pkg, name string) Level {
+	syms := symbols()
+	target := Symbol{Package: pkg, Name: name, Level: Unknown}
+
+	i, ok := slices.BinarySearchFunc(syms, target, compare)
+	if !ok {
+		return Unknown
+	}
+
+	return syms[i].Level
+}
+
+// Symbols returns all of the symbols that have a stability level, sorted by
+// package and name.
+func Symbols() []Symbol {
+	return slices.Clone(symbols())
+}
+
+// String returns the name of the level in lowercase.
+func (l Level) String() string {
+	switch l {
+	case Unknown:
+		return "unknown"
+	case Stable:
+		return "stable"
+	case Experimental:
+		return "experimental"
+	default:
+		return fmt.Sprintf("Level(%d)", int(l))
+	}
+}
+
+// compare compares the symbols by package and name.
+func compare(a, b Symbol) int {
+	if c := strings.Compare(a.Package, b.Package); c != 0 {
+		return c
+	}
+
+	return strings.Compare(a.Name, b.Name)
+}
+
+// parse parses the list of stability levels. Each line of the list is
+// a package import path, a symbol name, and a level separated by spaces. The
+// empty lines and the lines starting with "#" are skipped.
+func parse(data string) ([]Symbol, error) {
+	var syms []Symbol
+
+	for i, line := range strings.Split(data, "\n") {
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) != 3 { //nolint:mnd // package, name, and level
+			return nil, fmt.Errorf("%w: line %d: expected 3 fields", errSyntax, i+1)
+		}
+
+		var level Level
+
+		switch fields[2] {
+		case "stable":
+			level = Stable
+		case "experimental":
+			level = Experimental
+		default:
+			return nil, fmt.Errorf("%w: line %d: unknown level %q", errSyntax, i+1, fields[2])
+		}
+
+		syms = append(syms, Symbol{Package: fields[0], Name: fields[1], Level: level})
+	}
+
+	slices.SortFunc(syms, compare)
+
+	for i := 1; i < len(syms); i++ {
+		if compare(syms[i-1], syms[i]) == 0 {
+			return nil, fmt.Errorf(
+				"%w: duplicate symbol %s.%s",
+				errSyntax,
+				syms[i].Package,
+				syms[i].Name,
+			)
+		}
+	}
+
+	return syms, nil
+}
@@ -0,0 +1,99 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package stability_test
+
+import (
+	"testing"
+
+	"github.com/anttikivi/semver/internal/apicheck"
+	"github.com/anttikivi/semver/stability"
+)
+
+func TestSymbolsAnnotated(t *testing.T) {
+	t.Parallel()
+
+	lines, err := apicheck.Generate("..")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	exported := make(map[stability.Symbol]bool)
+
+	for _, line := range lines {
+		pkg, name, ok := apicheck.Symbol(line)
+		if !ok {
+			continue
+		}
+
+		exported[stability.Symbol{Package: pkg, Name: name, Level: stability.Unknown}] = true
+
+		if stability.Lookup(pkg, name) == stability.Unknown {
+			t.Errorf("%s.%s has no stability level; add it to levels.txt", pkg, name)
+		}
+	}
+
+	for _, s := range stability.Symbols() {
+		if !exported[stability.Symbol{Package: s.Package, Name: s.Name, Level: stability.Unknown}] {
+			t.Errorf("levels.txt has a level for %s.%s that is not exported", s.Package, s.Name)
+		}
+	}
+}
+
+func TestLookup(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		pkg  string
+		name string
+		want stability.Level
+	}{
+		{"github.com/anttikivi/semver", "Parse", stability.Stable},
+		{"github.com/anttikivi/semver", "Version.Major", stability.Stable},
+		{"github.com/anttikivi/semver", "Version.String", stability.Stable},
+		{"github.com/anttikivi/semver", "Version.Environ", stability.Experimental},
+		{"github.com/anttikivi/semver", "ParseConstraint", stability.Experimental},
+		{"github.com/anttikivi/semver/stability", "Lookup", stability.Experimental},
+		{"github.com/anttikivi/semver", "parse", stability.Unknown},
+		{"example.com/m", "Parse", stability.Unknown},
+	}
+
+	for _, tt := range tests {
+		if got := stability.Lookup(tt.pkg, tt.name); got != tt.want {
+			t.Errorf("Lookup(%q, %q) = %v, want %v", tt.pkg, tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestLevelString(t *testing.T) {
+	t.Parallel()
+
+	tests := map[stability.Level]string{
+		stability.Unknown:      "unknown",
+		stability.Stable:       "stable",
+		stability.Experimental: "experimental",
+		stability.Level(9):     "Level(9)",
+	}
+
+	for l, want := range tests {
+		if got := l.String(); got != want {
+			t.Errorf("Level(%d).String() = %q, want %q", int(l), got, want)
+		}
+	}
+}
@@ -0,0 +1,118 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver
+
+import "slices"
+
+// VersionStats are summary statistics of a collection of versions.
+type VersionStats struct {
+	// Count is the number of versions.
+	Count int
+
+	// Majors is the number of different major versions.
+	Majors int
+
+	// Minors is the number of different minor version series.
+	Minors int
+
+	// Patches is the number of different version cores.
+	Patches int
+
+	// Prereleases is the number of pre-release versions.
+	Prereleases int
+
+	// Oldest is the version with the lowest precedence. It is nil if there
+	// are no versions.
+	Oldest *Version
+
+	// Newest is the version with the highest precedence. It is nil if there
+	// are no versions.
+	Newest *Version
+
+	// MedianGap is the median of the release levels of the differences
+	// between consecutive versions in increasing order of precedence. Equal
+	// versions are counted once. It is LevelNone if there are fewer than two
+	// different versions.
+	MedianGap ReleaseLevel
+}
+
+// Stats returns the summary statistics of the versions.
+func (x Versions) Stats() VersionStats {
+	s := VersionStats{
+		Count:       len(x),
+		Majors:      0,
+		Minors:      0,
+		Patches:     0,
+		Prereleases: 0,
+		Oldest:      nil,
+		Newest:      nil,
+		MedianGap:   LevelNone,
+	}
+
+	if len(x) == 0 {
+		return s
+	}
+
+	sorted := slices.Clone(x)
+	slices.SortStableFunc(sorted, Compare)
+	sorted = slices.CompactFunc(sorted, (*Version).Equal)
+
+	s.Oldest = sorted[0]
+	s.Newest = sorted[len(sorted)-1]
+
+	gaps := make([]ReleaseLevel, 0, len(sorted)-1)
+
+	for i, v := range sorted {
+		if i == 0 {
+			s.Majors, s.Minors, s.Patches = 1, 1, 1
+
+			continue
+		}
+
+		l := diffLevel(sorted[i-1], v)
+		gaps = append(gaps, l)
+
+		switch l {
+		case LevelMajor:
+			s.Majors++
+			s.Minors++
+			s.Patches++
+		case LevelMinor:
+			s.Minors++
+			s.Patches++
+		case LevelPatch:
+			s.Patches++
+		case LevelNone, LevelBuild, LevelPrerelease:
+		}
+	}
+
+	for _, v := range x {
+		if len(v.Prerelease) > 0 {
+			s.Prereleases++
+		}
+	}
+
+	if len(gaps) > 0 {
+		slices.Sort(gaps)
+		s.MedianGap = gaps[(len(gaps)-1)/2]
+	}
+
+	return s
+}
@@ -0,0 +1,63 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver_test
+
+import (
+	"testing"
+
+	"github.com/anttikivi/semver"
+)
+
+func TestVersionsStats(t *testing.T) {
+	t.Parallel()
+
+	var vs semver.Versions
+	for _, s := range []string{
+		"1.0.0", "1.0.1", "1.0.2", "1.1.0-rc.1", "1.1.0", "1.1.0+build", "1.1.1", "2.0.0", "0.9.0",
+	} {
+		vs = append(vs, semver.MustParse(s))
+	}
+
+	s := vs.Stats()
+
+	if s.Count != 9 || s.Majors != 3 || s.Minors != 4 || s.Patches != 7 || s.Prereleases != 1 {
+		t.Errorf(
+			"Stats() counts = %d, %d, %d, %d, %d, want 9, 3, 4, 7, 1",
+			s.Count,
+			s.Majors,
+			s.Minors,
+			s.Patches,
+			s.Prereleases,
+		)
+	}
+
+	if s.Oldest.String() != "0.9.0" || s.Newest.String() != "2.0.0" {
+		t.Errorf("Stats() oldest and newest = %q, %q, want \"0.9.0\", \"2.0.0\"", s.Oldest, s.Newest)
+	}
+
+	if s.MedianGap != semver.LevelPatch {
+		t.Errorf("Stats().MedianGap = %v, want %v", s.MedianGap, semver.LevelPatch)
+	}
+
+	empty := semver.Versions{}.Stats()
+	if empty.Count != 0 || empty.Oldest != nil || empty.MedianGap != semver.LevelNone {
+		t.Errorf("Stats() of empty versions = %+v", empty)
+	}
+}
@@ -0,0 +1,123 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver
+
+import (
+	"fmt"
+	"text/template"
+)
+
+// FuncMap returns the template functions for using versions in templates of
+// text/template. The map can be converted to the FuncMap of html/template.
+// The functions take the version as the last argument so that it can be
+// piped to them, and they return an error for invalid arguments, which stops
+// the execution of the template:
+//
+//   - semverCompare a b returns the result of [Compare] for the versions a
+//     and b.
+//   - semverSatisfies c v reports whether the version v satisfies
+//     the constraint c.
+//   - semverBump level v returns the version v bumped at the release level,
+//     which is "major", "minor", "patch", or "prerelease", using [Bump].
+//   - semverCanonical v returns the version v, which may be partial and have
+//     the "v" prefix, in its canonical form, for example "1.2.0" for "v1.2".
+//
+// For example:
+//
+//	{{ if .Version | semverSatisfies "^1.2.0" }}...{{ end }}
+//	next: {{ .Version | semverBump "minor" }}
+func FuncMap() template.FuncMap {
+	return template.FuncMap{
+		"semverCompare":   templateCompare,
+		"semverSatisfies": templateSatisfies,
+		"semverBump":      templateBump,
+		"semverCanonical": templateCanonical,
+	}
+}
+
+// templateCompare is "semverCompare" of [FuncMap].
+func templateCompare(a, b string) (int, error) {
+	v, err := Parse(a)
+	if err != nil {
+		return 0, err
+	}
+
+	w, err := Parse(b)
+	if err != nil {
+		return 0, err
+	}
+
+	return v.Compare(w), nil
+}
+
+// templateSatisfies is "semverSatisfies" of [FuncMap].
+func templateSatisfies(constraint, version string) (bool, error) {
+	c, err := ParseConstraint(constraint)
+	if err != nil {
+		return false, err
+	}
+
+	v, err := Parse(version)
+	if err != nil {
+		return false, err
+	}
+
+	return c.Check(v), nil
+}
+
+// templateBump is "semverBump" of [FuncMap].
+func templateBump(level, version string) (string, error) {
+	var l ReleaseLevel
+
+	switch level {
+	case "major":
+		l = LevelMajor
+	case "minor":
+		l = LevelMinor
+	case "patch":
+		l = LevelPatch
+	case "prerelease", "pre-release":
+		l = LevelPrerelease
+	default:
+		return "", fmt.Errorf("%w: %q", ErrInvalidLevel, level)
+	}
+
+	v, err := Parse(version)
+	if err != nil {
+		return "", err
+	}
+
+	w, err := Bump(v, l)
+	if err != nil {
+		return "", err
+	}
+
+	return w.String(), nil
+}
+
+// templateCanonical is "semverCanonical" of [FuncMap].
+func templateCanonical(version string) (string, error) {
+	v, err := ParseLax(version)
+	if err != nil {
+		return "", err
+	}
+
+	return v.String(), nil
+}
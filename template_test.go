@@ -0,0 +1,66 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver_test
+
+import (
+	"strings"
+	"testing"
+	"text/template"
+
+	"github.com/anttikivi/semver"
+)
+
+func TestFuncMap(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		tmpl string
+		want string
+	}{
+		{`{{ semverCompare "1.2.3" .Version }}`, "-1"},
+		{`{{ .Version | semverSatisfies "^1.2.0" }}`, "true"},
+		{`{{ .Version | semverSatisfies ">=2.0.0" }}`, "false"},
+		{`{{ .Version | semverBump "minor" }}`, "1.5.0"},
+		{`{{ .Version | semverBump "prerelease" }}`, "1.4.1-0"},
+		{`{{ semverCanonical "v1.2" }}`, "1.2.0"},
+	}
+
+	for _, tt := range tests {
+		tmpl := template.Must(template.New("test").Funcs(semver.FuncMap()).Parse(tt.tmpl))
+
+		var sb strings.Builder
+		if err := tmpl.Execute(&sb, map[string]string{"Version": "1.4.0"}); err != nil {
+			t.Errorf("executing %q failed: %v", tt.tmpl, err)
+
+			continue
+		}
+
+		if got := sb.String(); got != tt.want {
+			t.Errorf("executing %q = %q, want %q", tt.tmpl, got, tt.want)
+		}
+	}
+
+	tmpl := template.Must(
+		template.New("test").Funcs(semver.FuncMap()).Parse(`{{ semverBump "huge" "1.0.0" }}`),
+	)
+	if err := tmpl.Execute(&strings.Builder{}, nil); err == nil {
+		t.Error("executing semverBump with an invalid level succeeded, want an error")
+	}
+}
@@ -0,0 +1,317 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// Kinds of tokens.
+const (
+	// TokenPrefix is the "v" prefix.
+	TokenPrefix TokenKind = iota
+
+	// TokenMajor is the major version number.
+	TokenMajor
+
+	// TokenMinor is the minor version number.
+	TokenMinor
+
+	// TokenPatch is the patch version number.
+	TokenPatch
+
+	// TokenDot is a dot between the version numbers or identifiers.
+	TokenDot
+
+	// TokenHyphen is the hyphen before the pre-release.
+	TokenHyphen
+
+	// TokenPlus is the plus sign before the build metadata.
+	TokenPlus
+
+	// TokenPrerelease is a pre-release identifier.
+	TokenPrerelease
+
+	// TokenBuild is a build identifier.
+	TokenBuild
+)
+
+// States of a Tokenizer, named after what is expected next.
+const (
+	expectStart tokenizerState = iota
+	expectMajor
+	expectFirstDot
+	expectMinor
+	expectSecondDot
+	expectPatch
+	expectAfterPatch
+	expectPrerelease
+	expectAfterPrerelease
+	expectBuild
+	expectAfterBuild
+	expectEOF
+)
+
+// A TokenKind is the kind of a Token.
+type TokenKind int
+
+// A Token is a part of a version string returned by a Tokenizer.
+type Token struct {
+	// Kind is the kind of the token.
+	Kind TokenKind
+
+	// Start is the byte offset of the start of the token.
+	Start int
+
+	// End is the byte offset of the end of the token.
+	End int
+
+	// Text is the text of the token.
+	Text string
+}
+
+// A Tokenizer splits a version string into tokens with their byte offsets,
+// for tools like syntax highlighters and linters. It accepts the same
+// versions as [Parse]. The state of the tokenizer can be saved with
+// [Tokenizer.Checkpoint] and restored with [Tokenizer.Restore], for example to
+// look ahead.
+type Tokenizer struct {
+	s     string
+	pos   int
+	state tokenizerState
+}
+
+// A TokenizerCheckpoint is a saved state of a Tokenizer.
+type TokenizerCheckpoint struct {
+	pos   int
+	state tokenizerState
+}
+
+// A ParseError is an error in a version string at a byte offset. It wraps
+// [ErrInvalidVersion].
+type ParseError struct {
+	// Input is the version string.
+	Input string
+
+	// Offset is the byte offset of the error in Input.
+	Offset int
+
+	// Message describes the error.
+	Message string
+}
+
+// A tokenizerState is the state of a Tokenizer.
+type tokenizerState int
+
+// NewTokenizer returns a Tokenizer for s.
+func NewTokenizer(s string) *Tokenizer {
+	return &Tokenizer{s: s, pos: 0, state: expectStart}
+}
+
+// Next returns the next token. It returns [io.EOF] after the last token of
+// a valid version, and a [*ParseError] if the version is not valid. After an
+// error, Next returns the same error until the state is restored.
+func (t *Tokenizer) Next() (Token, error) {
+	switch t.state {
+	case expectStart:
+		if t.pos < len(t.s) && t.s[t.pos] == 'v' {
+			t.state = expectMajor
+
+			return t.token(TokenPrefix, t.pos+1), nil
+		}
+
+		return t.number(TokenMajor, expectFirstDot)
+	case expectMajor:
+		return t.number(TokenMajor, expectFirstDot)
+	case expectFirstDot:
+		return t.dot(expectMinor, "after the major version")
+	case expectMinor:
+		return t.number(TokenMinor, expectSecondDot)
+	case expectSecondDot:
+		return t.dot(expectPatch, "after the minor version")
+	case expectPatch:
+		return t.number(TokenPatch, expectAfterPatch)
+	case expectAfterPatch, expectAfterPrerelease, expectAfterBuild:
+		return t.separator()
+	case expectPrerelease:
+		return t.identifier(TokenPrerelease, expectAfterPrerelease)
+	case expectBuild:
+		return t.identifier(TokenBuild, expectAfterBuild)
+	case expectEOF:
+	}
+
+	return Token{}, io.EOF
+}
+
+// Checkpoint returns the current state of t.
+func (t *Tokenizer) Checkpoint() TokenizerCheckpoint {
+	return TokenizerCheckpoint{pos: t.pos, state: t.state}
+}
+
+// Restore restores t to the state c that was returned by
+// [Tokenizer.Checkpoint] of t.
+func (t *Tokenizer) Restore(c TokenizerCheckpoint) {
+	t.pos = c.pos
+	t.state = c.state
+}
+
+// Offset returns the byte offset of the next token.
+func (t *Tokenizer) Offset() int {
+	return t.pos
+}
+
+// String returns the name of the token kind.
+func (k TokenKind) String() string {
+	switch k {
+	case TokenPrefix:
+		return "prefix"
+	case TokenMajor:
+		return "major"
+	case TokenMinor:
+		return "minor"
+	case TokenPatch:
+		return "patch"
+	case TokenDot:
+		return "dot"
+	case TokenHyphen:
+		return "hyphen"
+	case TokenPlus:
+		return "plus"
+	case TokenPrerelease:
+		return "pre-release"
+	case TokenBuild:
+		return "build"
+	default:
+		return fmt.Sprintf("TokenKind(%d)", int(k))
+	}
+}
+
+// Error returns the error message with the offset.
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("%s: %s at offset %d in %q", ErrInvalidVersion, e.Message, e.Offset, e.Input)
+}
+
+// Unwrap returns [ErrInvalidVersion].
+func (e *ParseError) Unwrap() error {
+	return ErrInvalidVersion
+}
+
+// number returns a version number token of the given kind.
+func (t *Tokenizer) number(kind TokenKind, next tokenizerState) (Token, error) {
+	end := t.pos
+	for end < len(t.s) && isDigit(t.s[end]) {
+		end++
+	}
+
+	switch {
+	case end == t.pos:
+		return Token{}, t.errorf("expected the %s version", kind)
+	case t.s[t.pos] == '0' && end-t.pos > 1:
+		return Token{}, t.errorf("leading zero in the %s version", kind)
+	}
+
+	if _, err := strconv.ParseUint(t.s[t.pos:end], 10, 64); err != nil {
+		return Token{}, t.errorf("the %s version is too large", kind)
+	}
+
+	t.state = next
+
+	return t.token(kind, end), nil
+}
+
+// dot returns a dot token between the version numbers.
+func (t *Tokenizer) dot(next tokenizerState, where string) (Token, error) {
+	if t.pos >= len(t.s) || t.s[t.pos] != '.' {
+		return Token{}, t.errorf("expected a dot %s", where)
+	}
+
+	t.state = next
+
+	return t.token(TokenDot, t.pos+1), nil
+}
+
+// separator returns the token that follows the version core, a pre-release
+// identifier, or a build identifier.
+func (t *Tokenizer) separator() (Token, error) {
+	if t.pos == len(t.s) {
+		t.state = expectEOF
+
+		return Token{}, io.EOF
+	}
+
+	switch c := t.s[t.pos]; {
+	case c == '.' && t.state == expectAfterPrerelease:
+		t.state = expectPrerelease
+
+		return t.token(TokenDot, t.pos+1), nil
+	case c == '.' && t.state == expectAfterBuild:
+		t.state = expectBuild
+
+		return t.token(TokenDot, t.pos+1), nil
+	case c == '-' && t.state == expectAfterPatch:
+		t.state = expectPrerelease
+
+		return t.token(TokenHyphen, t.pos+1), nil
+	case c == '+' && t.state != expectAfterBuild:
+		t.state = expectBuild
+
+		return t.token(TokenPlus, t.pos+1), nil
+	default:
+		return Token{}, t.errorf("unexpected character %q", c)
+	}
+}
+
+// identifier returns a pre-release or a build identifier token.
+func (t *Tokenizer) identifier(kind TokenKind, next tokenizerState) (Token, error) {
+	end := t.pos
+	for end < len(t.s) && isIdentifierCharacter(t.s[end]) {
+		end++
+	}
+
+	if end == t.pos {
+		return Token{}, t.errorf("expected a %s identifier", kind)
+	}
+
+	if kind == TokenPrerelease {
+		if _, err := parsePrereleaseIdentifier(t.s[t.pos:end]); err != nil {
+			return Token{}, t.errorf("invalid pre-release identifier %q", t.s[t.pos:end])
+		}
+	}
+
+	t.state = next
+
+	return t.token(kind, end), nil
+}
+
+// token returns the token of the given kind from the current position to end
+// and advances the tokenizer to end.
+func (t *Tokenizer) token(kind TokenKind, end int) Token {
+	tok := Token{Kind: kind, Start: t.pos, End: end, Text: t.s[t.pos:end]}
+	t.pos = end
+
+	return tok
+}
+
+// errorf returns a ParseError at the current position.
+func (t *Tokenizer) errorf(format string, a ...any) error {
+	return &ParseError{Input: t.s, Offset: t.pos, Message: fmt.Sprintf(format, a...)}
+}
@@ -0,0 +1,170 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver_test
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/anttikivi/semver"
+)
+
+func TestTokenizer(t *testing.T) {
+	t.Parallel()
+
+	tok := semver.NewTokenizer("v1.20.3-rc.1+b.5")
+
+	want := []semver.Token{
+		{Kind: semver.TokenPrefix, Start: 0, End: 1, Text: "v"},
+		{Kind: semver.TokenMajor, Start: 1, End: 2, Text: "1"},
+		{Kind: semver.TokenDot, Start: 2, End: 3, Text: "."},
+		{Kind: semver.TokenMinor, Start: 3, End: 5, Text: "20"},
+		{Kind: semver.TokenDot, Start: 5, End: 6, Text: "."},
+		{Kind: semver.TokenPatch, Start: 6, End: 7, Text: "3"},
+		{Kind: semver.TokenHyphen, Start: 7, End: 8, Text: "-"},
+		{Kind: semver.TokenPrerelease, Start: 8, End: 10, Text: "rc"},
+		{Kind: semver.TokenDot, Start: 10, End: 11, Text: "."},
+		{Kind: semver.TokenPrerelease, Start: 11, End: 12, Text: "1"},
+		{Kind: semver.TokenPlus, Start: 12, End: 13, Text: "+"},
+		{Kind: semver.TokenBuild, Start: 13, End: 14, Text: "b"},
+		{Kind: semver.TokenDot, Start: 14, End: 15, Text: "."},
+		{Kind: semver.TokenBuild, Start: 15, End: 16, Text: "5"},
+	}
+
+	for i, w := range want {
+		got, err := tok.Next()
+		if err != nil {
+			t.Fatalf("Next() #%d error = %v", i, err)
+		}
+
+		if got != w {
+			t.Errorf("Next() #%d = %+v, want %+v", i, got, w)
+		}
+	}
+
+	if _, err := tok.Next(); !errors.Is(err, io.EOF) {
+		t.Errorf("Next() at the end error = %v, want %v", err, io.EOF)
+	}
+}
+
+func TestTokenizerCheckpoint(t *testing.T) {
+	t.Parallel()
+
+	tok := semver.NewTokenizer("1.2.3-rc.1")
+
+	for range 5 {
+		if _, err := tok.Next(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	c := tok.Checkpoint()
+
+	first, err := tok.Next()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tok.Restore(c)
+
+	if again, err := tok.Next(); err != nil || again != first || first.Kind != semver.TokenHyphen {
+		t.Errorf("Next() after Restore() = %+v, %v, want %+v", again, err, first)
+	}
+}
+
+func TestTokenizerError(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		s      string
+		offset int
+	}{
+		{"", 0},
+		{"v", 1},
+		{"1.02.3", 2},
+		{"1.2", 3},
+		{"1.2.3-", 6},
+		{"1.2.3-rc..1", 9},
+		{"1.2.3-01", 6},
+		{"1.2.3+b+c", 7},
+		{"1.2.3 ", 5},
+		{"1.2.3-rc.1-x+é", 13},
+	}
+
+	for _, tt := range tests {
+		tok := semver.NewTokenizer(tt.s)
+
+		var err error
+		for err == nil {
+			_, err = tok.Next()
+		}
+
+		var pe *semver.ParseError
+		if !errors.As(err, &pe) || !errors.Is(err, semver.ErrInvalidVersion) {
+			t.Errorf("tokenizing %q error = %v, want a *ParseError", tt.s, err)
+
+			continue
+		}
+
+		if pe.Offset != tt.offset {
+			t.Errorf("tokenizing %q error offset = %d, want %d", tt.s, pe.Offset, tt.offset)
+		}
+	}
+}
+
+func TestTokenizerMatchesParse(t *testing.T) {
+	t.Parallel()
+
+	inputs := []string{
+		"0.0.0", "v1.2.3", "1.2.3-0", "1.2.3-00", "1.2.3-0a", "1.2.3-a-b.c--d",
+		"1.2.3+001", "1.2.3+", "1.2.3-+b", "01.2.3", "1.2.3.4", "vv1.2.3",
+		"18446744073709551615.0.0", "18446744073709551616.0.0",
+		"1.2.3-18446744073709551616", "1.2.3-rc.1+build.-", "1.2.3_4", "-1.2.3",
+	}
+
+	for _, s := range inputs {
+		tok := semver.NewTokenizer(s)
+
+		var (
+			sb  strings.Builder
+			err error
+			tk  semver.Token
+		)
+
+		for {
+			if tk, err = tok.Next(); err != nil {
+				break
+			}
+
+			sb.WriteString(tk.Text)
+		}
+
+		_, parseErr := semver.Parse(s)
+		if ok := errors.Is(err, io.EOF); ok != (parseErr == nil) {
+			t.Errorf("tokenizing %q error = %v, but Parse() error = %v", s, err, parseErr)
+		}
+
+		if errors.Is(err, io.EOF) && sb.String() != s {
+			t.Errorf("tokens of %q join to %q", s, sb.String())
+		}
+	}
+}
@@ -0,0 +1,134 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver
+
+import (
+	"slices"
+	"time"
+)
+
+// A ReleaseTrain describes a release cadence where a new version is released
+// at fixed intervals. It is used for projecting the future versions from the
+// history of the past releases. The train only uses the dates given to it and
+// never reads the current time.
+type ReleaseTrain struct {
+	// Cadence is the time between two releases.
+	Cadence time.Duration
+
+	// Major makes every release increment the major version. Otherwise
+	// every release increments the minor version.
+	Major bool
+
+	// History contains the past releases of the train. It doesn't need to
+	// be sorted.
+	History []DatedVersion
+}
+
+// A DatedVersion is a version paired with its release date.
+type DatedVersion struct {
+	Version *Version
+	Date    time.Time
+}
+
+// NextPlannedVersion returns the next version that is planned to be released
+// after now, and its planned release date. The projection is based on the
+// latest release in the history. If the history is empty or the cadence is not
+// positive, NextPlannedVersion returns nil and the zero time.
+func (t *ReleaseTrain) NextPlannedVersion(now time.Time) (*Version, time.Time) {
+	last, ok := t.last()
+	if !ok || t.Cadence <= 0 {
+		return nil, time.Time{}
+	}
+
+	n := uint64(1)
+	if now.After(last.Date) {
+		n = uint64(now.Sub(last.Date)/t.Cadence) + 1
+	}
+
+	return t.advance(last.Version, n), last.Date.Add(time.Duration(n) * t.Cadence)
+}
+
+// VersionAt returns the version of the train that is the latest one at the
+// given date. Dates covered by the history return the latest release made on
+// or before the date, and dates after the history return the projected
+// version. If date is before the first release or the history is empty,
+// VersionAt returns nil.
+func (t *ReleaseTrain) VersionAt(date time.Time) *Version {
+	last, ok := t.last()
+	if !ok {
+		return nil
+	}
+
+	if !date.Before(last.Date) {
+		if t.Cadence <= 0 {
+			return last.Version
+		}
+
+		return t.advance(last.Version, uint64(date.Sub(last.Date)/t.Cadence))
+	}
+
+	var at *DatedVersion
+
+	for i := range t.History {
+		r := &t.History[i]
+		if r.Date.After(date) {
+			continue
+		}
+
+		if at == nil || r.Date.After(at.Date) || (r.Date.Equal(at.Date) && r.Version.Compare(at.Version) > 0) {
+			at = r
+		}
+	}
+
+	if at == nil {
+		return nil
+	}
+
+	return at.Version
+}
+
+// last returns the latest release in the history. Releases made on the same
+// date are ordered by their precedence.
+func (t *ReleaseTrain) last() (DatedVersion, bool) {
+	if len(t.History) == 0 {
+		return DatedVersion{}, false
+	}
+
+	return slices.MaxFunc(t.History, func(a, b DatedVersion) int {
+		if c := a.Date.Compare(b.Date); c != 0 {
+			return c
+		}
+
+		return a.Version.Compare(b.Version)
+	}), true
+}
+
+// advance returns the version that is n releases after v.
+func (t *ReleaseTrain) advance(v *Version, n uint64) *Version {
+	if n == 0 {
+		return v
+	}
+
+	if t.Major {
+		return coreVersion(v.Major+n, 0, 0)
+	}
+
+	return coreVersion(v.Major, v.Minor+n, 0)
+}
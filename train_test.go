@@ -0,0 +1,94 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/anttikivi/semver"
+)
+
+func TestReleaseTrain(t *testing.T) {
+	t.Parallel()
+
+	date := func(s string) time.Time {
+		d, err := time.Parse(time.DateOnly, s)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return d
+	}
+
+	train := &semver.ReleaseTrain{
+		Cadence: 6 * 7 * 24 * time.Hour,
+		Major:   false,
+		History: []semver.DatedVersion{
+			{Version: semver.MustParse("1.2.0"), Date: date("2024-03-01")},
+			{Version: semver.MustParse("1.0.0"), Date: date("2024-01-05")},
+			{Version: semver.MustParse("1.1.0"), Date: date("2024-02-02")},
+			{Version: semver.MustParse("1.1.1"), Date: date("2024-02-10")},
+		},
+	}
+
+	nextTests := []struct {
+		now      string
+		wantV    string
+		wantDate string
+	}{
+		{"2024-03-01", "1.3.0", "2024-04-12"},
+		{"2024-03-10", "1.3.0", "2024-04-12"},
+		{"2024-04-12", "1.4.0", "2024-05-24"},
+		{"2024-06-01", "1.5.0", "2024-07-05"},
+	}
+
+	for _, tt := range nextTests {
+		v, d := train.NextPlannedVersion(date(tt.now))
+		if v == nil || v.String() != tt.wantV || !d.Equal(date(tt.wantDate)) {
+			t.Errorf("NextPlannedVersion(%s) = %v, %s, want %s, %s", tt.now, v, d.Format(time.DateOnly), tt.wantV, tt.wantDate)
+		}
+	}
+
+	atTests := []struct {
+		date string
+		want string
+	}{
+		{"2023-12-31", ""},
+		{"2024-01-05", "1.0.0"},
+		{"2024-02-15", "1.1.1"},
+		{"2024-03-01", "1.2.0"},
+		{"2024-04-11", "1.2.0"},
+		{"2024-04-12", "1.3.0"},
+		{"2025-01-01", "1.9.0"},
+	}
+
+	for _, tt := range atTests {
+		got := train.VersionAt(date(tt.date))
+		if (got == nil && tt.want != "") || (got != nil && got.String() != tt.want) {
+			t.Errorf("VersionAt(%s) = %v, want %q", tt.date, got, tt.want)
+		}
+	}
+
+	empty := &semver.ReleaseTrain{Cadence: time.Hour, Major: true, History: nil}
+	if v, _ := empty.NextPlannedVersion(date("2024-01-01")); v != nil {
+		t.Errorf("NextPlannedVersion() with empty history = %v, want nil", v)
+	}
+}
@@ -0,0 +1,169 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+// Package updates implements the logic for checking whether a newer version of
+// a program is available. It selects the best update candidate from a list of
+// available versions according to the release channel the user follows,
+// a version constraint, and whether pre-release versions are allowed.
+package updates
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/anttikivi/semver"
+)
+
+// Common release channels. The channels other than Stable are named after
+// the first pre-release identifier of the versions released in them.
+const (
+	Stable = "stable"
+	RC     = "rc"
+	Beta   = "beta"
+	Alpha  = "alpha"
+)
+
+// Options are the options for [CheckUpdate]. The zero value selects only
+// stable versions.
+type Options struct {
+	// Constraint is the constraint the candidate versions must satisfy. If it
+	// is nil, all of the versions are considered. The constraint is checked
+	// with [semver.IncludePrereleases] so that the channel decides which
+	// pre-release versions are accepted.
+	Constraint *semver.Constraint
+
+	// Channel is the release channel to follow. A channel accepts the versions
+	// released in it and in the more stable channels: Stable accepts only
+	// stable versions, RC accepts also release candidates, Beta accepts also
+	// beta versions, and Alpha accepts also alpha versions. Other channels
+	// accept the versions released in them and the stable versions. If
+	// the channel is empty, Stable is used.
+	Channel string
+
+	// AllowPrerelease allows selecting any pre-release version regardless of
+	// the channel.
+	AllowPrerelease bool
+}
+
+// CheckUpdate returns the best update for current from available. The best
+// update is the greatest version that is greater than current and accepted by
+// opts. It reports whether an update was found.
+func CheckUpdate(current *semver.Version, available semver.Versions, opts Options) (*semver.Version, bool) {
+	var best *semver.Version
+
+	for _, v := range available {
+		if v.Compare(current) <= 0 || (best != nil && v.Compare(best) <= 0) {
+			continue
+		}
+
+		if !opts.accepts(v) {
+			continue
+		}
+
+		best = v
+	}
+
+	return best, best != nil
+}
+
+// CheckSource lists the available versions from src and returns the best
+// update for current from them like [CheckUpdate].
+func CheckSource(
+	ctx context.Context,
+	current *semver.Version,
+	src semver.VersionSource,
+	opts Options,
+) (*semver.Version, bool, error) {
+	available, err := src.List(ctx)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to list versions: %w", err)
+	}
+
+	v, ok := CheckUpdate(current, available, opts)
+
+	return v, ok, nil
+}
+
+// ChannelOf returns the release channel of v. The channel of a version without
+// pre-release identifiers is Stable. For pre-release versions, the channel is
+// the leading letters of the first pre-release identifier in lower case, so
+// both "1.2.0-beta.2" and "1.2.0-Beta2" are in the "beta" channel. If
+// the first identifier doesn't start with a letter, the channel is
+// the identifier itself.
+func ChannelOf(v *semver.Version) string {
+	if len(v.Prerelease) == 0 {
+		return Stable
+	}
+
+	id := v.Prerelease[0].String()
+
+	end := strings.IndexFunc(id, func(r rune) bool {
+		return (r < 'a' || r > 'z') && (r < 'A' || r > 'Z')
+	})
+
+	switch end {
+	case -1:
+		return strings.ToLower(id)
+	case 0:
+		return id
+	default:
+		return strings.ToLower(id[:end])
+	}
+}
+
+// accepts reports whether v can be selected according to the options.
+func (o Options) accepts(v *semver.Version) bool {
+	if o.Constraint != nil && !o.Constraint.Check(v, semver.IncludePrereleases()) {
+		return false
+	}
+
+	if len(v.Prerelease) == 0 || o.AllowPrerelease {
+		return true
+	}
+
+	channel := o.Channel
+	if channel == "" {
+		channel = Stable
+	}
+
+	vc := ChannelOf(v)
+	if vc == channel {
+		return true
+	}
+
+	return stability(channel) > 0 && stability(vc) >= stability(channel)
+}
+
+// stability returns the rank of the known release channels, from the least
+// stable to the most stable. It returns zero for unknown channels.
+func stability(channel string) int {
+	switch channel {
+	case Alpha:
+		return 1
+	case Beta:
+		return 2 //nolint:mnd // rank
+	case RC:
+		return 3 //nolint:mnd // rank
+	case Stable:
+		return 4 //nolint:mnd // rank
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,138 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package updates_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/anttikivi/semver"
+	"github.com/anttikivi/semver/source"
+	"github.com/anttikivi/semver/updates"
+)
+
+func TestCheckUpdate(t *testing.T) {
+	t.Parallel()
+
+	available := semver.Versions{
+		semver.MustParse("1.0.0"),
+		semver.MustParse("1.1.0"),
+		semver.MustParse("1.2.0-alpha.1"),
+		semver.MustParse("1.2.0-beta.1"),
+		semver.MustParse("1.2.0-rc.1"),
+		semver.MustParse("1.2.0-nightly.20250601"),
+		semver.MustParse("2.0.0-beta.1"),
+		semver.MustParse("1.1.1"),
+	}
+
+	tests := []struct {
+		name    string
+		current string
+		opts    updates.Options
+		want    string
+	}{
+		{"stable", "1.0.0", updates.Options{}, "1.1.1"},
+		{"up to date", "1.1.1", updates.Options{}, ""},
+		{"rc", "1.0.0", updates.Options{Channel: updates.RC}, "1.2.0-rc.1"},
+		{"beta", "1.0.0", updates.Options{Channel: updates.Beta}, "2.0.0-beta.1"},
+		{"alpha", "1.0.0", updates.Options{Channel: updates.Alpha}, "2.0.0-beta.1"},
+		{"custom channel", "1.0.0", updates.Options{Channel: "nightly"}, "1.2.0-nightly.20250601"},
+		{"allow prerelease", "1.0.0", updates.Options{AllowPrerelease: true}, "2.0.0-beta.1"},
+		{
+			"constraint",
+			"1.0.0",
+			updates.Options{Channel: updates.Beta, Constraint: semver.MustParseConstraint("<1.2.0-0")},
+			"1.1.1",
+		},
+		{
+			"constraint with pre-release",
+			"1.0.0",
+			updates.Options{Channel: updates.Beta, Constraint: semver.MustParseConstraint("^1.0.0")},
+			"1.2.0-rc.1",
+		},
+		{"no downgrade", "3.0.0", updates.Options{AllowPrerelease: true}, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, ok := updates.CheckUpdate(semver.MustParse(tt.current), available, tt.opts)
+
+			switch {
+			case tt.want == "" && ok:
+				t.Errorf("CheckUpdate(%q) = %q, want no update", tt.current, got)
+			case tt.want != "" && !ok:
+				t.Errorf("CheckUpdate(%q) found no update, want %q", tt.current, tt.want)
+			case ok && got.String() != tt.want:
+				t.Errorf("CheckUpdate(%q) = %q, want %q", tt.current, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestChannelOf(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		v    string
+		want string
+	}{
+		{"1.0.0", updates.Stable},
+		{"1.0.0+build", updates.Stable},
+		{"1.0.0-rc.1", updates.RC},
+		{"1.0.0-Beta2", updates.Beta},
+		{"1.0.0-alpha", updates.Alpha},
+		{"1.0.0-0.3.7", "0"},
+		{"1.0.0-x-y", "x"},
+	}
+
+	for _, tt := range tests {
+		if got := updates.ChannelOf(semver.MustParse(tt.v)); got != tt.want {
+			t.Errorf("ChannelOf(%q) = %q, want %q", tt.v, got, tt.want)
+		}
+	}
+}
+
+func TestCheckSource(t *testing.T) {
+	t.Parallel()
+
+	src := source.Static(semver.Versions{semver.MustParse("1.0.0"), semver.MustParse("1.2.0")})
+
+	got, ok, err := updates.CheckSource(t.Context(), semver.MustParse("1.0.0"), src, updates.Options{})
+	if err != nil {
+		t.Fatalf("CheckSource() error = %v", err)
+	}
+
+	if !ok || got.String() != "1.2.0" {
+		t.Errorf("CheckSource() = %v, %v, want 1.2.0, true", got, ok)
+	}
+
+	errList := errors.New("list failed")
+	failing := semver.VersionSourceFunc(func(context.Context) (semver.Versions, error) {
+		return nil, errList
+	})
+
+	_, _, err = updates.CheckSource(t.Context(), semver.MustParse("1.0.0"), failing, updates.Options{})
+	if !errors.Is(err, errList) {
+		t.Errorf("CheckSource() error = %v, want %v", err, errList)
+	}
+}
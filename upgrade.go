@@ -0,0 +1,148 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+)
+
+// ErrInvalidUpgrade is returned when an upgrade cannot be planned between
+// the given versions.
+var ErrInvalidUpgrade = errors.New("invalid upgrade")
+
+// UpgradeRules are the rules that an upgrade path must follow. The zero value
+// allows upgrading directly to the target version.
+type UpgradeRules struct {
+	// EachMajor requires the upgrade to pass through every major version
+	// series between the versions.
+	EachMajor bool
+
+	// EachMinor requires the upgrade to pass through every minor version
+	// series between the versions. It implies EachMajor.
+	EachMinor bool
+
+	// AllowPrerelease allows using pre-release versions as intermediate
+	// steps.
+	AllowPrerelease bool
+}
+
+// PlanUpgrade returns the sequence of versions required for upgrading from
+// version from to version to according to rules. The intermediate steps are
+// selected from available: for every version series that must be passed
+// through, the greatest available version in the series is used. The returned
+// sequence ends with to, which doesn't need to be in available. The series of
+// from and to are not included as intermediate steps.
+//
+// If to is not greater than from, or if a version series that must be passed
+// through has no version in available, the returned error wraps
+// ErrInvalidUpgrade. The required series between the versions are the ones
+// that must exist as the version numbers are consecutive: for example, with
+// EachMinor, the upgrade from 1.4.0 to 3.2.0 requires the series 2.0, 3.0,
+// and 3.1, and the series from 2.1 up to the greatest 2.x series in
+// available.
+func PlanUpgrade(from, to *Version, available Versions, rules UpgradeRules) (Versions, error) {
+	if to.Compare(from) <= 0 {
+		return nil, fmt.Errorf("%w: %s is not greater than %s", ErrInvalidUpgrade, to, from)
+	}
+
+	if !rules.EachMajor && !rules.EachMinor {
+		return Versions{to}, nil
+	}
+
+	// sameSeries reports whether the versions are in the same series according
+	// to the rules.
+	sameSeries := func(a, b *Version) bool {
+		return a.Major == b.Major && (!rules.EachMinor || a.Minor == b.Minor)
+	}
+
+	candidates := make(Versions, 0, len(available))
+
+	for _, v := range available {
+		if v.Compare(from) <= 0 || v.Compare(to) >= 0 ||
+			(len(v.Prerelease) > 0 && !rules.AllowPrerelease) ||
+			sameSeries(v, from) || sameSeries(v, to) {
+			continue
+		}
+
+		candidates = append(candidates, v)
+	}
+
+	slices.SortFunc(candidates, Compare)
+
+	steps := make(Versions, 0, len(candidates)+1)
+
+	for i, v := range candidates {
+		// Select the greatest version in each series, i.e. the last one
+		// before the series changes.
+		if i+1 < len(candidates) && sameSeries(v, candidates[i+1]) {
+			continue
+		}
+
+		steps = append(steps, v)
+	}
+
+	if series := missingSeries(from, to, steps, rules.EachMinor); series != "" {
+		return nil, fmt.Errorf("%w: no version available in series %s", ErrInvalidUpgrade, series)
+	}
+
+	return append(steps, to), nil
+}
+
+// missingSeries returns the first version series between from and to that is
+// not passed through by steps, or an empty string if there is no such series.
+// The steps must be sorted and contain one version for each series.
+func missingSeries(from, to *Version, steps Versions, eachMinor bool) string {
+	if !eachMinor {
+		want := from.Major + 1
+
+		for _, v := range steps {
+			if v.Major != want {
+				return fmt.Sprintf("%d.x", want)
+			}
+
+			want++
+		}
+
+		if want < to.Major {
+			return fmt.Sprintf("%d.x", want)
+		}
+
+		return ""
+	}
+
+	major, minor := from.Major, from.Minor
+
+	for _, v := range append(steps[:len(steps):len(steps)], to) {
+		switch {
+		case v.Major == major && v.Minor > minor+1:
+			return fmt.Sprintf("%d.%d", major, minor+1)
+		case v.Major > major+1:
+			return fmt.Sprintf("%d.x", major+1)
+		case v.Major > major && v.Minor != 0:
+			return fmt.Sprintf("%d.0", v.Major)
+		}
+
+		major, minor = v.Major, v.Minor
+	}
+
+	return ""
+}
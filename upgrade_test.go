@@ -0,0 +1,115 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/anttikivi/semver"
+)
+
+func TestPlanUpgrade(t *testing.T) {
+	t.Parallel()
+
+	var available semver.Versions
+	for _, s := range []string{
+		"1.0.0", "1.0.1", "1.1.0", "1.1.4", "1.2.0-rc.1", "1.2.0", "1.2.2",
+		"1.3.0", "2.0.0-beta.1", "2.0.0", "2.1.0", "2.1.3", "3.0.0", "3.1.0",
+	} {
+		available = append(available, semver.MustParse(s))
+	}
+
+	tests := []struct {
+		name  string
+		from  string
+		to    string
+		rules semver.UpgradeRules
+		want  []string
+	}{
+		{"direct", "1.0.0", "3.1.0", semver.UpgradeRules{}, []string{"3.1.0"}},
+		{"each minor", "1.0.1", "1.3.0", semver.UpgradeRules{EachMinor: true}, []string{"1.1.4", "1.2.2", "1.3.0"}},
+		{
+			"each minor across majors",
+			"1.2.0",
+			"2.1.3",
+			semver.UpgradeRules{EachMinor: true},
+			[]string{"1.3.0", "2.0.0", "2.1.3"},
+		},
+		{"each major", "1.0.0", "3.1.0", semver.UpgradeRules{EachMajor: true}, []string{"2.1.3", "3.1.0"}},
+		{"same series", "1.1.0", "1.1.4", semver.UpgradeRules{EachMinor: true}, []string{"1.1.4"}},
+		{
+			"prerelease",
+			"1.3.0",
+			"2.0.0",
+			semver.UpgradeRules{EachMajor: true, AllowPrerelease: true},
+			[]string{"2.0.0"},
+		},
+		{
+			"target not available",
+			"2.1.0",
+			"4.0.0",
+			semver.UpgradeRules{EachMajor: true},
+			[]string{"3.1.0", "4.0.0"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			steps, err := semver.PlanUpgrade(semver.MustParse(tt.from), semver.MustParse(tt.to), available, tt.rules)
+			if err != nil {
+				t.Fatalf("PlanUpgrade(%q, %q) failed unexpectedly: %v", tt.from, tt.to, err)
+			}
+
+			if got := versionStrings(steps); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("PlanUpgrade(%q, %q) = %v, want %v", tt.from, tt.to, got, tt.want)
+			}
+		})
+	}
+
+	_, err := semver.PlanUpgrade(semver.MustParse("2.0.0"), semver.MustParse("1.0.0"), available, semver.UpgradeRules{})
+	if !errors.Is(err, semver.ErrInvalidUpgrade) {
+		t.Errorf("PlanUpgrade(2.0.0, 1.0.0) error = %v, want ErrInvalidUpgrade", err)
+	}
+
+	var gaps semver.Versions
+	for _, s := range []string{"1.0.0", "1.1.0", "1.3.0", "3.0.0", "3.2.0", "4.1.0"} {
+		gaps = append(gaps, semver.MustParse(s))
+	}
+
+	for _, tt := range []struct {
+		from  string
+		to    string
+		rules semver.UpgradeRules
+	}{
+		{"1.0.0", "3.2.0", semver.UpgradeRules{EachMajor: true}},
+		{"1.0.0", "1.3.0", semver.UpgradeRules{EachMinor: true}},
+		{"1.1.0", "3.2.0", semver.UpgradeRules{EachMinor: true}},
+		{"3.2.0", "4.2.0", semver.UpgradeRules{EachMinor: true}},
+	} {
+		_, err := semver.PlanUpgrade(semver.MustParse(tt.from), semver.MustParse(tt.to), gaps, tt.rules)
+		if !errors.Is(err, semver.ErrInvalidUpgrade) {
+			t.Errorf("PlanUpgrade(%s, %s) error = %v, want ErrInvalidUpgrade", tt.from, tt.to, err)
+		}
+	}
+}
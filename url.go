@@ -0,0 +1,83 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// versionQueryKeys are the query parameters that [FindInURL] checks for
+// a version, in order.
+//
+//nolint:gochecknoglobals // read-only lookup table
+var versionQueryKeys = []string{"version", "ver", "v", "tag", "ref", "release"}
+
+// FindInURL returns the version in the given download URL. The version is
+// searched for first in the path segments, from the last one to the first
+// one, and then in the common query parameters, like "version" and "tag".
+// A path segment is a version if it is a valid version, possibly with
+// the "v" prefix, or if it is a versioned file name that [ParseFileName]
+// recognizes. For example, the version in
+// "https://github.com/o/r/releases/download/v1.2.3/tool-1.2.3-linux-amd64.tar.gz"
+// is "1.2.3".
+func FindInURL(u string) (*Version, error) {
+	parsed, err := url.Parse(u)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse URL: %w", err)
+	}
+
+	segments := strings.Split(parsed.Path, "/")
+	for i := len(segments) - 1; i >= 0; i-- {
+		if v := findInSegment(segments[i]); v != nil {
+			return v, nil
+		}
+	}
+
+	query := parsed.Query()
+	for _, key := range versionQueryKeys {
+		for _, value := range query[key] {
+			if v := findInSegment(value); v != nil {
+				return v, nil
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("%w: no version in URL %q", ErrInvalidVersion, u)
+}
+
+// findInSegment returns the version in a single URL path segment or query
+// value, or nil if there is none.
+func findInSegment(s string) *Version {
+	if s == "" {
+		return nil
+	}
+
+	if v, err := Parse(s); err == nil {
+		return v
+	}
+
+	if f, err := ParseFileName(s); err == nil {
+		return f.Version
+	}
+
+	return nil
+}
@@ -0,0 +1,62 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/anttikivi/semver"
+)
+
+func TestFindInURL(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		u       string
+		want    string
+		wantErr error
+	}{
+		{"https://github.com/o/r/releases/download/v1.2.3/tool-linux-amd64.tar.gz", "1.2.3", nil},
+		{"https://github.com/o/r/releases/download/v1.2.3/tool-1.2.3-linux-amd64.tar.gz", "1.2.3", nil},
+		{"https://example.com/dl/tool-2.0.0-rc.1.zip", "2.0.0-rc.1", nil},
+		{"https://example.com/dl/1.0.0-beta/", "1.0.0-beta", nil},
+		{"https://example.com/download?product=tool&version=v3.1.4", "3.1.4", nil},
+		{"https://example.com/download?tag=1.0.0%2Bbuild.5", "1.0.0+build.5", nil},
+		{"/releases/download/v0.1.0/checksums.txt", "0.1.0", nil},
+		{"https://example.com/latest/tool.tar.gz", "", semver.ErrInvalidVersion},
+		{"https://example.com/download?version=latest", "", semver.ErrInvalidVersion},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.u, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := semver.FindInURL(tt.u)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("FindInURL(%q) error = %v, want %v", tt.u, err, tt.wantErr)
+			}
+
+			if err == nil && got.String() != tt.want {
+				t.Errorf("FindInURL(%q) = %q, want %q", tt.u, got, tt.want)
+			}
+		})
+	}
+}
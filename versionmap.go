@@ -0,0 +1,226 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Errors returned by the methods of VersionMap.
+var (
+	// ErrUnknownComponent is returned when a VersionMap doesn't have
+	// the requested component.
+	ErrUnknownComponent = errors.New("unknown component")
+
+	// ErrNotLockstep is returned when the versions of the components in
+	// a VersionMap are not in lockstep.
+	ErrNotLockstep = errors.New("versions are not in lockstep")
+)
+
+// A VersionMap maps the components of a monorepo, like the released modules
+// or artifacts, to their versions. It is encoded in JSON and YAML as an object
+// that maps the names of the components to version strings. The YAML methods
+// implement the interfaces that the common YAML packages, like
+// gopkg.in/yaml.v3, use, so the package doesn't depend on any of them.
+// The methods that change the map replace the versions in it instead of
+// modifying them.
+type VersionMap map[string]*Version
+
+// Names returns the names of the components in m in sorted order.
+func (m VersionMap) Names() []string {
+	names := make([]string, 0, len(m))
+	for name := range m {
+		names = append(names, name)
+	}
+
+	slices.Sort(names)
+
+	return names
+}
+
+// Bump bumps the versions of the named components at the given release level
+// using [Bump]. If no names are given, all of the components are bumped.
+// The map is changed only if all of the versions can be bumped: Bump returns
+// an error that wraps [ErrUnknownComponent] if a component is not in m, and
+// the error from [Bump] if a version can't be bumped.
+func (m VersionMap) Bump(level ReleaseLevel, names ...string) error {
+	if len(names) == 0 {
+		names = m.Names()
+	}
+
+	bumped := make(map[string]*Version, len(names))
+
+	for _, name := range names {
+		v, ok := m[name]
+		if !ok {
+			return fmt.Errorf("%w: %q", ErrUnknownComponent, name)
+		}
+
+		w, err := Bump(v, level)
+		if err != nil {
+			return fmt.Errorf("failed to bump %s: %w", name, err)
+		}
+
+		bumped[name] = w
+	}
+
+	for name, v := range bumped {
+		m[name] = v
+	}
+
+	return nil
+}
+
+// CheckLockstep checks that the versions of the named components are in
+// lockstep at the given release level: at LevelMajor, they must have the same
+// major version, at LevelMinor, the same major and minor versions, and at
+// LevelPatch, the same version core. At LevelPrerelease and below, they must
+// be equal except for the build metadata. If no names are given, all of
+// the components are checked. It returns an error that wraps [ErrNotLockstep]
+// and names the first component that differs from the first one in sorted
+// order, and an error that wraps [ErrUnknownComponent] if a component is not
+// in m.
+func (m VersionMap) CheckLockstep(level ReleaseLevel, names ...string) error {
+	if len(names) == 0 {
+		names = m.Names()
+	} else {
+		names = slices.Sorted(slices.Values(names))
+	}
+
+	var (
+		first string
+		want  *Version
+	)
+
+	for _, name := range names {
+		v, ok := m[name]
+		if !ok {
+			return fmt.Errorf("%w: %q", ErrUnknownComponent, name)
+		}
+
+		if want == nil {
+			first, want = name, v
+
+			continue
+		}
+
+		if diffLevel(want, v) >= max(level, LevelPrerelease) {
+			return fmt.Errorf(
+				"%w at %s: %s is %s but %s is %s",
+				ErrNotLockstep,
+				level,
+				name,
+				v,
+				first,
+				want,
+			)
+		}
+	}
+
+	return nil
+}
+
+// String returns the components and their versions in sorted order as
+// "name@version" separated by commas.
+func (m VersionMap) String() string {
+	var sb strings.Builder
+
+	for i, name := range m.Names() {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+
+		sb.WriteString(name)
+		sb.WriteByte('@')
+		sb.WriteString(m[name].String())
+	}
+
+	return sb.String()
+}
+
+// MarshalJSON implements [json.Marshaler].
+func (m VersionMap) MarshalJSON() ([]byte, error) {
+	b, err := json.Marshal(m.strings())
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal version map: %w", err)
+	}
+
+	return b, nil
+}
+
+// UnmarshalJSON implements [json.Unmarshaler]. It replaces the contents of m.
+func (m *VersionMap) UnmarshalJSON(data []byte) error {
+	var s map[string]string
+
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("failed to unmarshal version map: %w", err)
+	}
+
+	return m.parse(s)
+}
+
+// MarshalYAML implements the Marshaler interface of the YAML packages.
+func (m VersionMap) MarshalYAML() (any, error) {
+	return m.strings(), nil
+}
+
+// UnmarshalYAML implements the Unmarshaler interface of gopkg.in/yaml.v2,
+// which gopkg.in/yaml.v3 also supports. It replaces the contents of m.
+func (m *VersionMap) UnmarshalYAML(unmarshal func(any) error) error {
+	var s map[string]string
+
+	if err := unmarshal(&s); err != nil {
+		return fmt.Errorf("failed to unmarshal version map: %w", err)
+	}
+
+	return m.parse(s)
+}
+
+// parse replaces the contents of m with the components and versions in s.
+func (m *VersionMap) parse(s map[string]string) error {
+	parsed := make(VersionMap, len(s))
+
+	for name, str := range s {
+		v, err := Parse(str)
+		if err != nil {
+			return fmt.Errorf("failed to unmarshal the version of %s: %w", name, err)
+		}
+
+		parsed[name] = v
+	}
+
+	*m = parsed
+
+	return nil
+}
+
+// strings returns m with the versions as strings.
+func (m VersionMap) strings() map[string]string {
+	s := make(map[string]string, len(m))
+	for name, v := range m {
+		s[name] = v.String()
+	}
+
+	return s
+}
@@ -0,0 +1,155 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver_test
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/anttikivi/semver"
+)
+
+func TestVersionMapJSON(t *testing.T) {
+	t.Parallel()
+
+	m := semver.VersionMap{
+		"api": semver.MustParse("1.2.3"),
+		"cli": semver.MustParse("1.4.0-rc.1"),
+	}
+
+	b, err := json.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := `{"api":"1.2.3","cli":"1.4.0-rc.1"}`; string(b) != want {
+		t.Errorf("json.Marshal() = %s, want %s", b, want)
+	}
+
+	var got semver.VersionMap
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatal(err)
+	}
+
+	if got.String() != m.String() {
+		t.Errorf("round trip = %s, want %s", got, m)
+	}
+
+	err = json.Unmarshal([]byte(`{"api":"1.2"}`), &got)
+	if !errors.Is(err, semver.ErrInvalidVersion) {
+		t.Errorf("json.Unmarshal() error = %v, want %v", err, semver.ErrInvalidVersion)
+	}
+}
+
+func TestVersionMapYAML(t *testing.T) {
+	t.Parallel()
+
+	m := semver.VersionMap{"api": semver.MustParse("1.2.3")}
+
+	out, err := m.MarshalYAML()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got semver.VersionMap
+
+	err = got.UnmarshalYAML(func(v any) error {
+		p, ok := v.(*map[string]string)
+		if !ok {
+			t.Fatalf("UnmarshalYAML() decoded into %T", v)
+		}
+
+		s, ok := out.(map[string]string)
+		if !ok {
+			t.Fatalf("MarshalYAML() = %T, want map[string]string", out)
+		}
+
+		*p = s
+
+		return nil
+	})
+	if err != nil || got.String() != "api@1.2.3" {
+		t.Errorf("YAML round trip = %s, %v, want api@1.2.3", got, err)
+	}
+}
+
+func TestVersionMapBump(t *testing.T) {
+	t.Parallel()
+
+	m := semver.VersionMap{
+		"api": semver.MustParse("1.2.3"),
+		"cli": semver.MustParse("1.4.0"),
+		"web": semver.MustParse("2.0.0"),
+	}
+
+	if err := m.Bump(semver.LevelMinor, "api", "cli"); err != nil {
+		t.Fatal(err)
+	}
+
+	if want := "api@1.3.0, cli@1.5.0, web@2.0.0"; m.String() != want {
+		t.Errorf("after Bump() = %s, want %s", m, want)
+	}
+
+	if err := m.Bump(semver.LevelPatch, "api", "db"); !errors.Is(err, semver.ErrUnknownComponent) {
+		t.Errorf("Bump() error = %v, want %v", err, semver.ErrUnknownComponent)
+	}
+
+	if m["api"].String() != "1.3.0" {
+		t.Errorf("failed Bump() changed api to %s", m["api"])
+	}
+
+	if err := m.Bump(semver.LevelMajor); err != nil {
+		t.Fatal(err)
+	}
+
+	if want := "api@2.0.0, cli@2.0.0, web@3.0.0"; m.String() != want {
+		t.Errorf("after Bump() = %s, want %s", m, want)
+	}
+}
+
+func TestVersionMapCheckLockstep(t *testing.T) {
+	t.Parallel()
+
+	m := semver.VersionMap{
+		"api": semver.MustParse("1.2.3"),
+		"cli": semver.MustParse("1.2.4"),
+		"web": semver.MustParse("2.0.0"),
+	}
+
+	if err := m.CheckLockstep(semver.LevelMinor, "cli", "api"); err != nil {
+		t.Errorf("CheckLockstep(LevelMinor, api, cli) error = %v", err)
+	}
+
+	err := m.CheckLockstep(semver.LevelPatch, "cli", "api")
+	if !errors.Is(err, semver.ErrNotLockstep) || !strings.Contains(err.Error(), "cli is 1.2.4") {
+		t.Errorf("CheckLockstep(LevelPatch) error = %v, want %v", err, semver.ErrNotLockstep)
+	}
+
+	if err = m.CheckLockstep(semver.LevelMajor); !errors.Is(err, semver.ErrNotLockstep) {
+		t.Errorf("CheckLockstep(LevelMajor) error = %v, want %v", err, semver.ErrNotLockstep)
+	}
+
+	err = m.CheckLockstep(semver.LevelMajor, "db")
+	if !errors.Is(err, semver.ErrUnknownComponent) {
+		t.Errorf("CheckLockstep(db) error = %v, want %v", err, semver.ErrUnknownComponent)
+	}
+}
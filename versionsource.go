@@ -0,0 +1,40 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver
+
+import "context"
+
+// A VersionSource lists the available versions of something, for example
+// the releases of a project in a registry. The implementations for the common
+// registries are in the source package.
+type VersionSource interface {
+	// List returns the available versions. The order of the versions is not
+	// specified, and the returned slice may be modified by the caller.
+	List(ctx context.Context) (Versions, error)
+}
+
+// The VersionSourceFunc type is an adapter that allows the use of ordinary
+// functions as a [VersionSource].
+type VersionSourceFunc func(ctx context.Context) (Versions, error)
+
+// List calls f(ctx).
+func (f VersionSourceFunc) List(ctx context.Context) (Versions, error) {
+	return f(ctx)
+}
@@ -0,0 +1,95 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver
+
+import (
+	"io"
+	"strconv"
+	"sync"
+)
+
+// writeBufferSize is the initial size of the buffers used by
+// [Version.WriteTo]. It fits most of the versions.
+const writeBufferSize = 64
+
+// writeBuffers are the buffers used by [Version.WriteTo].
+//
+//nolint:gochecknoglobals // the pool is shared by all of the calls
+var writeBuffers = sync.Pool{
+	New: func() any {
+		b := make([]byte, 0, writeBufferSize)
+
+		return &b
+	},
+}
+
+// WriteTo writes the string representation of v to w. It implements
+// [io.WriterTo]. The version is written with a single call to w.Write without
+// allocating an intermediate string.
+func (v *Version) WriteTo(w io.Writer) (int64, error) {
+	bp, _ := writeBuffers.Get().(*[]byte)
+	b := v.appendTo((*bp)[:0])
+
+	n, err := w.Write(b)
+
+	*bp = b
+	writeBuffers.Put(bp)
+
+	return int64(n), err
+}
+
+// appendTo appends the string representation of v to b and returns
+// the extended buffer.
+func (v *Version) appendTo(b []byte) []byte {
+	b = strconv.AppendUint(b, v.Major, 10)
+	b = append(b, '.')
+	b = strconv.AppendUint(b, v.Minor, 10)
+	b = append(b, '.')
+	b = strconv.AppendUint(b, v.Patch, 10)
+
+	for i, id := range v.Prerelease {
+		if i == 0 {
+			b = append(b, '-')
+		} else {
+			b = append(b, '.')
+		}
+
+		switch id := id.(type) {
+		case numericIdentifier:
+			b = strconv.AppendUint(b, id.v, 10)
+		case alphanumericIdentifier:
+			b = append(b, id.v...)
+		default:
+			b = append(b, id.String()...)
+		}
+	}
+
+	for i, id := range v.Build {
+		if i == 0 {
+			b = append(b, '+')
+		} else {
+			b = append(b, '.')
+		}
+
+		b = append(b, id...)
+	}
+
+	return b
+}
@@ -0,0 +1,63 @@
+// Copyright (c) 2025 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver_test
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/anttikivi/semver"
+)
+
+func TestVersionWriteTo(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+
+	for _, s := range []string{"0.0.0", "1.2.3", "1.2.3-rc.1", "1.2.3-alpha.beta.11+linux.amd64", "1.2.3+001"} {
+		buf.Reset()
+
+		n, err := semver.MustParse(s).WriteTo(&buf)
+		if err != nil {
+			t.Fatalf("Version{%q}.WriteTo() failed unexpectedly: %v", s, err)
+		}
+
+		if got := buf.String(); got != s || n != int64(len(s)) {
+			t.Errorf("Version{%q}.WriteTo() wrote %q and returned %d, want %q and %d", s, got, n, s, len(s))
+		}
+	}
+}
+
+var errWrite = errors.New("write failed")
+
+type errWriter struct{}
+
+func (errWriter) Write([]byte) (int, error) {
+	return 0, errWrite
+}
+
+func TestVersionWriteToError(t *testing.T) {
+	t.Parallel()
+
+	if _, err := semver.MustParse("1.2.3").WriteTo(errWriter{}); !errors.Is(err, errWrite) {
+		t.Errorf("Version.WriteTo() error = %v, want %v", err, errWrite)
+	}
+}